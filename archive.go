@@ -0,0 +1,662 @@
+package jasper
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"github.com/ulikunitz/xz"
+)
+
+const (
+	// DefaultArchiveMaxSize is the largest total size, in bytes, of the
+	// files extracted from an archive if ArchiveOptions does not specify
+	// a limit.
+	DefaultArchiveMaxSize = 16 * 1024 * 1024 * 1024
+	// DefaultArchiveMaxFiles is the largest number of entries extracted
+	// from an archive if ArchiveOptions does not specify a limit.
+	DefaultArchiveMaxFiles = 100000
+)
+
+// archiveExtensions maps the file extensions of archives to their formats.
+// Longer extensions are checked first.
+var archiveExtensions = []struct {
+	ext    string
+	format ArchiveFormat
+}{
+	{ext: ".tar.gz", format: ArchiveTarGz},
+	{ext: ".tar.bz2", format: ArchiveTarBz2},
+	{ext: ".tar.xz", format: ArchiveTarXz},
+	{ext: ".tar.zst", format: ArchiveTarZst},
+	{ext: ".tgz", format: ArchiveTarGz},
+	{ext: ".tbz2", format: ArchiveTarBz2},
+	{ext: ".tbz", format: ArchiveTarBz2},
+	{ext: ".txz", format: ArchiveTarXz},
+	{ext: ".tzst", format: ArchiveTarZst},
+	{ext: ".tar", format: ArchiveTar},
+	{ext: ".zip", format: ArchiveZip},
+}
+
+// archiveMagic maps the leading bytes of compressed files and archives to
+// their formats.
+var archiveMagic = []struct {
+	magic  []byte
+	format ArchiveFormat
+}{
+	{magic: []byte{0x1f, 0x8b}, format: ArchiveTarGz},
+	{magic: []byte("BZh"), format: ArchiveTarBz2},
+	{magic: []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}, format: ArchiveTarXz},
+	{magic: []byte{0x28, 0xb5, 0x2f, 0xfd}, format: ArchiveTarZst},
+	{magic: []byte("PK\x03\x04"), format: ArchiveZip},
+}
+
+// detectArchiveFormat returns the format of the archive, detected from its
+// extension or, failing that, its contents.
+func detectArchiveFormat(fileName string) (ArchiveFormat, error) {
+	lower := strings.ToLower(fileName)
+	for _, ext := range archiveExtensions {
+		if strings.HasSuffix(lower, ext.ext) {
+			return ext.format, nil
+		}
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return "", errors.Wrapf(err, "problem opening archive %s", fileName)
+	}
+	defer file.Close()
+
+	header := make([]byte, 512)
+	n, _ := io.ReadFull(file, header)
+	header = header[:n]
+	for _, magic := range archiveMagic {
+		if bytes.HasPrefix(header, magic.magic) {
+			return magic.format, nil
+		}
+	}
+	if len(header) >= 262 && bytes.Equal(header[257:262], []byte("ustar")) {
+		return ArchiveTar, nil
+	}
+
+	return "", errors.Errorf("could not detect archive format for %s", fileName)
+}
+
+// ArchiveReport describes the contents of an archive that were written by
+// extracting it. Files are the paths, relative to the target path and
+// separated by slashes, of the files and links that were written, and Size
+// is the total size of the files in bytes.
+type ArchiveReport struct {
+	Files []string `json:"files,omitempty"`
+	Size  int64    `json:"size"`
+}
+
+func doExtract(info DownloadInfo) (ArchiveReport, error) {
+	format := info.ArchiveOpts.Format
+	if format == ArchiveAuto {
+		var err error
+		if format, err = detectArchiveFormat(info.Path); err != nil {
+			return ArchiveReport{}, err
+		}
+	}
+
+	e := newExtractor(info.ArchiveOpts)
+	var err error
+	switch format {
+	case ArchiveZip:
+		err = e.extractZip(info.Path)
+	case ArchiveTar, ArchiveTarGz, ArchiveTarBz2, ArchiveTarXz, ArchiveTarZst:
+		err = e.extractTarFile(info.Path, format)
+	default:
+		return ArchiveReport{}, errors.Errorf("unrecognized archive format %s", info.ArchiveOpts.Format)
+	}
+	if err == nil {
+		err = e.finish()
+	}
+
+	return e.report, errors.Wrapf(err, "problem extracting archive %s to %s", info.Path, info.ArchiveOpts.TargetPath)
+}
+
+// entryPath returns the path to which the archive entry with the given
+// name is extracted, and whether it should be extracted at all. It returns
+// an error if the entry would be written outside of the target path.
+func (opts ArchiveOptions) entryPath(name string) (string, bool, error) {
+	name = strings.Replace(name, `\`, "/", -1)
+	if path.IsAbs(name) || filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return "", false, errors.Errorf("archive entry '%s' has an absolute path", name)
+	}
+
+	name = path.Clean(name)
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return "", false, errors.Errorf("archive entry '%s' is outside of the target path", name)
+	}
+	if name == "." {
+		return "", false, nil
+	}
+
+	parts := strings.Split(name, "/")
+	if len(parts) <= opts.StripComponents {
+		return "", false, nil
+	}
+	name = strings.Join(parts[opts.StripComponents:], "/")
+
+	if len(opts.Include) > 0 && !matchesArchivePattern(opts.Include, name) {
+		return "", false, nil
+	}
+	if matchesArchivePattern(opts.Exclude, name) {
+		return "", false, nil
+	}
+
+	return filepath.Join(opts.TargetPath, filepath.FromSlash(name)), true, nil
+}
+
+// matchesArchivePattern returns whether the path, or any of its parent
+// directories, matches any of the patterns.
+func matchesArchivePattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		for prefix := name; prefix != "."; prefix = path.Dir(prefix) {
+			if ok, _ := path.Match(pattern, prefix); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// archiveSymlink is a symlink whose creation is deferred until the rest of
+// the archive is extracted, so that no entry is written through it.
+type archiveSymlink struct {
+	path     string
+	linkname string
+}
+
+// extractor writes the entries of an archive into the target path. It
+// never writes through a symlink, refuses symlinks that point outside of
+// the target path, and enforces the limits in the ArchiveOptions.
+type extractor struct {
+	opts     ArchiveOptions
+	target   string
+	maxSize  int64
+	maxFiles int
+	entries  int
+	symlinks []archiveSymlink
+	report   ArchiveReport
+}
+
+func newExtractor(opts ArchiveOptions) *extractor {
+	e := &extractor{
+		opts:     opts,
+		target:   filepath.Clean(opts.TargetPath),
+		maxSize:  opts.MaxSize,
+		maxFiles: opts.MaxFiles,
+	}
+	if e.maxSize == 0 {
+		e.maxSize = DefaultArchiveMaxSize
+	}
+	if e.maxFiles == 0 {
+		e.maxFiles = DefaultArchiveMaxFiles
+	}
+	return e
+}
+
+// pathContains returns whether the path is inside the root path.
+func pathContains(root, p string) bool {
+	rel, err := filepath.Rel(root, p)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// addEntry counts an entry against the file limit.
+func (e *extractor) addEntry() error {
+	e.entries++
+	if e.entries > e.maxFiles {
+		return errors.Errorf("archive has more than the maximum of %d entries", e.maxFiles)
+	}
+	return nil
+}
+
+// record adds a written file or link to the report.
+func (e *extractor) record(p string, size int64) {
+	rel, err := filepath.Rel(e.target, p)
+	if err != nil {
+		rel = p
+	}
+	e.report.Files = append(e.report.Files, filepath.ToSlash(rel))
+	e.report.Size += size
+}
+
+// checkPath ensures that none of the directories between the target path
+// and the path are symlinks, so that writing the path cannot follow a link
+// out of the target path.
+func (e *extractor) checkPath(p string) error {
+	rel, err := filepath.Rel(e.target, p)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	current := e.target
+	parts := strings.Split(rel, string(filepath.Separator))
+	for i, part := range parts[:len(parts)-1] {
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return errors.Errorf("cannot extract '%s' through symlink '%s'", rel, filepath.Join(parts[:i+1]...))
+		}
+	}
+	return nil
+}
+
+// replaceable ensures that the path can be created by removing any file
+// or link that is already there, so that it is replaced rather than
+// written through.
+func replaceable(p string) error {
+	info, err := os.Lstat(p)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if info.IsDir() {
+		return errors.Errorf("'%s' already exists and is a directory", p)
+	}
+	return errors.WithStack(os.Remove(p))
+}
+
+func (e *extractor) extractTarFile(fileName string, format ArchiveFormat) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "problem opening archive %s", fileName)
+	}
+	defer file.Close()
+
+	var reader io.Reader = bufio.NewReader(file)
+	switch format {
+	case ArchiveTarGz:
+		gzr, err := gzip.NewReader(reader)
+		if err != nil {
+			return errors.Wrap(err, "problem reading gzip")
+		}
+		defer gzr.Close()
+		reader = gzr
+	case ArchiveTarBz2:
+		reader = bzip2.NewReader(reader)
+	case ArchiveTarXz:
+		xzr, err := xz.NewReader(reader)
+		if err != nil {
+			return errors.Wrap(err, "problem reading xz")
+		}
+		reader = xzr
+	case ArchiveTarZst:
+		zstr, err := newZstdReader(reader)
+		if err != nil {
+			return errors.Wrap(err, "problem reading zstd")
+		}
+		defer zstr.Close()
+		reader = zstr
+	}
+
+	return e.extractTar(reader)
+}
+
+func (e *extractor) extractTar(reader io.Reader) error {
+	tr := tar.NewReader(reader)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "problem reading tar archive")
+		}
+
+		target, ok, err := e.opts.entryPath(header.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = e.writeDir(target)
+		case tar.TypeReg, tar.TypeRegA:
+			err = e.writeFile(target, tr, header.FileInfo().Mode())
+		case tar.TypeSymlink:
+			err = e.addSymlink(target, header.Linkname)
+		case tar.TypeLink:
+			err = e.writeLink(target, header.Linkname)
+		default:
+			grip.Debug(message.Fields{
+				"message": "skipping unsupported tar entry",
+				"entry":   header.Name,
+				"type":    string(header.Typeflag),
+			})
+		}
+		if err != nil {
+			return errors.Wrapf(err, "problem extracting archive entry '%s'", header.Name)
+		}
+	}
+}
+
+func (e *extractor) extractZip(fileName string) error {
+	zr, err := zip.OpenReader(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "problem opening archive %s", fileName)
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		target, ok, err := e.opts.entryPath(f.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		if err = e.extractZipEntry(f, target); err != nil {
+			return errors.Wrapf(err, "problem extracting archive entry '%s'", f.Name)
+		}
+	}
+
+	return nil
+}
+
+func (e *extractor) extractZipEntry(f *zip.File, target string) error {
+	mode := f.Mode()
+	if mode.IsDir() {
+		return e.writeDir(target)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer rc.Close()
+
+	if mode&os.ModeSymlink != 0 {
+		linkname, err := ioutil.ReadAll(io.LimitReader(rc, 4096))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return e.addSymlink(target, string(linkname))
+	}
+
+	return e.writeFile(target, rc, mode)
+}
+
+func (e *extractor) writeDir(target string) error {
+	if err := e.addEntry(); err != nil {
+		return err
+	}
+	if err := e.checkPath(target); err != nil {
+		return err
+	}
+	return errors.WithStack(makeEnclosingDirectories(target))
+}
+
+func (e *extractor) writeFile(target string, reader io.Reader, mode os.FileMode) error {
+	if err := e.addEntry(); err != nil {
+		return err
+	}
+	if err := e.checkPath(target); err != nil {
+		return err
+	}
+	if err := makeEnclosingDirectories(filepath.Dir(target)); err != nil {
+		return errors.Wrap(err, "problem making enclosing directories")
+	}
+	if err := replaceable(target); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	remaining := e.maxSize - e.report.Size
+	n, err := io.Copy(file, io.LimitReader(reader, remaining+1))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && n > remaining {
+		err = errors.Errorf("archive is larger than the maximum size of %d bytes", e.maxSize)
+	}
+	if err != nil {
+		grip.Warning(os.Remove(target))
+		return errors.WithStack(err)
+	}
+
+	e.record(target, n)
+	return nil
+}
+
+// addSymlink checks that the symlink points inside of the target path, and
+// defers its creation until the rest of the archive is extracted.
+func (e *extractor) addSymlink(target, linkname string) error {
+	if err := e.addEntry(); err != nil {
+		return err
+	}
+	if path.IsAbs(linkname) || filepath.IsAbs(linkname) || filepath.VolumeName(linkname) != "" {
+		return errors.Errorf("symlink points to absolute path '%s'", linkname)
+	}
+	if !pathContains(e.target, filepath.Join(filepath.Dir(target), filepath.FromSlash(linkname))) {
+		return errors.Errorf("symlink points to '%s', outside of the target path", linkname)
+	}
+
+	e.symlinks = append(e.symlinks, archiveSymlink{path: target, linkname: linkname})
+	return nil
+}
+
+func (e *extractor) writeLink(target, linkname string) error {
+	if err := e.addEntry(); err != nil {
+		return err
+	}
+	source, ok, err := e.opts.entryPath(linkname)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("hard link target '%s' is not extracted", linkname)
+	}
+	if err = e.checkPath(source); err != nil {
+		return err
+	}
+	if info, err := os.Lstat(source); err == nil && !info.Mode().IsRegular() {
+		return errors.Errorf("hard link target '%s' is not a regular file", linkname)
+	}
+	if err = e.checkPath(target); err != nil {
+		return err
+	}
+
+	if err = makeEnclosingDirectories(filepath.Dir(target)); err != nil {
+		return errors.Wrap(err, "problem making enclosing directories")
+	}
+	if err = replaceable(target); err != nil {
+		return err
+	}
+	if err = os.Link(source, target); err != nil {
+		return errors.WithStack(err)
+	}
+
+	e.record(target, 0)
+	return nil
+}
+
+// finish creates the deferred symlinks, and then ensures that each of them
+// resolves to a path inside of the target path, since a symlink may point
+// through another.
+func (e *extractor) finish() error {
+	for _, link := range e.symlinks {
+		if err := e.checkPath(link.path); err != nil {
+			return err
+		}
+		if err := makeEnclosingDirectories(filepath.Dir(link.path)); err != nil {
+			return errors.Wrap(err, "problem making enclosing directories")
+		}
+		if err := replaceable(link.path); err != nil {
+			return err
+		}
+		if err := os.Symlink(link.linkname, link.path); err != nil {
+			return errors.WithStack(err)
+		}
+		e.record(link.path, 0)
+	}
+	if len(e.symlinks) == 0 {
+		return nil
+	}
+
+	root, err := resolvePath(e.target)
+	if err != nil {
+		return err
+	}
+	catcher := grip.NewBasicCatcher()
+	for _, link := range e.symlinks {
+		resolved, err := resolvePath(link.path)
+		if err != nil {
+			catcher.Add(err)
+		} else if !pathContains(root, resolved) {
+			catcher.Add(errors.Errorf("symlink '%s' resolves to '%s', outside of the target path", link.path, resolved))
+		} else {
+			continue
+		}
+		grip.Warning(os.Remove(link.path))
+	}
+	return catcher.Resolve()
+}
+
+// maxSymlinkHops is the largest number of symlinks that resolvePath
+// follows.
+const maxSymlinkHops = 255
+
+// resolvePath returns the absolute path that the path refers to after
+// following all symlinks, like filepath.EvalSymlinks, except that the path
+// does not need to exist.
+func resolvePath(p string) (string, error) {
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	volume := filepath.VolumeName(abs)
+	root := volume + string(filepath.Separator)
+
+	resolved := root
+	remaining := strings.Split(filepath.ToSlash(abs[len(volume):]), "/")
+	for hops := 0; len(remaining) > 0; {
+		part := remaining[0]
+		remaining = remaining[1:]
+
+		switch part {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+
+		next := filepath.Join(resolved, part)
+		info, err := os.Lstat(next)
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		hops++
+		if hops > maxSymlinkHops {
+			return "", errors.Errorf("too many levels of symlinks resolving '%s'", p)
+		}
+		linkname, err := os.Readlink(next)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		if filepath.IsAbs(linkname) {
+			resolved = filepath.VolumeName(linkname) + string(filepath.Separator)
+			if filepath.VolumeName(linkname) == "" {
+				resolved = root
+			}
+			linkname = linkname[len(filepath.VolumeName(linkname)):]
+		}
+		remaining = append(strings.Split(filepath.ToSlash(linkname), "/"), remaining...)
+	}
+
+	return resolved, nil
+}
+
+// zstdReader decompresses a zstd stream with the zstd command. Unlike the
+// other archive formats, which are decompressed in-process, zstd archives
+// can only be extracted on hosts that have the zstd command on their PATH.
+type zstdReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	stderr bytes.Buffer
+}
+
+func newZstdReader(reader io.Reader) (io.ReadCloser, error) {
+	zstd, err := exec.LookPath("zstd")
+	if err != nil {
+		return nil, errors.Errorf("archive format '%s' is not supported on this host, "+
+			"since extracting it requires the zstd command, which was not found: %s", ArchiveTarZst, err.Error())
+	}
+
+	r := &zstdReader{cmd: exec.Command(zstd, "--decompress", "--stdout")}
+	r.cmd.Stdin = reader
+	r.cmd.Stderr = &r.stderr
+	if r.ReadCloser, err = r.cmd.StdoutPipe(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err = r.cmd.Start(); err != nil {
+		return nil, errors.Wrap(err, "problem starting zstd")
+	}
+
+	return r, nil
+}
+
+// Read reports the error of the zstd command, if any, once its output is
+// exhausted.
+func (r *zstdReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF {
+		if waitErr := r.wait(); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+func (r *zstdReader) wait() error {
+	if r.cmd.ProcessState != nil {
+		return nil
+	}
+	if err := r.cmd.Wait(); err != nil {
+		return errors.Wrapf(err, "problem decompressing zstd: %s", strings.TrimSpace(r.stderr.String()))
+	}
+	return nil
+}
+
+// Close stops the zstd command if it has not exited.
+func (r *zstdReader) Close() error {
+	if r.cmd.ProcessState == nil && r.cmd.Process != nil {
+		_ = r.cmd.Process.Kill()
+		_ = r.cmd.Wait()
+	}
+	return nil
+}
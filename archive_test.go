@@ -0,0 +1,379 @@
+package jasper
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+)
+
+type testArchiveEntry struct {
+	name     string
+	body     string
+	typeflag byte
+	linkname string
+}
+
+var testArchiveEntries = []testArchiveEntry{
+	{name: "root/", typeflag: tar.TypeDir},
+	{name: "root/bin/", typeflag: tar.TypeDir},
+	{name: "root/bin/tool", body: "tool"},
+	{name: "root/README.txt", body: "readme"},
+}
+
+func makeTestTar(t *testing.T, entries []testArchiveEntry) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, entry := range entries {
+		typeflag := entry.typeflag
+		if typeflag == 0 {
+			typeflag = tar.TypeReg
+		}
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     entry.name,
+			Typeflag: typeflag,
+			Linkname: entry.linkname,
+			Mode:     0755,
+			Size:     int64(len(entry.body)),
+		}))
+		_, err := tw.Write([]byte(entry.body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func makeTestZip(t *testing.T, entries []testArchiveEntry) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name}
+		switch entry.typeflag {
+		case tar.TypeDir:
+			header.SetMode(os.ModeDir | 0755)
+		case tar.TypeSymlink:
+			header.SetMode(os.ModeSymlink | 0777)
+			entry.body = entry.linkname
+		default:
+			header.SetMode(0644)
+		}
+		w, err := zw.CreateHeader(header)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(entry.body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// compressWithCommand compresses the data with the given command, and
+// skips the test if it is not installed.
+func compressWithCommand(t *testing.T, command string, data []byte) []byte {
+	if _, err := exec.LookPath(command); err != nil {
+		t.Skipf("%s is not installed", command)
+	}
+	cmd := exec.Command(command, "--stdout")
+	cmd.Stdin = bytes.NewReader(data)
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	return out
+}
+
+func TestExtractArchiveFormats(t *testing.T) {
+	for name, makeArchive := range map[string]func(*testing.T) ([]byte, string, ArchiveFormat){
+		"Tar": func(t *testing.T) ([]byte, string, ArchiveFormat) {
+			return makeTestTar(t, testArchiveEntries), ".tar", ArchiveTar
+		},
+		"TarGz": func(t *testing.T) ([]byte, string, ArchiveFormat) {
+			buf := &bytes.Buffer{}
+			gzw := gzip.NewWriter(buf)
+			_, err := gzw.Write(makeTestTar(t, testArchiveEntries))
+			require.NoError(t, err)
+			require.NoError(t, gzw.Close())
+			return buf.Bytes(), ".tgz", ArchiveTarGz
+		},
+		"TarBz2": func(t *testing.T) ([]byte, string, ArchiveFormat) {
+			return compressWithCommand(t, "bzip2", makeTestTar(t, testArchiveEntries)), ".tar.bz2", ArchiveTarBz2
+		},
+		"TarXz": func(t *testing.T) ([]byte, string, ArchiveFormat) {
+			buf := &bytes.Buffer{}
+			xzw, err := xz.NewWriter(buf)
+			require.NoError(t, err)
+			_, err = xzw.Write(makeTestTar(t, testArchiveEntries))
+			require.NoError(t, err)
+			require.NoError(t, xzw.Close())
+			return buf.Bytes(), ".tar.xz", ArchiveTarXz
+		},
+		"TarZst": func(t *testing.T) ([]byte, string, ArchiveFormat) {
+			return compressWithCommand(t, "zstd", makeTestTar(t, testArchiveEntries)), ".tar.zst", ArchiveTarZst
+		},
+		"Zip": func(t *testing.T) ([]byte, string, ArchiveFormat) {
+			return makeTestZip(t, testArchiveEntries), ".zip", ArchiveZip
+		},
+		// "": func(t *testing.T) ([]byte, string, ArchiveFormat) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, ext, format := makeArchive(t)
+
+			dir, err := ioutil.TempDir("build", "archive")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			for _, test := range []struct {
+				name     string
+				fileName string
+				format   ArchiveFormat
+			}{
+				{name: "ExplicitFormat", fileName: "archive", format: format},
+				{name: "DetectedFromExtension", fileName: "archive" + ext, format: ArchiveAuto},
+				{name: "DetectedFromContents", fileName: "archive", format: ArchiveAuto},
+			} {
+				t.Run(test.name, func(t *testing.T) {
+					archive := filepath.Join(dir, test.name, test.fileName)
+					target := filepath.Join(dir, test.name, "out")
+					require.NoError(t, os.MkdirAll(filepath.Dir(archive), 0755))
+					require.NoError(t, ioutil.WriteFile(archive, data, 0644))
+
+					report, err := doExtract(DownloadInfo{
+						Path:        archive,
+						ArchiveOpts: ArchiveOptions{ShouldExtract: true, Format: test.format, TargetPath: target},
+					})
+					require.NoError(t, err)
+					assert.Len(t, report.Files, 2)
+					assert.Contains(t, report.Files, "root/bin/tool")
+					assert.EqualValues(t, len("tool")+len("readme"), report.Size)
+
+					contents, err := ioutil.ReadFile(filepath.Join(target, "root", "bin", "tool"))
+					require.NoError(t, err)
+					assert.Equal(t, "tool", string(contents))
+					contents, err = ioutil.ReadFile(filepath.Join(target, "root", "README.txt"))
+					require.NoError(t, err)
+					assert.Equal(t, "readme", string(contents))
+				})
+			}
+		})
+	}
+}
+
+func TestExtractArchiveOptions(t *testing.T) {
+	for name, test := range map[string]func(*testing.T, func([]testArchiveEntry, ArchiveOptions) (string, error)){
+		"StripComponentsRemovesLeadingDirectories": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract(testArchiveEntries, ArchiveOptions{StripComponents: 1})
+			require.NoError(t, err)
+			assertArchiveFiles(t, target, "bin/tool", "README.txt")
+		},
+		"StripComponentsSkipsShortPaths": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract(testArchiveEntries, ArchiveOptions{StripComponents: 2})
+			require.NoError(t, err)
+			assertArchiveFiles(t, target, "tool")
+		},
+		"IncludeMatchesEntriesAndDirectories": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract(testArchiveEntries, ArchiveOptions{Include: []string{"root/bin"}})
+			require.NoError(t, err)
+			assertArchiveFiles(t, target, "root/bin/tool")
+		},
+		"ExcludeOverridesInclude": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract(testArchiveEntries, ArchiveOptions{StripComponents: 1, Include: []string{"*"}, Exclude: []string{"*.txt"}})
+			require.NoError(t, err)
+			assertArchiveFiles(t, target, "bin/tool")
+		},
+		"RejectsParentDirectoryEntries": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract([]testArchiveEntry{{name: "foo/../../evil", body: "evil"}}, ArchiveOptions{})
+			assert.Error(t, err)
+			_, err = os.Stat(filepath.Join(filepath.Dir(target), "evil"))
+			assert.True(t, os.IsNotExist(err))
+		},
+		"RejectsAbsoluteEntries": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			_, err := extract([]testArchiveEntry{{name: "/tmp/evil", body: "evil"}}, ArchiveOptions{})
+			assert.Error(t, err)
+		},
+		"CreatesSymlinksInsideTarget": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract([]testArchiveEntry{
+				{name: "lib/libfoo.so.1", body: "foo"},
+				{name: "lib/libfoo.so", typeflag: tar.TypeSymlink, linkname: "libfoo.so.1"},
+				{name: "bin/libfoo.so", typeflag: tar.TypeSymlink, linkname: "../lib/libfoo.so"},
+			}, ArchiveOptions{})
+			require.NoError(t, err)
+			contents, err := ioutil.ReadFile(filepath.Join(target, "bin", "libfoo.so"))
+			require.NoError(t, err)
+			assert.Equal(t, "foo", string(contents))
+		},
+		"RejectsSymlinksOutsideTarget": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			_, err := extract([]testArchiveEntry{{name: "link", typeflag: tar.TypeSymlink, linkname: "../archive"}}, ArchiveOptions{})
+			assert.Error(t, err)
+		},
+		"RejectsAbsoluteSymlinks": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			_, err := extract([]testArchiveEntry{{name: "link", typeflag: tar.TypeSymlink, linkname: "/etc/passwd"}}, ArchiveOptions{})
+			assert.Error(t, err)
+		},
+		"RejectsSymlinksThatEscapeThroughOtherSymlinks": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract([]testArchiveEntry{
+				{name: "dir", typeflag: tar.TypeSymlink, linkname: "."},
+				{name: "link", typeflag: tar.TypeSymlink, linkname: "dir/../archive"},
+			}, ArchiveOptions{})
+			assert.Error(t, err)
+			_, err = os.Lstat(filepath.Join(target, "link"))
+			assert.True(t, os.IsNotExist(err))
+		},
+		"DoesNotWriteThroughSymlinks": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			_, err := extract([]testArchiveEntry{
+				{name: "dir", typeflag: tar.TypeSymlink, linkname: "."},
+				{name: "dir/file", body: "file"},
+			}, ArchiveOptions{})
+			assert.Error(t, err)
+		},
+		"DoesNotWriteThroughExistingSymlinks": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract(nil, ArchiveOptions{})
+			require.NoError(t, err)
+			outside := filepath.Join(filepath.Dir(target), "outside")
+			require.NoError(t, os.MkdirAll(outside, 0755))
+			require.NoError(t, os.MkdirAll(target, 0755))
+			require.NoError(t, os.Symlink(outside, filepath.Join(target, "escape")))
+
+			_, err = extract([]testArchiveEntry{{name: "escape/file", body: "evil"}}, ArchiveOptions{})
+			assert.Error(t, err)
+			_, err = os.Stat(filepath.Join(outside, "file"))
+			assert.True(t, os.IsNotExist(err))
+		},
+		"EnforcesMaxSize": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			target, err := extract(testArchiveEntries, ArchiveOptions{MaxSize: 8})
+			assert.Error(t, err)
+			_, err = os.Stat(filepath.Join(target, "root", "README.txt"))
+			assert.True(t, os.IsNotExist(err))
+
+			_, err = extract(testArchiveEntries, ArchiveOptions{MaxSize: 10})
+			assert.NoError(t, err)
+		},
+		"EnforcesMaxFiles": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {
+			_, err := extract(testArchiveEntries, ArchiveOptions{MaxFiles: 3})
+			assert.Error(t, err)
+
+			_, err = extract(testArchiveEntries, ArchiveOptions{MaxFiles: 4})
+			assert.NoError(t, err)
+		},
+		// "": func(t *testing.T, extract func([]testArchiveEntry, ArchiveOptions) (string, error)) {},
+	} {
+		for format, makeArchive := range map[ArchiveFormat]func(*testing.T, []testArchiveEntry) []byte{
+			ArchiveTar: makeTestTar,
+			ArchiveZip: makeTestZip,
+		} {
+			t.Run(name+"/"+string(format), func(t *testing.T) {
+				dir, err := ioutil.TempDir("build", "archive")
+				require.NoError(t, err)
+				defer os.RemoveAll(dir)
+
+				test(t, func(entries []testArchiveEntry, opts ArchiveOptions) (string, error) {
+					archive := filepath.Join(dir, "archive")
+					require.NoError(t, ioutil.WriteFile(archive, makeArchive(t, entries), 0644))
+
+					opts.ShouldExtract = true
+					opts.Format = format
+					opts.TargetPath = filepath.Join(dir, "out")
+					_, err := doExtract(DownloadInfo{Path: archive, ArchiveOpts: opts})
+					return opts.TargetPath, err
+				})
+			})
+		}
+	}
+}
+
+func TestExtractTarLinks(t *testing.T) {
+	dir, err := ioutil.TempDir("build", "archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "archive.tar")
+	require.NoError(t, ioutil.WriteFile(archive, makeTestTar(t, []testArchiveEntry{
+		{name: "bin/tool", body: "tool"},
+		{name: "bin/symlink", typeflag: tar.TypeSymlink, linkname: "tool"},
+		{name: "bin/hardlink", typeflag: tar.TypeLink, linkname: "bin/tool"},
+	}), 0644))
+
+	target := filepath.Join(dir, "out")
+	_, err = doExtract(DownloadInfo{
+		Path:        archive,
+		ArchiveOpts: ArchiveOptions{ShouldExtract: true, Format: ArchiveAuto, TargetPath: target},
+	})
+	require.NoError(t, err)
+
+	linkname, err := os.Readlink(filepath.Join(target, "bin", "symlink"))
+	require.NoError(t, err)
+	assert.Equal(t, "tool", linkname)
+
+	contents, err := ioutil.ReadFile(filepath.Join(target, "bin", "hardlink"))
+	require.NoError(t, err)
+	assert.Equal(t, "tool", string(contents))
+}
+
+func TestExtractTarZstWithoutCommand(t *testing.T) {
+	archive := compressWithCommand(t, "zstd", makeTestTar(t, testArchiveEntries))
+	dir, err := ioutil.TempDir("build", "archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	dir, err = filepath.Abs(dir)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "archive.tar.zst")
+	require.NoError(t, ioutil.WriteFile(path, archive, 0644))
+
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	require.NoError(t, os.Setenv("PATH", dir))
+
+	_, err = doExtract(DownloadInfo{
+		Path:        path,
+		ArchiveOpts: ArchiveOptions{ShouldExtract: true, Format: ArchiveAuto, TargetPath: filepath.Join(dir, "out")},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not supported on this host")
+}
+
+func TestArchiveOptionsValidate(t *testing.T) {
+	target, err := filepath.Abs("build")
+	require.NoError(t, err)
+
+	valid := ArchiveOptions{ShouldExtract: true, Format: ArchiveTarZst, TargetPath: target, StripComponents: 1, Include: []string{"bin/*"}}
+	assert.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.StripComponents = -1
+	assert.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.Exclude = []string{"["}
+	assert.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.MaxSize = -1
+	assert.Error(t, invalid.Validate())
+}
+
+// assertArchiveFiles asserts that the regular files under the directory
+// are exactly the given slash-separated paths.
+func assertArchiveFiles(t *testing.T, dir string, expected ...string) {
+	actual := []string{}
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			actual = append(actual, filepath.ToSlash(rel))
+		}
+		return nil
+	}))
+	assert.Len(t, actual, len(expected))
+	for _, path := range expected {
+		assert.Contains(t, actual, path)
+	}
+}
@@ -0,0 +1,376 @@
+package jasper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ArtifactOutput is the name of the artifact that holds the
+	// standard output of a process.
+	ArtifactOutput = "stdout.log"
+	// ArtifactError is the name of the artifact that holds the
+	// standard error of a process.
+	ArtifactError = "stderr.log"
+	// ArtifactInfo is the name of the artifact that holds the
+	// ProcessInfo of a process, as JSON, after it completes.
+	ArtifactInfo = "info.json"
+
+	// DefaultArtifactPruneDelay is the duration between prunes of the
+	// artifact directory.
+	DefaultArtifactPruneDelay = time.Minute
+
+	// artifactStagingPrefix is the prefix of the directories that hold
+	// the artifacts of processes that have not completed.
+	artifactStagingPrefix = ".staging-"
+)
+
+// ArtifactOptions configures a directory in which the output and the
+// final state of processes are kept. Each process' artifacts are
+// stored in "<Directory>/<id>/". Directories older than MaxAge are
+// removed, and the oldest directories are removed while the total size
+// of all artifacts exceeds MaxSize bytes; zero values disable the
+// corresponding limit. Retention is enforced every PruneDelay, which
+// defaults to DefaultArtifactPruneDelay.
+type ArtifactOptions struct {
+	Directory  string        `json:"directory"`
+	MaxAge     time.Duration `json:"max_age"`
+	MaxSize    int64         `json:"max_size"`
+	PruneDelay time.Duration `json:"prune_delay"`
+}
+
+// Validate ensures that the ArtifactOptions is valid, and sets the
+// default PruneDelay if it is unset.
+func (opts *ArtifactOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	if opts.Directory == "" {
+		catcher.Add(errors.New("must specify an artifact directory"))
+	}
+	if opts.MaxAge < 0 || opts.MaxSize < 0 || opts.PruneDelay < 0 {
+		catcher.Add(errors.New("cannot have negative max age, max size, or prune delay"))
+	}
+	if opts.PruneDelay == 0 {
+		opts.PruneDelay = DefaultArtifactPruneDelay
+	}
+	return catcher.Resolve()
+}
+
+// ProcessArtifacts is a directory of process artifacts. Use
+// ArtifactMiddleware to capture the artifacts of the processes created
+// by a Manager, and the services' artifact routes to fetch them, even
+// after the processes have been cleared from the manager.
+type ProcessArtifacts struct {
+	opts ArtifactOptions
+}
+
+// NewProcessArtifacts validates the options and creates the artifact
+// directory, if it does not already exist.
+func NewProcessArtifacts(opts ArtifactOptions) (*ProcessArtifacts, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid artifact options")
+	}
+	if err := os.MkdirAll(opts.Directory, 0755); err != nil {
+		return nil, errors.Wrapf(err, "problem creating artifact directory '%s'", opts.Directory)
+	}
+
+	return &ProcessArtifacts{opts: opts}, nil
+}
+
+// Options returns the options of the artifact directory.
+func (a *ProcessArtifacts) Options() ArtifactOptions { return a.opts }
+
+// Open opens the artifact with the given name (one of ArtifactOutput,
+// ArtifactError, or ArtifactInfo) of the process with the given ID.
+// Artifacts are only available once the process has completed.
+func (a *ProcessArtifacts) Open(id, name string) (*os.File, error) {
+	switch name {
+	case ArtifactOutput, ArtifactError, ArtifactInfo:
+	default:
+		return nil, errors.Errorf("unknown artifact '%s'", name)
+	}
+	if id == "" || strings.HasPrefix(id, ".") || strings.ContainsAny(id, `/\`) {
+		return nil, errors.Errorf("invalid process ID '%s'", id)
+	}
+
+	file, err := os.Open(filepath.Join(a.opts.Directory, id, name))
+	if os.IsNotExist(err) {
+		return nil, errors.Errorf("no artifact '%s' found for process '%s'", name, id)
+	}
+	return file, errors.Wrapf(err, "problem opening artifact '%s' for process '%s'", name, id)
+}
+
+// Prune removes the artifacts of processes that are older than the
+// maximum age, and then the oldest artifacts until the total size is
+// within the maximum size.
+func (a *ProcessArtifacts) Prune() error {
+	if a.opts.MaxAge == 0 && a.opts.MaxSize == 0 {
+		return nil
+	}
+
+	entries, err := ioutil.ReadDir(a.opts.Directory)
+	if err != nil {
+		return errors.Wrapf(err, "problem reading artifact directory '%s'", a.opts.Directory)
+	}
+
+	type artifactDir struct {
+		path    string
+		modTime time.Time
+		size    int64
+	}
+
+	catcher := grip.NewBasicCatcher()
+	dirs := []artifactDir{}
+	var total int64
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		dir := artifactDir{path: filepath.Join(a.opts.Directory, entry.Name()), modTime: entry.ModTime()}
+		catcher.Add(filepath.Walk(dir.path, func(_ string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				dir.size += info.Size()
+			}
+			return nil
+		}))
+
+		if a.opts.MaxAge > 0 && time.Since(dir.modTime) > a.opts.MaxAge {
+			catcher.Add(os.RemoveAll(dir.path))
+			continue
+		}
+		dirs = append(dirs, dir)
+		total += dir.size
+	}
+
+	if a.opts.MaxSize > 0 {
+		sort.Slice(dirs, func(i, j int) bool { return dirs[i].modTime.Before(dirs[j].modTime) })
+		for _, dir := range dirs {
+			if total <= a.opts.MaxSize {
+				break
+			}
+			catcher.Add(os.RemoveAll(dir.path))
+			total -= dir.size
+		}
+	}
+
+	return errors.Wrap(catcher.Resolve(), "problem pruning artifacts")
+}
+
+// capture returns a copy of the options that redirects the output of the
+// process into a staging directory, until the process completes. The
+// caller's options are not modified.
+func (a *ProcessArtifacts) capture(opts *CreateOptions) (*CreateOptions, *artifactCapture, error) {
+	dir, err := ioutil.TempDir(a.opts.Directory, artifactStagingPrefix)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "problem creating artifact staging directory")
+	}
+
+	c := &artifactCapture{artifacts: a, dir: dir}
+	if c.output, err = c.create(ArtifactOutput); err != nil {
+		c.abort()
+		return nil, nil, err
+	}
+	if c.error, err = c.create(ArtifactError); err != nil {
+		c.abort()
+		return nil, nil, err
+	}
+
+	captured := *opts
+	if !captured.Output.SuppressOutput {
+		captured.Output.Output = teeWriter(opts.Output.Output, c.output)
+	}
+	if !captured.Output.SuppressError {
+		captured.Output.Error = teeWriter(opts.Output.Error, c.error)
+	}
+
+	return &captured, c, nil
+}
+
+func teeWriter(existing io.Writer, w io.Writer) io.Writer {
+	if existing == nil {
+		return w
+	}
+	return io.MultiWriter(existing, w)
+}
+
+// artifactCapture holds the artifacts of a single process while it runs.
+type artifactCapture struct {
+	artifacts *ProcessArtifacts
+	dir       string
+	output    *artifactFile
+	error     *artifactFile
+	once      sync.Once
+}
+
+func (c *artifactCapture) create(name string) (*artifactFile, error) {
+	file, err := os.Create(filepath.Join(c.dir, name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "problem creating artifact '%s'", name)
+	}
+	return &artifactFile{file: file}, nil
+}
+
+func (c *artifactCapture) close() error {
+	catcher := grip.NewBasicCatcher()
+	for _, f := range []*artifactFile{c.output, c.error} {
+		if f != nil {
+			catcher.Add(f.Close())
+		}
+	}
+	return catcher.Resolve()
+}
+
+// abort discards the artifacts of a process that failed to start.
+func (c *artifactCapture) abort() {
+	c.once.Do(func() {
+		grip.Warning(c.close())
+		grip.Warning(os.RemoveAll(c.dir))
+	})
+}
+
+// finish writes the info of the completed process and moves its
+// artifacts into place.
+func (c *artifactCapture) finish(info ProcessInfo) {
+	c.once.Do(func() {
+		catcher := grip.NewBasicCatcher()
+		// Flush the lines that the output is still buffering into the
+		// artifacts, since the options' closers may not have run yet.
+		catcher.Add(info.Options.Output.flushOutput())
+		catcher.Add(c.close())
+
+		payload, err := json.MarshalIndent(info.Redacted(), "", "  ")
+		catcher.Add(errors.Wrap(err, "problem marshaling process info"))
+		if err == nil {
+			catcher.Add(ioutil.WriteFile(filepath.Join(c.dir, ArtifactInfo), payload, 0644))
+		}
+
+		dest := filepath.Join(c.artifacts.opts.Directory, info.ID)
+		if err = os.Rename(c.dir, dest); err != nil {
+			catcher.Add(errors.Wrapf(err, "problem moving artifacts to '%s'", dest))
+			catcher.Add(os.RemoveAll(c.dir))
+		}
+
+		grip.Warning(message.WrapError(catcher.Resolve(), message.Fields{
+			"message": "problem saving process artifacts",
+			"id":      info.ID,
+		}))
+	})
+}
+
+// artifactFile is a file that discards writes once it is closed, so
+// that a respawned process, which inherits the output writers of the
+// original process, does not fail when writing to it.
+type artifactFile struct {
+	file   *os.File
+	closed bool
+	mu     sync.Mutex
+}
+
+func (f *artifactFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return len(p), nil
+	}
+	return f.file.Write(p)
+}
+
+func (f *artifactFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	return f.file.Close()
+}
+
+// ArtifactMiddleware captures the standard output and standard error of
+// every process created by the wrapped Manager, along with its final
+// ProcessInfo, into the given artifact directory, and enforces the
+// directory's retention policy in the background until the manager is
+// closed. Only processes that run on the local host can be captured,
+// so the wrapped Manager should not be a remote client.
+func ArtifactMiddleware(artifacts *ProcessArtifacts) ManagerMiddleware {
+	return func(m Manager) Manager {
+		am := &artifactManager{
+			Manager:   m,
+			artifacts: artifacts,
+			done:      make(chan struct{}),
+		}
+		go am.backgroundPrune()
+		return am
+	}
+}
+
+type artifactManager struct {
+	Manager
+	artifacts *ProcessArtifacts
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (m *artifactManager) backgroundPrune() {
+	timer := time.NewTimer(m.artifacts.opts.PruneDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-timer.C:
+			grip.Error(errors.Wrap(m.artifacts.Prune(), "error during artifact pruning"))
+			timer.Reset(m.artifacts.opts.PruneDelay)
+		}
+	}
+}
+
+func (m *artifactManager) Create(ctx context.Context, opts *CreateOptions) (Process, error) {
+	if opts == nil {
+		return nil, errors.New("cannot create a process without options")
+	}
+
+	opts, c, err := m.artifacts.capture(opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	proc, err := m.Manager.Create(ctx, opts)
+	if err != nil {
+		c.abort()
+		return nil, errors.WithStack(err)
+	}
+
+	if err = proc.RegisterTrigger(ctx, c.finish); err != nil {
+		// The process completed before the trigger was registered.
+		if !proc.Complete(ctx) {
+			c.abort()
+			return proc, errors.Wrap(err, "problem registering artifact trigger")
+		}
+		c.finish(proc.Info(ctx))
+	}
+
+	return proc, nil
+}
+
+func (m *artifactManager) Close(ctx context.Context) error {
+	m.closeOnce.Do(func() { close(m.done) })
+
+	return errors.WithStack(m.Manager.Close(ctx))
+}
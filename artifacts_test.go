@@ -0,0 +1,316 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactOptions(t *testing.T) {
+	opts := ArtifactOptions{Directory: "foo"}
+	require.NoError(t, opts.Validate())
+	assert.Equal(t, DefaultArtifactPruneDelay, opts.PruneDelay)
+
+	for name, opts := range map[string]ArtifactOptions{
+		"MissingDirectory": {},
+		"NegativeMaxAge":   {Directory: "foo", MaxAge: -1},
+		"NegativeMaxSize":  {Directory: "foo", MaxSize: -1},
+		"NegativeDelay":    {Directory: "foo", PruneDelay: -1},
+	} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			assert.Error(t, opts.Validate())
+		})
+	}
+}
+
+func makeArtifactDir(t *testing.T, root, id string, size int, age time.Duration) {
+	dir := filepath.Join(root, id)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ArtifactOutput), bytes.Repeat([]byte("x"), size), 0644))
+	modTime := time.Now().Add(-age)
+	require.NoError(t, os.Chtimes(dir, modTime, modTime))
+}
+
+func TestProcessArtifacts(t *testing.T) {
+	for name, test := range map[string]func(*testing.T, *ProcessArtifacts, string){
+		"OpenRejectsUnknownNames": func(t *testing.T, a *ProcessArtifacts, root string) {
+			makeArtifactDir(t, root, "foo", 1, 0)
+			_, err := a.Open("foo", "bar")
+			assert.Error(t, err)
+		},
+		"OpenRejectsInvalidIDs": func(t *testing.T, a *ProcessArtifacts, root string) {
+			for _, id := range []string{"", "..", ".staging-foo", "../foo", `foo\bar`} {
+				_, err := a.Open(id, ArtifactOutput)
+				assert.Error(t, err, id)
+			}
+		},
+		"OpenMissingArtifact": func(t *testing.T, a *ProcessArtifacts, root string) {
+			_, err := a.Open("foo", ArtifactOutput)
+			assert.Error(t, err)
+		},
+		"PruneWithoutLimitsKeepsEverything": func(t *testing.T, a *ProcessArtifacts, root string) {
+			makeArtifactDir(t, root, "old", 100, 24*time.Hour)
+			require.NoError(t, a.Prune())
+			_, err := os.Stat(filepath.Join(root, "old"))
+			assert.NoError(t, err)
+		},
+		"PruneRemovesOldArtifacts": func(t *testing.T, a *ProcessArtifacts, root string) {
+			a.opts.MaxAge = time.Hour
+			makeArtifactDir(t, root, "old", 1, 2*time.Hour)
+			makeArtifactDir(t, root, "new", 1, 0)
+			require.NoError(t, os.MkdirAll(filepath.Join(root, artifactStagingPrefix+"foo"), 0755))
+			staging := time.Now().Add(-2 * time.Hour)
+			require.NoError(t, os.Chtimes(filepath.Join(root, artifactStagingPrefix+"foo"), staging, staging))
+
+			require.NoError(t, a.Prune())
+			_, err := os.Stat(filepath.Join(root, "old"))
+			assert.True(t, os.IsNotExist(err))
+			_, err = os.Stat(filepath.Join(root, "new"))
+			assert.NoError(t, err)
+			_, err = os.Stat(filepath.Join(root, artifactStagingPrefix+"foo"))
+			assert.NoError(t, err, "staging directories should not be pruned")
+		},
+		"PruneRemovesOldestArtifactsOverMaxSize": func(t *testing.T, a *ProcessArtifacts, root string) {
+			a.opts.MaxSize = 250
+			makeArtifactDir(t, root, "oldest", 100, 3*time.Minute)
+			makeArtifactDir(t, root, "older", 100, 2*time.Minute)
+			makeArtifactDir(t, root, "newest", 100, time.Minute)
+
+			require.NoError(t, a.Prune())
+			_, err := os.Stat(filepath.Join(root, "oldest"))
+			assert.True(t, os.IsNotExist(err))
+			for _, id := range []string{"older", "newest"} {
+				_, err = os.Stat(filepath.Join(root, id))
+				assert.NoError(t, err, id)
+			}
+		},
+		// "": func(t *testing.T, a *ProcessArtifacts, root string) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			root, err := ioutil.TempDir("build", "artifacts")
+			require.NoError(t, err)
+			defer os.RemoveAll(root)
+
+			a, err := NewProcessArtifacts(ArtifactOptions{Directory: root})
+			require.NoError(t, err)
+			test(t, a, root)
+		})
+	}
+}
+
+func TestArtifactMiddleware(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a shell script")
+	}
+
+	for name, test := range map[string]func(context.Context, *testing.T, Manager, *ProcessArtifacts){
+		"CapturesOutputAndInfo": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			proc, err := manager.Create(ctx, &CreateOptions{Args: []string{"sh", "-c", "echo foo; echo bar >&2; exit 3"}})
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.Error(t, err)
+
+			for name, expected := range map[string]string{ArtifactOutput: "foo\n", ArtifactError: "bar\n"} {
+				file, err := a.Open(proc.ID(), name)
+				require.NoError(t, err)
+				contents, err := ioutil.ReadAll(file)
+				require.NoError(t, file.Close())
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(contents))
+			}
+
+			file, err := a.Open(proc.ID(), ArtifactInfo)
+			require.NoError(t, err)
+			defer file.Close()
+			info := ProcessInfo{}
+			require.NoError(t, json.NewDecoder(file).Decode(&info))
+			assert.Equal(t, proc.ID(), info.ID)
+			assert.Equal(t, 3, info.ExitCode)
+			assert.True(t, info.Complete)
+			assert.False(t, info.Successful)
+		},
+		"PreservesExistingWritersAndRedaction": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			buf := &bytes.Buffer{}
+			opts := &CreateOptions{
+				Args:        []string{"sh", "-c", "echo $TOKEN"},
+				Environment: map[string]string{"TOKEN": "s3cr3t"},
+			}
+			opts.Output.Output = buf
+			opts.Output.Redaction.EnvVars = []string{"TOKEN"}
+			proc, err := manager.Create(ctx, opts)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			assert.Equal(t, RedactedValue+"\n", buf.String())
+
+			file, err := a.Open(proc.ID(), ArtifactOutput)
+			require.NoError(t, err)
+			defer file.Close()
+			contents, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			assert.Equal(t, RedactedValue+"\n", string(contents))
+
+			infoFile, err := a.Open(proc.ID(), ArtifactInfo)
+			require.NoError(t, err)
+			defer infoFile.Close()
+			info := ProcessInfo{}
+			require.NoError(t, json.NewDecoder(infoFile).Decode(&info))
+			assert.Equal(t, RedactedValue, info.Options.Environment["TOKEN"])
+		},
+		"SuppressedOutputIsEmpty": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			opts := &CreateOptions{Args: []string{"echo", "foo"}}
+			opts.Output.SuppressOutput = true
+			proc, err := manager.Create(ctx, opts)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			file, err := a.Open(proc.ID(), ArtifactOutput)
+			require.NoError(t, err)
+			defer file.Close()
+			contents, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			assert.Empty(t, contents)
+		},
+		"FailedCreateLeavesNoArtifacts": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			_, err := manager.Create(ctx, &CreateOptions{})
+			require.Error(t, err)
+
+			entries, err := ioutil.ReadDir(a.Options().Directory)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		},
+		"ArtifactsOutliveClear": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			proc, err := manager.Create(ctx, trueCreateOpts())
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			manager.Clear(ctx)
+			_, err = manager.Get(ctx, proc.ID())
+			require.Error(t, err)
+
+			file, err := a.Open(proc.ID(), ArtifactInfo)
+			require.NoError(t, err)
+			assert.NoError(t, file.Close())
+		},
+		"RespawnedProcessDoesNotFail": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			proc, err := manager.Create(ctx, &CreateOptions{Args: []string{"echo", "foo"}})
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			respawned, err := proc.Respawn(ctx)
+			require.NoError(t, err)
+			_, err = respawned.Wait(ctx)
+			assert.NoError(t, err)
+		},
+		"BackgroundPruneEnforcesRetention": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			makeArtifactDir(t, a.Options().Directory, "old", 1, 2*time.Hour)
+
+			timer := time.NewTimer(0)
+			defer timer.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					assert.Fail(t, "artifacts were not pruned")
+					return
+				case <-timer.C:
+					if _, err := os.Stat(filepath.Join(a.Options().Directory, "old")); os.IsNotExist(err) {
+						return
+					}
+					timer.Reset(10 * time.Millisecond)
+				}
+			}
+		},
+		"CapturesFlushedOutput": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			opts := &CreateOptions{
+				Args:        []string{"sh", "-c", "printf $TOKEN"},
+				Environment: map[string]string{"TOKEN": "s3cr3t"},
+			}
+			opts.Output.Redaction.EnvVars = []string{"TOKEN"}
+			proc, err := manager.Create(ctx, opts)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			file, err := a.Open(proc.ID(), ArtifactOutput)
+			require.NoError(t, err)
+			defer file.Close()
+			contents, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			assert.Equal(t, RedactedValue, string(contents))
+		},
+		"FinishFlushesBufferedOutput": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			opts := &CreateOptions{Args: []string{"true"}, Environment: map[string]string{"TOKEN": "s3cr3t"}}
+			opts.Output.Redaction.EnvVars = []string{"TOKEN"}
+			opts, c, err := a.capture(opts)
+			require.NoError(t, err)
+			cmd, err := opts.Resolve(ctx)
+			require.NoError(t, err)
+
+			_, err = cmd.Stdout.Write([]byte("s3cr3t"))
+			require.NoError(t, err)
+			c.finish(ProcessInfo{ID: "foo", Options: *opts})
+
+			file, err := a.Open("foo", ArtifactOutput)
+			require.NoError(t, err)
+			defer file.Close()
+			contents, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			assert.Equal(t, RedactedValue, string(contents))
+			opts.Close()
+		},
+		"CreateDoesNotModifyOptions": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			output, errOutput := &bytes.Buffer{}, &bytes.Buffer{}
+			opts := &CreateOptions{Args: []string{"echo", "foo"}}
+			opts.Output.Output = output
+			opts.Output.Error = errOutput
+
+			proc, err := manager.Create(ctx, opts)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+			assert.True(t, opts.Output.Output == output)
+			assert.True(t, opts.Output.Error == errOutput)
+			assert.Equal(t, "foo\n", output.String())
+
+			opts.Args = nil
+			_, err = manager.Create(ctx, opts)
+			require.Error(t, err)
+			assert.True(t, opts.Output.Output == output)
+			assert.True(t, opts.Output.Error == errOutput)
+		},
+		"CreateRejectsNilOptions": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {
+			_, err := manager.Create(ctx, nil)
+			assert.Error(t, err)
+		},
+		// "": func(ctx context.Context, t *testing.T, manager Manager, a *ProcessArtifacts) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+			defer cancel()
+
+			root, err := ioutil.TempDir("build", "artifacts")
+			require.NoError(t, err)
+			defer os.RemoveAll(root)
+
+			a, err := NewProcessArtifacts(ArtifactOptions{Directory: root, MaxAge: time.Hour, PruneDelay: 10 * time.Millisecond})
+			require.NoError(t, err)
+			manager := WrapManager(NewLocalManager(), ArtifactMiddleware(a))
+			defer manager.Close(ctx)
+
+			test(ctx, t, manager, a)
+		})
+	}
+}
@@ -10,12 +10,20 @@ import (
 	"github.com/google/shlex"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/send"
 	"github.com/pkg/errors"
 )
 
 // CreateOptions contains options related to starting a process. This includes
 // execution configuration, post-execution triggers, and output configuration.
+//
+// Timeout and TimeoutSecs both limit how long the process runs. TimeoutSecs
+// is the whole number of seconds in Timeout: Validate fills in whichever of
+// them is not set, and rejects options that set both to different values.
+// The REST API encodes Timeout, in nanoseconds, as "timeout" alongside
+// "timeout_secs", so REST clients that only send "timeout_secs" are still
+// supported, but clients that send both must send values that agree.
 type CreateOptions struct {
 	Args             []string          `json:"args"`
 	Environment      map[string]string `json:"env,omitempty"`
@@ -24,7 +32,7 @@ type CreateOptions struct {
 	Output           OutputOptions     `json:"output"`
 	OverrideEnviron  bool              `json:"override_env,omitempty"`
 	TimeoutSecs      int               `json:"timeout_secs,omitempty"`
-	Timeout          time.Duration     `json:"-"`
+	Timeout          time.Duration     `json:"timeout,omitempty"`
 	Tags             []string          `json:"tags"`
 	OnSuccess        []*CreateOptions  `json:"on_success"`
 	OnFailure        []*CreateOptions  `json:"on_failure"`
@@ -68,9 +76,12 @@ func (opts *CreateOptions) Validate() error {
 	}
 
 	if opts.Timeout != 0 && opts.TimeoutSecs != 0 {
-		if time.Duration(opts.TimeoutSecs)*time.Second != opts.Timeout {
+		// TimeoutSecs is the whole number of seconds in Timeout, so
+		// that validated options with a sub-second timeout remain
+		// valid.
+		if int(opts.Timeout.Seconds()) != opts.TimeoutSecs {
 			return errors.Errorf("cannot specify timeout (nanos) [%s] and timeout_secs [%d]",
-				opts.Timeout, opts.Timeout)
+				opts.Timeout, opts.TimeoutSecs)
 		}
 	}
 
@@ -137,6 +148,18 @@ func (opts *CreateOptions) Resolve(ctx context.Context) (*exec.Cmd, error) {
 	cmd := exec.CommandContext(ctx, opts.Args[0], args...) // nolint
 	cmd.Dir = opts.WorkingDirectory
 
+	opts.Output.setLogFileNameData(newLogFileNameData(opts))
+	opts.Output.killProcess = func() {
+		if cmd.Process != nil {
+			grip.Warning(message.WrapError(cmd.Process.Kill(), message.Fields{
+				"message": "problem killing process that exceeded its output limit",
+				"args":    opts.Args,
+			}))
+		}
+	}
+	if err = opts.Output.setupRedaction(opts.lookupEnv); err != nil {
+		return nil, err
+	}
 	cmd.Stdout, err = opts.Output.GetOutput()
 	if err != nil {
 		return nil, err
@@ -148,27 +171,35 @@ func (opts *CreateOptions) Resolve(ctx context.Context) (*exec.Cmd, error) {
 	cmd.Env = env
 
 	// Senders require Close() or else command output is not guaranteed to log.
-	opts.closers = append(opts.closers, func() (_ error) {
+	opts.closers = append(opts.closers, func() error {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(opts.Output.flushOutput())
 		if opts.Output.outputSender != nil {
-			opts.Output.outputSender.Close()
+			catcher.Add(opts.Output.outputSender.Close())
 		}
 		if opts.Output.errorSender != nil {
-			opts.Output.errorSender.Close()
-		}
-		if opts.Output.outputSender != nil {
-			opts.Output.outputSender.Sender.Close()
-		}
-		// Since senders are shared, only close error's senders if output hasn't already closed them.
-		if opts.Output.errorSender != nil && (opts.Output.SuppressOutput || opts.Output.SendOutputToError) {
-			opts.Output.errorSender.Sender.Close()
+			catcher.Add(opts.Output.errorSender.Close())
 		}
+		catcher.Add(opts.Output.closeLoggers())
 
-		return
+		return catcher.Resolve()
 	})
 
 	return cmd, nil
 }
 
+// lookupEnv returns the value that the environment variable with the given
+// name has in the process.
+func (opts *CreateOptions) lookupEnv(name string) (string, bool) {
+	if value, ok := opts.Environment[name]; ok {
+		return value, true
+	}
+	if opts.OverrideEnviron {
+		return "", false
+	}
+	return os.LookupEnv(name)
+}
+
 // AddEnvVar adds an environment variable to the CreateOptions struct on which
 // this method is called. If the Environment map is nil, this method will
 // instantiate one.
@@ -180,11 +211,14 @@ func (opts *CreateOptions) AddEnvVar(k, v string) {
 	opts.Environment[k] = v
 }
 
-// Close will execute the closer functions assigned to the CreateOptions. This
-// function is often called as a trigger at the end of a process' lifetime in
-// Jasper.
-func (opts *CreateOptions) Close() {
+// Close will execute the closer functions assigned to the CreateOptions and
+// return their errors. This function is often called as a trigger at the end
+// of a process' lifetime in Jasper.
+func (opts *CreateOptions) Close() error {
+	catcher := grip.NewBasicCatcher()
 	for _, c := range opts.closers {
-		c()
+		catcher.Add(c())
 	}
+
+	return catcher.Resolve()
 }
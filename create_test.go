@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -63,6 +64,12 @@ func TestCreateConstructor(t *testing.T) {
 	}
 }
 
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
 func TestCreateOptions(t *testing.T) {
 	t.Parallel()
 
@@ -172,13 +179,26 @@ func TestCreateOptions(t *testing.T) {
 		"ClosersAreAlwaysCalled": func(t *testing.T, opts *CreateOptions) {
 			var counter int
 			opts.closers = append(opts.closers,
-				func() (_ error) { counter++; return },
+				func() error { counter++; return errors.New("closer failed") },
 				func() (_ error) { counter += 2; return },
 			)
-			opts.Close()
+			assert.Error(t, opts.Close())
 			assert.Equal(t, counter, 3)
 
 		},
+		"OutputCloserReturnsFlushErrors": func(t *testing.T, opts *CreateOptions) {
+			opts.Output.Output = failingWriter{}
+			opts.Output.LineBuffer = LineBufferOptions{Enabled: true}
+
+			_, err := opts.Resolve(ctx)
+			require.NoError(t, err)
+			out, err := opts.Output.GetOutput()
+			require.NoError(t, err)
+			_, err = out.Write([]byte("partial"))
+			require.NoError(t, err)
+
+			assert.Error(t, opts.Close())
+		},
 		"ConflictingTimeoutOptions": func(t *testing.T, opts *CreateOptions) {
 			opts.TimeoutSecs = 100
 			opts.Timeout = time.Hour
@@ -199,6 +219,15 @@ func TestCreateOptions(t *testing.T) {
 			assert.NoError(t, opts.Validate())
 			assert.Equal(t, 1, opts.TimeoutSecs)
 		},
+		"SubSecondTimeoutRemainsValid": func(t *testing.T, opts *CreateOptions) {
+			opts.TimeoutSecs = 0
+			opts.Timeout = 1500 * time.Millisecond
+
+			assert.NoError(t, opts.Validate())
+			assert.Equal(t, 1, opts.TimeoutSecs)
+			assert.NoError(t, opts.Validate())
+			assert.Equal(t, 1500*time.Millisecond, opts.Timeout)
+		},
 		"ResolveFailsWithInvalidLoggingConfiguration": func(t *testing.T, opts *CreateOptions) {
 			opts.Output.Loggers = []Logger{Logger{Type: LogSumologic, Options: LogOptions{Format: LogFormatPlain}}}
 			cmd, err := opts.Resolve(ctx)
@@ -4,25 +4,51 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
 
-	"github.com/mholt/archiver"
-	"github.com/mongodb/amboy"
-	"github.com/mongodb/amboy/queue"
 	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
 	"github.com/tychoish/bond"
-	"github.com/tychoish/bond/recall"
 	"github.com/tychoish/lru"
 )
 
 // DownloadInfo represents the URL to download and the file path where it should be downloaded.
+//
+// If any of SHA256, SHA1, or MD5 are set, the hex-encoded digests of the
+// download are verified as it is written, and the file is removed if
+// they do not match. If FetchChecksum is set, the expected SHA256 digest
+// is read from the checksum file at the URL with ChecksumFileExtension
+// appended.
+//
+// URL may be an http://, https://, or file:// URL. The file is downloaded
+// from the first of the Sources that succeeds; the REST and RPC services
+// add the download sources that they are configured with to Sources.
+//
+// If MaxBytesPerSecond is set, the download is read no faster than that
+// rate. Downloads run by a service are also limited by the service's
+// DownloadOptions.
 type DownloadInfo struct {
-	URL         string         `json:"url"`
-	Path        string         `json:"path"`
-	ArchiveOpts ArchiveOptions `json:"archive_opts"`
+	URL           string          `json:"url"`
+	Path          string          `json:"path"`
+	ArchiveOpts   ArchiveOptions  `json:"archive_opts"`
+	SHA256        string          `json:"sha256,omitempty"`
+	SHA1          string          `json:"sha1,omitempty"`
+	MD5           string          `json:"md5,omitempty"`
+	FetchChecksum bool            `json:"fetch_checksum,omitempty"`
+	MaxRetries    int             `json:"max_retries,omitempty"`
+	RetryBackoff  time.Duration   `json:"retry_backoff,omitempty"`
+	Timeout       time.Duration   `json:"timeout,omitempty"`
+	Sources       DownloadSources `json:"sources,omitempty"`
+
+	MaxBytesPerSecond int64 `json:"max_bytes_per_second,omitempty"`
+
+	// limiter is the bandwidth limiter that the download shares with
+	// the other downloads of a DownloadJobs.
+	limiter *bandwidthLimiter
 }
 
 // Validate checks the download options.
@@ -33,41 +59,136 @@ func (info DownloadInfo) Validate() error {
 		catcher.Add(errors.New("download path must be an absolute path"))
 	}
 
+	catcher.Add(validateDownloadURL(info.URL))
+	catcher.Add(info.Sources.Validate())
+
+	if info.MaxRetries < 0 || info.RetryBackoff < 0 || info.Timeout < 0 {
+		catcher.Add(errors.New("cannot have negative retries, retry backoff, or timeout"))
+	}
+	if info.MaxBytesPerSecond < 0 {
+		catcher.Add(errors.New("cannot have a negative bandwidth limit"))
+	}
+
 	catcher.Add(info.ArchiveOpts.Validate())
+	catcher.Add(info.validateChecksums())
 
 	return catcher.Resolve()
 }
 
-// Download executes the download operation.
+// Download executes the download operation without a deadline. It is
+// equivalent to calling DownloadContext with a background context.
 func (info DownloadInfo) Download() error {
-	req, err := http.NewRequest(http.MethodGet, info.URL, nil)
-	if err != nil {
-		return errors.Wrap(err, "problem building request")
-	}
+	return info.DownloadContext(context.Background())
+}
+
+// DownloadContext executes the download operation. The download is written to
+// a partial file next to Path, which is renamed to Path once the download
+// completes and its checksums are verified.
+//
+// If the download fails with a network error or a 5xx or 429 status, it is
+// retried up to MaxRetries times, waiting RetryBackoff
+// (DefaultDownloadRetryBackoff by default) before the first retry and
+// twice as long before each subsequent retry. Retries resume the download
+// from where it stopped with an HTTP Range request, if the server supports
+// it. The download is canceled when the context is done, or after
+// Timeout, if it is set.
+func (info DownloadInfo) DownloadContext(ctx context.Context) error {
+	_, err := info.download(ctx, nil)
+	return err
+}
+
+// download executes the download operation, reporting the bytes
+// transferred to progress, if it is not nil. It returns what was written
+// if the download is extracted.
+func (info DownloadInfo) download(ctx context.Context, progress *downloadProgress) (ArchiveReport, error) {
+	ctx, cancel := info.withTimeout(ctx)
+	defer cancel()
 
 	client := bond.GetHTTPClient()
 	defer bond.PutHTTPClient(client)
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrapf(err, "problem downloading file for url %s", info.URL)
+	if err := info.resolveChecksum(ctx, client); err != nil {
+		return ArchiveReport{}, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("%s: could not download %s to path %s", resp.Status, info.URL, info.Path)
+
+	return info.transfer(ctx, client, progress)
+}
+
+// withTimeout returns a context that is canceled after the download's
+// Timeout, if it is set.
+func (info DownloadInfo) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if info.Timeout > 0 {
+		return context.WithTimeout(ctx, info.Timeout)
 	}
+	return context.WithCancel(ctx)
+}
 
-	if err = writeFile(resp.Body, info.Path); err != nil {
-		return err
+// transfer downloads the file from the first of the sources that
+// succeeds, and extracts it. The checksum must already be resolved if
+// FetchChecksum is set.
+func (info DownloadInfo) transfer(ctx context.Context, client *http.Client, progress *downloadProgress) (ArchiveReport, error) {
+	if err := makeEnclosingDirectories(filepath.Dir(info.Path)); err != nil {
+		return ArchiveReport{}, errors.Wrap(err, "problem making enclosing directories")
 	}
 
-	if info.ArchiveOpts.ShouldExtract {
-		if err = doExtract(info); err != nil {
-			return errors.Wrapf(err, "problem extracting file %s to path %s", info.Path, info.ArchiveOpts.TargetPath)
+	partial := info.Path + partialDownloadSuffix
+	limiters := []*bandwidthLimiter{info.limiter, newBandwidthLimiter(info.MaxBytesPerSecond)}
+	var err error
+	catcher := grip.NewBasicCatcher()
+	for _, url := range info.Sources.urls(info.URL) {
+		if err = os.Remove(partial); err != nil && !os.IsNotExist(err) {
+			return ArchiveReport{}, errors.Wrapf(err, "problem removing stale partial download %s", partial)
+		}
+
+		err = info.retry(ctx, func() (bool, error) {
+			return info.attempt(ctx, client, url, partial, progress, limiters)
+		})
+		if err == nil {
+			err = errors.Wrapf(os.Rename(partial, info.Path), "problem moving download to %s", info.Path)
+		}
+		if err == nil {
+			break
+		}
+		catcher.Add(errors.Wrapf(err, "could not download %s", url))
+		if ctx.Err() != nil {
+			break
+		}
+		grip.Debug(message.WrapError(err, message.Fields{
+			"message": "problem downloading from source, trying the next source",
+			"url":     info.URL,
+			"source":  url,
+		}))
+	}
+	if err != nil {
+		if rmErr := os.Remove(partial); rmErr != nil && !os.IsNotExist(rmErr) {
+			grip.Warning(errors.Wrapf(rmErr, "problem removing partial download %s", partial))
 		}
+		return ArchiveReport{}, errors.Wrapf(catcher.Resolve(), "could not download %s to path %s", info.URL, info.Path)
 	}
 
-	return nil
+	return info.extract()
+}
+
+// extract extracts the downloaded file, if it should be extracted, and
+// returns what was written.
+func (info DownloadInfo) extract() (ArchiveReport, error) {
+	if !info.ArchiveOpts.ShouldExtract {
+		return ArchiveReport{}, nil
+	}
+
+	report, err := doExtract(info)
+	if err != nil {
+		return report, errors.Wrapf(err, "problem extracting file %s to path %s", info.Path, info.ArchiveOpts.TargetPath)
+	}
+	grip.Debug(message.Fields{
+		"message": "extracted archive",
+		"archive": info.Path,
+		"target":  info.ArchiveOpts.TargetPath,
+		"files":   len(report.Files),
+		"size":    report.Size,
+	})
+
+	return report, nil
 }
 
 // ArchiveFormat represents an archive file type.
@@ -75,16 +196,26 @@ type ArchiveFormat string
 
 const (
 	// ArchiveAuto is an ArchiveFormat that does not force any particular type of
-	// archive format.
-	ArchiveAuto  ArchiveFormat = "auto"
-	ArchiveTarGz               = "targz" // nolint
-	ArchiveZip                 = "zip"   // nolint
+	// archive format. The format is detected from the file's extension or,
+	// failing that, its contents.
+	ArchiveAuto   ArchiveFormat = "auto"
+	ArchiveTarGz                = "targz"  // nolint
+	ArchiveZip                  = "zip"    // nolint
+	ArchiveTar                  = "tar"    // nolint
+	ArchiveTarBz2               = "tarbz2" // nolint
+	ArchiveTarXz                = "tarxz"  // nolint
+	// ArchiveTarZst is a tar archive compressed with zstd. Unlike the
+	// other formats, which are decompressed in-process, extracting it
+	// requires the zstd command to be on the PATH of the host that
+	// extracts it; on other hosts, extraction fails with an error that
+	// the format is not supported.
+	ArchiveTarZst = "tarzst" // nolint
 )
 
 // Validate checks that the ArchiveFormat is a recognized format.
 func (f ArchiveFormat) Validate() error {
 	switch f {
-	case ArchiveTarGz, ArchiveZip, ArchiveAuto:
+	case ArchiveTarGz, ArchiveZip, ArchiveAuto, ArchiveTar, ArchiveTarBz2, ArchiveTarXz, ArchiveTarZst:
 		return nil
 	default:
 		return errors.Errorf("unknown archive format %s", f)
@@ -92,10 +223,30 @@ func (f ArchiveFormat) Validate() error {
 }
 
 // ArchiveOptions encapsulates options related to management of archive files.
+//
+// StripComponents removes that many leading directories from the path of
+// each entry, and skips entries that have no path left, like tar's
+// --strip-components. Include and Exclude are patterns, in the syntax of
+// path.Match, that are matched against the path of each entry after
+// stripping, and against each of its parent directories. If Include is
+// set, only matching entries are extracted; entries matching Exclude are
+// never extracted.
+//
+// Extraction fails if an entry has an absolute path or a path outside of
+// TargetPath, would be written through a symlink, or is a symlink that
+// points outside of TargetPath. It also fails if the files extracted
+// total more than MaxSize bytes (DefaultArchiveMaxSize by default), or if
+// the archive has more than MaxFiles entries (DefaultArchiveMaxFiles by
+// default), including directories and links.
 type ArchiveOptions struct {
-	ShouldExtract bool
-	Format        ArchiveFormat
-	TargetPath    string
+	ShouldExtract   bool
+	Format          ArchiveFormat
+	TargetPath      string
+	StripComponents int
+	Include         []string
+	Exclude         []string
+	MaxSize         int64
+	MaxFiles        int
 }
 
 // Validate checks the archive file options.
@@ -112,14 +263,36 @@ func (opts ArchiveOptions) Validate() error {
 
 	catcher.Add(opts.Format.Validate())
 
+	if opts.StripComponents < 0 {
+		catcher.Add(errors.New("cannot strip a negative number of path components"))
+	}
+	if opts.MaxSize < 0 || opts.MaxFiles < 0 {
+		catcher.Add(errors.New("cannot have a negative maximum archive size or file count"))
+	}
+	for _, pattern := range append(append([]string{}, opts.Include...), opts.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			catcher.Add(errors.Wrapf(err, "invalid archive pattern '%s'", pattern))
+		}
+	}
+
 	return catcher.Resolve()
 }
 
-// MongoDBDownloadOptions represent one build variant of MongoDB.
+// MongoDBDownloadOptions represent one build variant of MongoDB. The
+// feed of releases and the release archives are downloaded from the
+// first of the Sources that succeeds.
+//
+// If Workers is set, at most that many of the releases are downloaded at
+// once, in addition to the limit of the service's DownloadOptions. If
+// MaxBytesPerSecond is set, each release is downloaded no faster than
+// that rate.
 type MongoDBDownloadOptions struct {
-	BuildOpts bond.BuildOptions `json:"build_opts"`
-	Path      string            `json:"path"`
-	Releases  []string          `json:"releases"`
+	BuildOpts         bond.BuildOptions `json:"build_opts"`
+	Path              string            `json:"path"`
+	Releases          []string          `json:"releases"`
+	Sources           DownloadSources   `json:"sources,omitempty"`
+	Workers           int               `json:"workers,omitempty"`
+	MaxBytesPerSecond int64             `json:"max_bytes_per_second,omitempty"`
 }
 
 // Validate checks for valid MongoDB download options.
@@ -131,6 +304,14 @@ func (opts MongoDBDownloadOptions) Validate() error {
 	}
 
 	catcher.Add(opts.BuildOpts.Validate())
+	catcher.Add(opts.Sources.Validate())
+
+	if opts.Workers < 0 || opts.Workers > MaxDownloadJobWorkers {
+		catcher.Add(errors.Errorf("workers must be between 0 and %d", MaxDownloadJobWorkers))
+	}
+	if opts.MaxBytesPerSecond < 0 {
+		catcher.Add(errors.New("cannot have a negative bandwidth limit"))
+	}
 
 	return catcher.Resolve()
 }
@@ -157,117 +338,51 @@ func (opts CacheOptions) Validate() error {
 	return catcher.Resolve()
 }
 
-func doExtract(info DownloadInfo) error {
-	var archiveHandler archiver.Archiver
-	switch info.ArchiveOpts.Format {
-	case ArchiveAuto:
-		unzipper := archiver.MatchingFormat(info.Path)
-		if unzipper == nil {
-			return errors.Errorf("could not detect archive format for %s", info.Path)
-		}
-		archiveHandler = unzipper
-	case ArchiveTarGz:
-		archiveHandler = archiver.TarGz
-	case ArchiveZip:
-		archiveHandler = archiver.Zip
-	default:
-		return errors.Errorf("unrecognized archive format %s", info.ArchiveOpts.Format)
-	}
-
-	if err := archiveHandler.Open(info.Path, info.ArchiveOpts.TargetPath); err != nil {
-		return errors.Wrapf(err, "problem extracting archive %s to %s", info.Path, info.ArchiveOpts.TargetPath)
-	}
-
-	return nil
-}
-
 // SetupDownloadMongoDBReleases performs necessary setup to download MongoDB with the given options.
+// The releases are downloaded and extracted in the background until the
+// context is done, and their files are added to the cache once each
+// release is extracted. Use DownloadJobs.StartMongoDB to query the
+// status of the downloads.
 func SetupDownloadMongoDBReleases(ctx context.Context, cache *lru.Cache, opts MongoDBDownloadOptions) error {
-	if err := makeEnclosingDirectories(opts.Path); err != nil {
-		return errors.Wrap(err, "problem creating enclosing directories")
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultDownloadJobWorkers
 	}
-
-	feed, err := bond.GetArtifactsFeed(ctx, opts.Path)
+	jobs, err := NewDownloadJobs(ctx, workers)
 	if err != nil {
-		return errors.Wrap(err, "problem making artifacts feed")
+		return errors.WithStack(err)
 	}
 
-	catcher := grip.NewBasicCatcher()
-	urls, errs := feed.GetArchives(opts.Releases, opts.BuildOpts)
-	jobs := createDownloadJobs(opts.Path, urls, catcher)
-
-	if err := setupDownloadJobsAsync(ctx, jobs, processDownloadJobs(ctx, addMongoDBFilesToCache(cache, opts.Path))); err != nil {
-		catcher.Add(errors.Wrap(err, "problem starting download jobs"))
-	}
-
-	for err = range errs {
-		catcher.Add(errors.Wrap(err, "problem initializing download jobs"))
-	}
-
-	return catcher.Resolve()
-}
-
-func createDownloadJobs(path string, urls <-chan string, catcher grip.Catcher) <-chan amboy.Job {
-	output := make(chan amboy.Job)
-
-	go func() {
-		for url := range urls {
-			j, err := recall.NewDownloadJob(url, path, true)
-			if err != nil {
-				catcher.Add(errors.Wrapf(err, "problem creating download job for %s", url))
-				continue
-			}
-
-			output <- j
-		}
-		close(output)
-	}()
-
-	return output
+	_, err = jobs.StartMongoDB(ctx, cache, opts)
+	return err
 }
 
-func processDownloadJobs(ctx context.Context, processFile func(string) error) func(amboy.Queue) error {
-	return func(q amboy.Queue) error {
-		grip.Infof("waiting for %d download jobs to complete", q.Stats().Total)
-		_ = amboy.WaitCtxInterval(ctx, q, 1000*time.Millisecond)
-		grip.Info("all download tasks complete, processing errors now")
-
-		if err := amboy.ResolveErrors(ctx, q); err != nil {
-			return errors.Wrap(err, "problem completing download jobs")
-		}
-
-		catcher := grip.NewBasicCatcher()
-		for job := range q.Results(ctx) {
-			downloadJob, ok := job.(*recall.DownloadFileJob)
-			if !ok {
-				catcher.Add(errors.New("problem retrieving download job from queue"))
-				continue
-			}
-			if err := processFile(downloadJob.FileName); err != nil {
-				catcher.Add(err)
-			}
-		}
-		return catcher.Resolve()
-	}
-}
-
-func setupDownloadJobsAsync(ctx context.Context, jobs <-chan amboy.Job, processJobs func(amboy.Queue) error) error {
-	q := queue.NewLocalUnordered(2)
-	if err := q.Start(ctx); err != nil {
-		return errors.Wrap(err, "problem starting download job queue")
-	}
+const (
+	// artifactsFeedURL is the URL of the feed of MongoDB releases.
+	artifactsFeedURL = "http://downloads.mongodb.org/full.json"
+	// artifactsFeedTTL is how long a downloaded feed of MongoDB
+	// releases is used before it is downloaded again, which matches
+	// bond.GetArtifactsFeed.
+	artifactsFeedTTL = 4 * time.Hour
+)
 
-	if err := amboy.PopulateQueue(ctx, q, jobs); err != nil {
-		return errors.Wrap(err, "problem adding download jobs to queue")
+// getArtifactsFeed returns the feed of MongoDB releases at the path,
+// downloading it from the sources first if it is missing or stale.
+func getArtifactsFeed(ctx context.Context, path string, sources DownloadSources) (*bond.ArtifactsFeed, error) {
+	feedPath := path
+	if !strings.HasSuffix(feedPath, ".json") {
+		feedPath = filepath.Join(path, "full.json")
 	}
 
-	go func() {
-		if err := processJobs(q); err != nil {
-			grip.Errorf(errors.Wrap(err, "error occurred while adding jobs to cache").Error())
+	stat, err := os.Stat(feedPath)
+	if os.IsNotExist(err) || (err == nil && stat.Mode().IsRegular() && time.Since(stat.ModTime()) > artifactsFeedTTL) {
+		info := DownloadInfo{URL: artifactsFeedURL, Path: feedPath, Sources: sources}
+		if err = info.DownloadContext(ctx); err != nil {
+			return nil, errors.Wrap(err, "problem downloading artifacts feed")
 		}
-	}()
+	}
 
-	return nil
+	return bond.GetArtifactsFeed(ctx, path)
 }
 
 func addMongoDBFilesToCache(cache *lru.Cache, absRootPath string) func(string) error {
@@ -0,0 +1,554 @@
+package jasper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"github.com/tychoish/bond"
+)
+
+const (
+	// downloadCacheIndex is the name of the file in the download cache
+	// directory that records the cache entries.
+	downloadCacheIndex = "index.json"
+	// downloadCacheStagingPrefix is the prefix of the files in the
+	// download cache directory that are being added to the cache.
+	downloadCacheStagingPrefix = ".staging-"
+
+	// DefaultDownloadCacheMaxSize is the number of bytes that a download
+	// cache holds before the services prune it, unless it is configured
+	// with SetMaxSize.
+	DefaultDownloadCacheMaxSize int64 = 10 * 1024 * 1024 * 1024
+)
+
+// DownloadCacheEntry describes a file in the download cache. Digest is
+// the hex-encoded SHA256 digest of the file, which identifies the entry,
+// and URLs are the URLs from which the file was downloaded. Pinned
+// entries are never evicted.
+type DownloadCacheEntry struct {
+	Digest     string    `json:"digest"`
+	URLs       []string  `json:"urls"`
+	Size       int64     `json:"size"`
+	Pinned     bool      `json:"pinned"`
+	Hits       int64     `json:"hits"`
+	AddTime    time.Time `json:"add_time"`
+	AccessTime time.Time `json:"access_time"`
+}
+
+func (e *DownloadCacheEntry) export() DownloadCacheEntry {
+	out := *e
+	out.URLs = append([]string(nil), e.URLs...)
+	return out
+}
+
+// DownloadCache is a content-addressed cache of downloaded files. Each
+// file is stored once in the cache directory, named by its SHA256
+// digest, no matter how many URLs it was downloaded from, and the cache
+// entries are recorded in the directory so that the cache survives
+// restarts. A directory should only be used by one DownloadCache at a
+// time. The REST and RPC services prune the cache to its MaxSize
+// (DefaultDownloadCacheMaxSize by default).
+//
+// A nil *DownloadCache is valid, and always downloads files.
+type DownloadCache struct {
+	dir     string
+	maxSize int64
+	entries map[string]*DownloadCacheEntry
+	urls    map[string]string
+	mu      sync.RWMutex
+}
+
+var (
+	defaultDownloadCache    *DownloadCache
+	defaultDownloadCacheDir = filepath.Join(os.TempDir(), "jasper-download-cache")
+	defaultDownloadCacheMu  sync.Mutex
+)
+
+// DefaultDownloadCache returns the download cache that is shared by the
+// REST and RPC services in this process, unless they are given a
+// different cache. It is stored in the directory set with
+// SetDefaultDownloadCacheDirectory, which is the "jasper-download-cache"
+// directory in the system's temporary directory by default.
+func DefaultDownloadCache() (*DownloadCache, error) {
+	defaultDownloadCacheMu.Lock()
+	defer defaultDownloadCacheMu.Unlock()
+
+	if defaultDownloadCache == nil {
+		cache, err := NewDownloadCache(defaultDownloadCacheDir)
+		if err != nil {
+			return nil, err
+		}
+		defaultDownloadCache = cache
+	}
+	return defaultDownloadCache, nil
+}
+
+// SetDefaultDownloadCacheDirectory sets the directory in which the
+// DefaultDownloadCache is stored. It returns an error if the default
+// cache has already been created in a different directory.
+func SetDefaultDownloadCacheDirectory(dir string) error {
+	if dir == "" {
+		return errors.New("must specify a download cache directory")
+	}
+
+	defaultDownloadCacheMu.Lock()
+	defer defaultDownloadCacheMu.Unlock()
+
+	if defaultDownloadCache != nil && defaultDownloadCache.dir != dir {
+		return errors.Errorf("default download cache already exists in '%s'", defaultDownloadCache.dir)
+	}
+	defaultDownloadCacheDir = dir
+
+	return nil
+}
+
+// NewDownloadCache creates the download cache directory, if it does not
+// already exist, and loads the entries that are recorded in it.
+func NewDownloadCache(dir string) (*DownloadCache, error) {
+	if dir == "" {
+		return nil, errors.New("must specify a download cache directory")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "problem creating download cache directory '%s'", dir)
+	}
+
+	c := &DownloadCache{
+		dir:     dir,
+		maxSize: DefaultDownloadCacheMaxSize,
+		entries: map[string]*DownloadCacheEntry{},
+		urls:    map[string]string{},
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// load reads the cache entries from the index, skipping any entries
+// whose files are missing.
+func (c *DownloadCache) load() error {
+	index := filepath.Join(c.dir, downloadCacheIndex)
+	contents, err := ioutil.ReadFile(index)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "problem reading download cache index '%s'", index)
+	}
+
+	entries := []DownloadCacheEntry{}
+	if err = json.Unmarshal(contents, &entries); err != nil {
+		return errors.Wrapf(err, "problem parsing download cache index '%s'", index)
+	}
+	for i := range entries {
+		entry := entries[i]
+		if !isDownloadCacheDigest(entry.Digest) {
+			continue
+		}
+		if stat, err := os.Stat(c.path(entry.Digest)); err != nil || stat.Size() != entry.Size {
+			continue
+		}
+		c.entries[entry.Digest] = &entry
+		for _, url := range entry.URLs {
+			c.urls[url] = entry.Digest
+		}
+	}
+
+	return nil
+}
+
+// save records the cache entries in the index. The caller must hold
+// the write lock.
+func (c *DownloadCache) save() error {
+	contents, err := json.Marshal(c.list())
+	if err != nil {
+		return errors.Wrap(err, "problem encoding download cache index")
+	}
+
+	index := filepath.Join(c.dir, downloadCacheIndex)
+	staging := filepath.Join(c.dir, downloadCacheStagingPrefix+downloadCacheIndex)
+	if err = ioutil.WriteFile(staging, contents, 0644); err != nil {
+		return errors.Wrapf(err, "problem writing download cache index '%s'", staging)
+	}
+	return errors.Wrapf(os.Rename(staging, index), "problem moving download cache index to '%s'", index)
+}
+
+func isDownloadCacheDigest(digest string) bool {
+	decoded, err := hex.DecodeString(digest)
+	return err == nil && len(decoded) == sha256.Size && digest == strings.ToLower(digest)
+}
+
+func (c *DownloadCache) path(digest string) string {
+	return filepath.Join(c.dir, digest)
+}
+
+// Directory returns the directory in which the cached files are stored.
+func (c *DownloadCache) Directory() string { return c.dir }
+
+// MaxSize returns the number of bytes that the cache holds before it is
+// pruned.
+func (c *DownloadCache) MaxSize() int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.maxSize
+}
+
+// SetMaxSize sets the number of bytes that the cache holds before it is
+// pruned.
+func (c *DownloadCache) SetMaxSize(size int64) error {
+	if size <= 0 {
+		return errors.New("download cache max size must be positive")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxSize = size
+	return nil
+}
+
+func (c *DownloadCache) list() []DownloadCacheEntry {
+	entries := make([]DownloadCacheEntry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		entries = append(entries, entry.export())
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].AddTime.Equal(entries[j].AddTime) {
+			return entries[i].Digest < entries[j].Digest
+		}
+		return entries[i].AddTime.Before(entries[j].AddTime)
+	})
+	return entries
+}
+
+// List returns all of the cache entries, in the order in which they were
+// added.
+func (c *DownloadCache) List() []DownloadCacheEntry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.list()
+}
+
+// Size returns the total size, in bytes, of the cached files.
+func (c *DownloadCache) Size() int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var size int64
+	for _, entry := range c.entries {
+		size += entry.Size
+	}
+	return size
+}
+
+func (c *DownloadCache) get(digest string) (*DownloadCacheEntry, error) {
+	entry, ok := c.entries[strings.ToLower(digest)]
+	if !ok {
+		return nil, errors.Errorf("no cached download '%s' found", digest)
+	}
+	return entry, nil
+}
+
+// Get returns the cache entry with the given digest.
+func (c *DownloadCache) Get(digest string) (DownloadCacheEntry, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, err := c.get(digest)
+	if err != nil {
+		return DownloadCacheEntry{}, err
+	}
+	return entry.export(), nil
+}
+
+// Pin sets whether the cache entry with the given digest is pinned.
+func (c *DownloadCache) Pin(digest string, pinned bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, err := c.get(digest)
+	if err != nil {
+		return err
+	}
+	entry.Pinned = pinned
+
+	return c.save()
+}
+
+// Evict removes the cache entry with the given digest and its file. It
+// returns an error if the entry is pinned.
+func (c *DownloadCache) Evict(digest string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, err := c.get(digest)
+	if err != nil {
+		return err
+	}
+	if entry.Pinned {
+		return errors.Errorf("cached download '%s' is pinned", digest)
+	}
+	c.evict(entry)
+
+	return c.save()
+}
+
+// evict removes the entry and its file. The caller must hold the write
+// lock.
+func (c *DownloadCache) evict(entry *DownloadCacheEntry) {
+	if err := os.Remove(c.path(entry.Digest)); err != nil && !os.IsNotExist(err) {
+		grip.Warning(errors.Wrapf(err, "problem removing cached download '%s'", entry.Digest))
+	}
+	for _, url := range entry.URLs {
+		delete(c.urls, url)
+	}
+	delete(c.entries, entry.Digest)
+}
+
+// Prune evicts the least recently used entries that are not pinned until
+// the cached files total at most maxSize bytes.
+func (c *DownloadCache) Prune(maxSize int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var size int64
+	candidates := make([]*DownloadCacheEntry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		size += entry.Size
+		if !entry.Pinned {
+			candidates = append(candidates, entry)
+		}
+	}
+	if size <= maxSize {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].AccessTime.Before(candidates[j].AccessTime)
+	})
+	for _, entry := range candidates {
+		if size <= maxSize {
+			break
+		}
+		size -= entry.Size
+		c.evict(entry)
+	}
+
+	return c.save()
+}
+
+// setURL records that the file with the given digest was downloaded from
+// the URL, replacing any file previously downloaded from it. The caller
+// must hold the write lock.
+func (c *DownloadCache) setURL(url, digest string) {
+	if url == "" {
+		return
+	}
+	if old, ok := c.urls[url]; ok {
+		if old == digest {
+			return
+		}
+		if entry, ok := c.entries[old]; ok {
+			for i := range entry.URLs {
+				if entry.URLs[i] == url {
+					entry.URLs = append(entry.URLs[:i], entry.URLs[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	c.urls[url] = digest
+	c.entries[digest].URLs = append(c.entries[digest].URLs, url)
+}
+
+// add copies the file at the given path, which was downloaded from the
+// URL, into the cache.
+func (c *DownloadCache) add(url, path string) (DownloadCacheEntry, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return DownloadCacheEntry{}, errors.Wrapf(err, "problem opening download %s", path)
+	}
+	defer src.Close()
+
+	staging, err := ioutil.TempFile(c.dir, downloadCacheStagingPrefix)
+	if err != nil {
+		return DownloadCacheEntry{}, errors.Wrap(err, "problem creating file in download cache")
+	}
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(staging, hash), src)
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(errors.Wrapf(err, "problem copying download %s into cache", path))
+	catcher.Add(staging.Close())
+	if catcher.HasErrors() {
+		grip.Warning(os.Remove(staging.Name()))
+		return DownloadCacheEntry{}, catcher.Resolve()
+	}
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[digest]
+	if ok {
+		grip.Warning(os.Remove(staging.Name()))
+	} else {
+		if err = os.Rename(staging.Name(), c.path(digest)); err != nil {
+			grip.Warning(os.Remove(staging.Name()))
+			return DownloadCacheEntry{}, errors.Wrapf(err, "problem moving download %s into cache", path)
+		}
+		now := time.Now()
+		entry = &DownloadCacheEntry{Digest: digest, Size: size, AddTime: now, AccessTime: now}
+		c.entries[digest] = entry
+	}
+	c.setURL(url, digest)
+
+	return entry.export(), c.save()
+}
+
+// lookup returns the cache entry for the download: the entry with its
+// SHA256 digest, if it is set, or the entry for its URL otherwise.
+func (c *DownloadCache) lookup(info DownloadInfo) (DownloadCacheEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	digest := strings.ToLower(info.SHA256)
+	if digest == "" {
+		digest = c.urls[info.URL]
+	}
+	entry, ok := c.entries[digest]
+	if !ok {
+		return DownloadCacheEntry{}, false
+	}
+	return entry.export(), true
+}
+
+// fetch copies the cached file to the download path, verifying it
+// against the download's checksums, and records the hit.
+func (c *DownloadCache) fetch(entry DownloadCacheEntry, info DownloadInfo, progress *downloadProgress) error {
+	src, err := os.Open(c.path(entry.Digest))
+	if err != nil {
+		return errors.Wrapf(err, "problem opening cached download '%s'", entry.Digest)
+	}
+	defer src.Close()
+
+	if err = makeEnclosingDirectories(filepath.Dir(info.Path)); err != nil {
+		return errors.Wrap(err, "problem making enclosing directories")
+	}
+	partial := info.Path + partialDownloadSuffix
+	dst, err := os.Create(partial)
+	if err != nil {
+		return errors.Wrapf(err, "problem creating file %s", partial)
+	}
+
+	verifier := newChecksumVerifier(info.checksums())
+	writers := []io.Writer{dst, verifier}
+	if progress != nil {
+		progress.reset(0, entry.Size)
+		writers = append(writers, progress)
+	}
+	_, err = io.Copy(io.MultiWriter(writers...), src)
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(errors.Wrapf(err, "problem copying cached download '%s'", entry.Digest))
+	catcher.Add(dst.Close())
+	catcher.Add(verifier.verify())
+	if err = catcher.Resolve(); err == nil {
+		err = errors.Wrapf(os.Rename(partial, info.Path), "problem moving cached download to %s", info.Path)
+	}
+	if err != nil {
+		if rmErr := os.Remove(partial); rmErr != nil && !os.IsNotExist(rmErr) {
+			grip.Warning(errors.Wrapf(rmErr, "problem removing partial download %s", partial))
+		}
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.entries[entry.Digest]; ok {
+		cached.Hits++
+		cached.AccessTime = time.Now()
+		c.setURL(info.URL, entry.Digest)
+		grip.Warning(errors.Wrap(c.save(), "problem saving download cache index"))
+	}
+
+	return nil
+}
+
+// Download executes the download operation like
+// DownloadInfo.DownloadContext, unless the file is already cached, in
+// which case the cached file is copied to the download path and
+// extracted instead. A download is found by its SHA256 digest, if it is
+// set or fetched with FetchChecksum, or by its URL otherwise. Cached
+// files are verified against the download's checksums, and are
+// downloaded again if they do not match. Files that are downloaded are
+// added to the cache.
+//
+// A download that is found by its URL is not checked against the server
+// again, so it is served from the cache even if the file at the URL has
+// changed. Downloads of URLs whose contents change should set SHA256 or
+// FetchChecksum, or their cache entries should be evicted when they
+// change.
+func (c *DownloadCache) Download(ctx context.Context, info DownloadInfo) error {
+	_, err := c.download(ctx, info, nil)
+	return err
+}
+
+// download executes the download operation with the cache, reporting the
+// bytes transferred to progress, if it is not nil.
+func (c *DownloadCache) download(ctx context.Context, info DownloadInfo, progress *downloadProgress) (ArchiveReport, error) {
+	if c == nil {
+		return info.download(ctx, progress)
+	}
+
+	ctx, cancel := info.withTimeout(ctx)
+	defer cancel()
+
+	client := bond.GetHTTPClient()
+	defer bond.PutHTTPClient(client)
+
+	if err := info.resolveChecksum(ctx, client); err != nil {
+		return ArchiveReport{}, err
+	}
+
+	if entry, ok := c.lookup(info); ok {
+		err := c.fetch(entry, info, progress)
+		if err == nil {
+			return info.extract()
+		}
+		grip.Debug(message.WrapError(err, message.Fields{
+			"message": "problem using cached download, downloading again",
+			"url":     info.URL,
+			"digest":  entry.Digest,
+		}))
+	}
+
+	report, err := info.transfer(ctx, client, progress)
+	if err != nil {
+		return report, err
+	}
+	if _, err = c.add(info.URL, info.Path); err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "problem adding download to cache",
+			"url":     info.URL,
+			"path":    info.Path,
+		}))
+	}
+
+	return report, nil
+}
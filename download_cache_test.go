@@ -0,0 +1,206 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" // nolint
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadCache(t *testing.T) {
+	content := bytes.Repeat([]byte("foobar"), 1024)
+	sum := sha256.Sum256(content)
+	digest := hex.EncodeToString(sum[:])
+
+	for name, test := range map[string]func(context.Context, *testing.T, *DownloadCache, *flakyDownloadServer, DownloadInfo){
+		"ServesRepeatedDownloadsFromCache": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			require.NoError(t, cache.Download(ctx, info))
+			first := info.Path
+			info.Path += "2"
+			require.NoError(t, cache.Download(ctx, info))
+			assert.Len(t, s.requests, 1)
+
+			for _, path := range []string{first, info.Path} {
+				downloaded, err := ioutil.ReadFile(path)
+				require.NoError(t, err)
+				assert.Equal(t, content, downloaded)
+			}
+
+			entry, err := cache.Get(digest)
+			require.NoError(t, err)
+			assert.Equal(t, []string{info.URL}, entry.URLs)
+			assert.EqualValues(t, len(content), entry.Size)
+			assert.EqualValues(t, 1, entry.Hits)
+			assert.EqualValues(t, len(content), cache.Size())
+		},
+		"FindsDownloadsByDigest": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			require.NoError(t, cache.Download(ctx, info))
+
+			other := info
+			other.URL += "/mirror"
+			other.Path += "2"
+			other.SHA256 = digest
+			require.NoError(t, cache.Download(ctx, other))
+			assert.Len(t, s.requests, 1)
+
+			entries := cache.List()
+			require.Len(t, entries, 1)
+			assert.Equal(t, []string{info.URL, other.URL}, entries[0].URLs)
+		},
+		"DownloadsAgainIfCachedFileDoesNotMatch": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			require.NoError(t, cache.Download(ctx, info))
+
+			s.content = []byte("changed")
+			sum := sha1.Sum(s.content) // nolint
+			info.SHA1 = hex.EncodeToString(sum[:])
+			require.NoError(t, cache.Download(ctx, info))
+			assert.Len(t, s.requests, 2)
+
+			downloaded, err := ioutil.ReadFile(info.Path)
+			require.NoError(t, err)
+			assert.Equal(t, s.content, downloaded)
+
+			entries := cache.List()
+			require.Len(t, entries, 2)
+			assert.Empty(t, entries[0].URLs)
+			assert.Equal(t, []string{info.URL}, entries[1].URLs)
+		},
+		"ExtractsCachedArchives": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			s.content = makeTestTar(t, testArchiveEntries)
+			info.Path += ".tar"
+			info.ArchiveOpts = ArchiveOptions{ShouldExtract: true, Format: ArchiveAuto, TargetPath: info.Path + ".1"}
+			require.NoError(t, cache.Download(ctx, info))
+
+			info.ArchiveOpts.TargetPath = info.Path + ".2"
+			require.NoError(t, cache.Download(ctx, info))
+			assert.Len(t, s.requests, 1)
+			assertArchiveFiles(t, info.ArchiveOpts.TargetPath, "root/README.txt", "root/bin/tool")
+		},
+		"PinnedEntriesAreNotEvicted": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			require.NoError(t, cache.Download(ctx, info))
+			require.NoError(t, cache.Pin(digest, true))
+			assert.Error(t, cache.Evict(digest))
+			require.NoError(t, cache.Prune(0))
+
+			entry, err := cache.Get(digest)
+			require.NoError(t, err)
+			assert.True(t, entry.Pinned)
+
+			require.NoError(t, cache.Pin(digest, false))
+			require.NoError(t, cache.Evict(digest))
+			_, err = cache.Get(digest)
+			assert.Error(t, err)
+			_, err = os.Stat(filepath.Join(cache.Directory(), digest))
+			assert.True(t, os.IsNotExist(err))
+
+			require.NoError(t, cache.Download(ctx, info))
+			assert.Len(t, s.requests, 2)
+		},
+		"PruneEvictsLeastRecentlyUsedEntries": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			require.NoError(t, cache.Download(ctx, info))
+			changed := info
+			changed.URL += "/changed"
+			s.content = []byte("changed")
+			require.NoError(t, cache.Download(ctx, changed))
+			require.Len(t, cache.List(), 2)
+			require.NoError(t, cache.Download(ctx, info))
+			assert.Len(t, s.requests, 2)
+
+			require.NoError(t, cache.Prune(int64(len(content))))
+			entries := cache.List()
+			require.Len(t, entries, 1)
+			assert.Equal(t, digest, entries[0].Digest)
+		},
+		"EntriesPersistAcrossRestarts": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			require.NoError(t, cache.Download(ctx, info))
+			require.NoError(t, cache.Pin(digest, true))
+
+			reopened, err := NewDownloadCache(cache.Directory())
+			require.NoError(t, err)
+			entries := reopened.List()
+			require.Len(t, entries, 1)
+			assert.True(t, entries[0].Pinned)
+
+			info.Path += "2"
+			require.NoError(t, reopened.Download(ctx, info))
+			assert.Len(t, s.requests, 1)
+		},
+		"MissingEntriesAreErrors": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			_, err := cache.Get(digest)
+			assert.Error(t, err)
+			assert.Error(t, cache.Pin(digest, true))
+			assert.Error(t, cache.Evict(digest))
+		},
+		"NilCacheAlwaysDownloads": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			var nilCache *DownloadCache
+			require.NoError(t, nilCache.Download(ctx, info))
+			require.NoError(t, nilCache.Download(ctx, info))
+			assert.Len(t, s.requests, 2)
+		},
+		"DownloadJobsUseCache": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			jobs, err := NewDownloadJobs(ctx, 1)
+			require.NoError(t, err)
+			jobs.SetCache(cache)
+
+			for i := 0; i < 2; i++ {
+				status, err := jobs.Start(info)
+				require.NoError(t, err)
+				status, err = jobs.Wait(ctx, status.ID)
+				require.NoError(t, err)
+				assert.Equal(t, DownloadJobCompleted, status.State)
+				assert.EqualValues(t, len(content), status.BytesTransferred)
+			}
+			assert.Len(t, s.requests, 1)
+		},
+		"MaxSizeIsConfigurable": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {
+			assert.Equal(t, DefaultDownloadCacheMaxSize, cache.MaxSize())
+			assert.Error(t, cache.SetMaxSize(0))
+			require.NoError(t, cache.SetMaxSize(1))
+			assert.EqualValues(t, 1, cache.MaxSize())
+		},
+		// "": func(ctx context.Context, t *testing.T, cache *DownloadCache, s *flakyDownloadServer, info DownloadInfo) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			s := &flakyDownloadServer{content: content}
+			server := httptest.NewServer(s)
+			defer server.Close()
+
+			dir, err := ioutil.TempDir("build", "download_cache")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			absDir, err := filepath.Abs(dir)
+			require.NoError(t, err)
+
+			cache, err := NewDownloadCache(filepath.Join(absDir, "cache"))
+			require.NoError(t, err)
+
+			test(ctx, t, cache, s, DownloadInfo{URL: server.URL, Path: filepath.Join(absDir, "foo")})
+		})
+	}
+}
+
+func TestDefaultDownloadCacheDirectory(t *testing.T) {
+	assert.Error(t, SetDefaultDownloadCacheDirectory(""))
+
+	cache, err := DefaultDownloadCache()
+	require.NoError(t, err)
+	assert.NoError(t, SetDefaultDownloadCacheDirectory(cache.Directory()))
+	assert.Error(t, SetDefaultDownloadCacheDirectory(filepath.Join(cache.Directory(), "other")))
+
+	same, err := DefaultDownloadCache()
+	require.NoError(t, err)
+	assert.True(t, cache == same)
+}
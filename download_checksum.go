@@ -0,0 +1,142 @@
+package jasper
+
+import (
+	"context"
+	"crypto/md5"  // nolint
+	"crypto/sha1" // nolint
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// ChecksumFileExtension is the extension of the checksum file that is
+// fetched alongside a download if DownloadInfo.FetchChecksum is set.
+const ChecksumFileExtension = ".sha256"
+
+// maxChecksumFileSize is the largest checksum file that is read.
+const maxChecksumFileSize = 64 * 1024
+
+// downloadChecksum is an expected digest of a download.
+type downloadChecksum struct {
+	name     string
+	expected string
+	hash     hash.Hash
+}
+
+// checksums returns the expected digests of the download.
+func (info DownloadInfo) checksums() []*downloadChecksum {
+	sums := []*downloadChecksum{}
+	for _, sum := range []struct {
+		name     string
+		expected string
+		hash     func() hash.Hash
+	}{
+		{name: "sha256", expected: info.SHA256, hash: sha256.New},
+		{name: "sha1", expected: info.SHA1, hash: sha1.New},
+		{name: "md5", expected: info.MD5, hash: md5.New},
+	} {
+		if sum.expected != "" {
+			sums = append(sums, &downloadChecksum{name: sum.name, expected: strings.ToLower(sum.expected), hash: sum.hash()})
+		}
+	}
+	return sums
+}
+
+// validateChecksums ensures that the expected digests are well-formed.
+func (info DownloadInfo) validateChecksums() error {
+	catcher := grip.NewBasicCatcher()
+	for _, sum := range info.checksums() {
+		if decoded, err := hex.DecodeString(sum.expected); err != nil || len(decoded) != sum.hash.Size() {
+			catcher.Add(errors.Errorf("invalid %s checksum '%s'", sum.name, sum.expected))
+		}
+	}
+	if info.FetchChecksum && info.SHA256 != "" {
+		catcher.Add(errors.New("cannot both specify a sha256 checksum and fetch the checksum file"))
+	}
+	return catcher.Resolve()
+}
+
+// fetchChecksum fetches the checksum file of the download, and returns the
+// sha256 digest that it contains. The file may contain only the digest, or
+// the digest followed by the file name, as written by sha256sum.
+func fetchChecksum(ctx context.Context, client *http.Client, url string) (string, error) {
+	url += ChecksumFileExtension
+	body, _, err := openDownload(ctx, client, url, 0)
+	if err != nil {
+		return "", errors.Wrapf(err, "problem fetching checksum file %s", url)
+	}
+	defer body.Close()
+
+	contents, err := ioutil.ReadAll(io.LimitReader(body, maxChecksumFileSize))
+	if err != nil {
+		return "", errors.Wrapf(err, "problem reading checksum file %s", url)
+	}
+	fields := strings.Fields(string(contents))
+	if len(fields) == 0 {
+		return "", errors.Errorf("checksum file %s is empty", url)
+	}
+
+	return fields[0], nil
+}
+
+// resolveChecksum fetches the checksum file of the download from the
+// first of its sources that has it, if FetchChecksum is set, and sets the
+// expected SHA256 digest of the download to its contents. The checksum is
+// only fetched once, since FetchChecksum is unset once it is resolved.
+func (info *DownloadInfo) resolveChecksum(ctx context.Context, client *http.Client) error {
+	if !info.FetchChecksum {
+		return nil
+	}
+
+	var sum string
+	catcher := grip.NewBasicCatcher()
+	for _, url := range info.Sources.urls(info.URL) {
+		var err error
+		if sum, err = fetchChecksum(ctx, client, url); err == nil {
+			break
+		}
+		catcher.Add(err)
+	}
+	if sum == "" {
+		return catcher.Resolve()
+	}
+	info.SHA256 = sum
+	info.FetchChecksum = false
+
+	return errors.Wrap(info.validateChecksums(), "problem reading checksum file")
+}
+
+// checksumVerifier computes the digests of the data written to it, and
+// compares them to the expected digests.
+type checksumVerifier struct {
+	sums   []*downloadChecksum
+	writer io.Writer
+}
+
+func newChecksumVerifier(sums []*downloadChecksum) *checksumVerifier {
+	writers := make([]io.Writer, 0, len(sums))
+	for _, sum := range sums {
+		writers = append(writers, sum.hash)
+	}
+	return &checksumVerifier{sums: sums, writer: io.MultiWriter(writers...)}
+}
+
+func (v *checksumVerifier) Write(p []byte) (int, error) { return v.writer.Write(p) }
+
+// verify returns an error if any of the digests do not match.
+func (v *checksumVerifier) verify() error {
+	catcher := grip.NewBasicCatcher()
+	for _, sum := range v.sums {
+		if actual := hex.EncodeToString(sum.hash.Sum(nil)); actual != sum.expected {
+			catcher.Add(errors.Errorf("%s checksum mismatch: expected '%s', got '%s'", sum.name, sum.expected, actual))
+		}
+	}
+	return catcher.Resolve()
+}
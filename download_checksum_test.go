@@ -0,0 +1,123 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fooSHA256 = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	fooSHA1   = "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"
+	fooMD5    = "acbd18db4cc2f85cedef654fccc4a4d8"
+)
+
+func TestDownloadChecksumValidation(t *testing.T) {
+	for name, info := range map[string]DownloadInfo{
+		"NoChecksums":    {},
+		"AllChecksums":   {SHA256: fooSHA256, SHA1: fooSHA1, MD5: fooMD5},
+		"UpperCaseHex":   {SHA256: strings.ToUpper(fooSHA256)},
+		"FetchChecksum":  {FetchChecksum: true},
+		"FetchWithOther": {FetchChecksum: true, MD5: fooMD5},
+	} {
+		t.Run("Valid"+name, func(t *testing.T) {
+			assert.NoError(t, info.validateChecksums())
+		})
+	}
+
+	for name, info := range map[string]DownloadInfo{
+		"NotHex":         {SHA256: strings.Repeat("z", 64)},
+		"WrongLength":    {SHA1: fooMD5},
+		"FetchAndSHA256": {FetchChecksum: true, SHA256: fooSHA256},
+	} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			assert.Error(t, info.validateChecksums())
+		})
+	}
+}
+
+func TestDownloadChecksumVerification(t *testing.T) {
+	checksumFile := fooSHA256 + "  foo\n"
+	var checksumRequests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/foo":
+			fmt.Fprint(rw, "foo")
+		case "/foo" + ChecksumFileExtension:
+			atomic.AddInt32(&checksumRequests, 1)
+			fmt.Fprint(rw, checksumFile)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	for name, test := range map[string]struct {
+		info     DownloadInfo
+		checksum string
+		success  bool
+	}{
+		"MatchingChecksums":        {info: DownloadInfo{SHA256: fooSHA256, SHA1: fooSHA1, MD5: fooMD5}, success: true},
+		"MismatchedChecksum":       {info: DownloadInfo{SHA256: fooSHA256, MD5: strings.Repeat("0", 32)}},
+		"FetchedChecksum":          {info: DownloadInfo{FetchChecksum: true}, checksum: checksumFile, success: true},
+		"FetchedBareChecksum":      {info: DownloadInfo{FetchChecksum: true}, checksum: fooSHA256, success: true},
+		"FetchedMismatch":          {info: DownloadInfo{FetchChecksum: true}, checksum: fooSHA1 + fooSHA1[:24]},
+		"FetchedMalformedChecksum": {info: DownloadInfo{FetchChecksum: true}, checksum: "foo"},
+		"FetchedEmptyChecksum":     {info: DownloadInfo{FetchChecksum: true}, checksum: ""},
+		"MissingChecksumFile":      {info: DownloadInfo{FetchChecksum: true, URL: srv.URL + "/bar"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			checksumFile = test.checksum
+			dir, err := ioutil.TempDir("build", "checksum")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			info := test.info
+			if info.URL == "" {
+				info.URL = srv.URL + "/foo"
+			}
+			info.Path = filepath.Join(dir, "foo")
+
+			ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+			defer cancel()
+			err = info.DownloadContext(ctx)
+			if !test.success {
+				assert.Error(t, err)
+				_, err = os.Stat(info.Path)
+				assert.True(t, os.IsNotExist(err), "unverified download should not be kept")
+				return
+			}
+			require.NoError(t, err)
+			contents, err := ioutil.ReadFile(info.Path)
+			require.NoError(t, err)
+			assert.Equal(t, "foo", string(contents))
+		})
+	}
+
+	t.Run("CachedDownloadFetchesChecksumOnce", func(t *testing.T) {
+		checksumFile = fooSHA256
+		atomic.StoreInt32(&checksumRequests, 0)
+		dir, err := ioutil.TempDir("build", "checksum")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		cache, err := NewDownloadCache(filepath.Join(dir, "cache"))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+		defer cancel()
+		info := DownloadInfo{URL: srv.URL + "/foo", Path: filepath.Join(dir, "foo"), FetchChecksum: true}
+		require.NoError(t, cache.Download(ctx, info))
+		assert.EqualValues(t, 1, atomic.LoadInt32(&checksumRequests))
+	})
+}
@@ -0,0 +1,681 @@
+package jasper
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/dependency"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/queue"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tychoish/lru"
+)
+
+const (
+	// DefaultDownloadJobWorkers is the number of download jobs that the
+	// REST and RPC services run at once, unless they are configured
+	// with DownloadOptions.
+	DefaultDownloadJobWorkers = 2
+	// MaxDownloadJobWorkers is the largest number of download jobs that
+	// can run at once.
+	MaxDownloadJobWorkers = 32
+	// DownloadJobTTL is how long a download job is kept after it
+	// finishes.
+	DownloadJobTTL = time.Hour
+	// MaxFinishedDownloadJobs is the largest number of finished download
+	// jobs that are kept. The jobs that started first are removed first.
+	MaxFinishedDownloadJobs = 1000
+)
+
+// DownloadOptions configures how a service runs download jobs. Workers is
+// the number of jobs that run at once, and defaults to
+// DefaultDownloadJobWorkers. If MaxBytesPerSecond is set, it limits the
+// total rate of all of the service's downloads, in addition to the limit
+// of each download.
+type DownloadOptions struct {
+	Workers           int   `json:"workers"`
+	MaxBytesPerSecond int64 `json:"max_bytes_per_second"`
+}
+
+// Validate checks the download options.
+func (opts DownloadOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+
+	if opts.Workers < 0 || opts.Workers > MaxDownloadJobWorkers {
+		catcher.Add(errors.Errorf("workers must be between 0 and %d", MaxDownloadJobWorkers))
+	}
+	if opts.MaxBytesPerSecond < 0 {
+		catcher.Add(errors.New("cannot have a negative bandwidth limit"))
+	}
+
+	return catcher.Resolve()
+}
+
+// DownloadStatus reports how a service runs download jobs, the number of
+// jobs that are waiting for a worker and running, and the number and
+// total size of the entries in its download cache.
+type DownloadStatus struct {
+	Options      DownloadOptions `json:"options"`
+	Pending      int             `json:"pending"`
+	Running      int             `json:"running"`
+	CacheEntries int             `json:"cache_entries"`
+	CacheSize    int64           `json:"cache_size"`
+}
+
+// DownloadJobState is a type for representing the state of a download
+// job.
+type DownloadJobState string
+
+const (
+	// DownloadJobPending is the state of a download job that is waiting
+	// for a worker.
+	DownloadJobPending DownloadJobState = "pending"
+	// DownloadJobRunning is the state of a download job that is
+	// downloading or extracting its file.
+	DownloadJobRunning DownloadJobState = "running"
+	// DownloadJobCompleted is the state of a download job that finished
+	// successfully.
+	DownloadJobCompleted DownloadJobState = "completed"
+	// DownloadJobFailed is the state of a download job that finished with
+	// an error.
+	DownloadJobFailed DownloadJobState = "failed"
+	// DownloadJobCanceled is the state of a download job that was
+	// canceled before it finished.
+	DownloadJobCanceled DownloadJobState = "canceled"
+)
+
+// Done returns whether the download job is in a final state.
+func (s DownloadJobState) Done() bool {
+	return s == DownloadJobCompleted || s == DownloadJobFailed || s == DownloadJobCanceled
+}
+
+// DownloadJobStatus reports the state and progress of a download job.
+// BytesTotal is -1 if the server did not report the size of the download,
+// and Rate is the average number of bytes transferred per second since
+// the job started. Extracted reports what was written by extracting the
+// download, once the job completes.
+type DownloadJobStatus struct {
+	ID               string           `json:"id"`
+	Info             DownloadInfo     `json:"info"`
+	State            DownloadJobState `json:"state"`
+	BytesTransferred int64            `json:"bytes_transferred"`
+	BytesTotal       int64            `json:"bytes_total"`
+	Rate             float64          `json:"rate"`
+	Error            string           `json:"error,omitempty"`
+	StartTime        time.Time        `json:"start_time"`
+	EndTime          time.Time        `json:"end_time"`
+	Extracted        ArchiveReport    `json:"extracted"`
+}
+
+// downloadProgress counts the bytes written to the download file. It is
+// safe for concurrent use.
+type downloadProgress struct {
+	transferred int64
+	total       int64
+}
+
+func newDownloadProgress() *downloadProgress {
+	return &downloadProgress{total: -1}
+}
+
+// reset starts counting from offset bytes, the size of the data that
+// has already been downloaded, out of total bytes.
+func (p *downloadProgress) reset(offset, total int64) {
+	atomic.StoreInt64(&p.transferred, offset)
+	atomic.StoreInt64(&p.total, total)
+}
+
+func (p *downloadProgress) Write(b []byte) (int, error) {
+	atomic.AddInt64(&p.transferred, int64(len(b)))
+	return len(b), nil
+}
+
+// DownloadJobs runs downloads in the background on an amboy queue, and
+// tracks them by ID so that they can be observed, waited on, and
+// canceled. Jobs wait to be added to the queue until one of the workers
+// is free, so that the number of workers can be changed while jobs are
+// waiting. Finished jobs are kept for DownloadJobTTL, up to
+// MaxFinishedDownloadJobs of them.
+type DownloadJobs struct {
+	queue       amboy.Queue
+	cancel      context.CancelFunc
+	closed      bool
+	cache       *DownloadCache
+	limiter     *bandwidthLimiter
+	workers     int
+	running     int
+	pending     []*downloadJob
+	jobs        map[string]*downloadJob
+	order       []string
+	ttl         time.Duration
+	maxFinished int
+	mu          sync.RWMutex
+}
+
+// downloadGroup limits the number of the jobs started by one request
+// that run at once.
+type downloadGroup struct {
+	workers int
+	running int
+}
+
+// NewDownloadJobs returns a DownloadJobs that runs at most workers
+// downloads at once. The downloads are canceled, and the workers are
+// stopped, when the context is done or Close is called.
+func NewDownloadJobs(ctx context.Context, workers int) (*DownloadJobs, error) {
+	if workers <= 0 || workers > MaxDownloadJobWorkers {
+		return nil, errors.Errorf("must have between 1 and %d download workers", MaxDownloadJobWorkers)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	q := queue.NewLocalUnordered(MaxDownloadJobWorkers)
+	if err := q.Start(ctx); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "problem starting download job queue")
+	}
+
+	d := &DownloadJobs{
+		queue:       q,
+		cancel:      cancel,
+		limiter:     newBandwidthLimiter(0),
+		workers:     workers,
+		jobs:        map[string]*downloadJob{},
+		ttl:         DownloadJobTTL,
+		maxFinished: MaxFinishedDownloadJobs,
+	}
+	go func() {
+		<-ctx.Done()
+		d.Close()
+	}()
+
+	return d, nil
+}
+
+// Close cancels the download jobs that have not finished and stops the
+// workers. Jobs cannot be started once it is closed.
+func (d *DownloadJobs) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return
+	}
+	d.closed = true
+
+	for _, j := range d.jobs {
+		_ = j.stop()
+	}
+	d.pending = nil
+	d.cancel()
+}
+
+// Configure changes the number of download jobs that run at once and the
+// total bandwidth limit of the downloads. The bandwidth limit applies to
+// the downloads that are already running.
+func (d *DownloadJobs) Configure(opts DownloadOptions) error {
+	if err := opts.Validate(); err != nil {
+		return errors.Wrap(err, "problem validating download options")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.workers = opts.Workers
+	if d.workers == 0 {
+		d.workers = DefaultDownloadJobWorkers
+	}
+	d.limiter.setRate(opts.MaxBytesPerSecond)
+	d.dispatch()
+
+	return nil
+}
+
+// Status returns the configuration of the download jobs, the number of
+// jobs that are pending and running, and the size of the download cache.
+func (d *DownloadJobs) Status() DownloadStatus {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	status := DownloadStatus{
+		Options: DownloadOptions{
+			Workers:           d.workers,
+			MaxBytesPerSecond: d.limiter.getRate(),
+		},
+	}
+	for _, j := range d.jobs {
+		switch j.status().State {
+		case DownloadJobPending:
+			status.Pending++
+		case DownloadJobRunning:
+			status.Running++
+		}
+	}
+	if d.cache != nil {
+		status.CacheEntries = len(d.cache.List())
+		status.CacheSize = d.cache.Size()
+	}
+
+	return status
+}
+
+// Download runs the download immediately, without waiting for a worker,
+// using the download cache and within the total bandwidth limit of the
+// download jobs.
+func (d *DownloadJobs) Download(ctx context.Context, info DownloadInfo) error {
+	if err := info.Validate(); err != nil {
+		return errors.Wrap(err, "problem validating download info")
+	}
+
+	d.mu.RLock()
+	cache := d.cache
+	d.mu.RUnlock()
+
+	info.limiter = d.limiter
+	_, err := cache.download(ctx, info, nil)
+	return err
+}
+
+// SetCache configures the download jobs that are started after it is
+// called to use the download cache. If the cache is nil, the jobs
+// always download their files.
+func (d *DownloadJobs) SetCache(cache *DownloadCache) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.cache = cache
+}
+
+// Start validates the download and starts a job to run it, returning the
+// status of the new job.
+func (d *DownloadJobs) Start(info DownloadInfo) (DownloadJobStatus, error) {
+	return d.start(info, nil, nil)
+}
+
+// start starts a job to run the download, and calls onComplete, if it is
+// not nil, once the download succeeds. If the group is not nil, the job
+// waits for the other jobs in the group to finish if the group is at its
+// worker limit.
+func (d *DownloadJobs) start(info DownloadInfo, group *downloadGroup, onComplete func() error) (DownloadJobStatus, error) {
+	if err := info.Validate(); err != nil {
+		return DownloadJobStatus{}, errors.Wrap(err, "problem validating download info")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return DownloadJobStatus{}, errors.New("download jobs are closed")
+	}
+	d.prune()
+
+	j := newDownloadJob(info, d.cache, onComplete)
+	j.limiter = d.limiter
+	j.group = group
+	j.finished = func() { d.finish(j) }
+
+	d.jobs[j.ID()] = j
+	d.order = append(d.order, j.ID())
+	d.pending = append(d.pending, j)
+	d.dispatch()
+
+	return j.status(), nil
+}
+
+// dispatch adds the pending jobs to the queue, in the order in which they
+// were started, while there are free workers. Jobs that were canceled
+// before they were added are dropped. The caller must hold the write
+// lock.
+func (d *DownloadJobs) dispatch() {
+	pending := d.pending[:0]
+	for _, j := range d.pending {
+		if j.status().State.Done() {
+			continue
+		}
+		if d.running >= d.workers || (j.group != nil && j.group.running >= j.group.workers) {
+			pending = append(pending, j)
+			continue
+		}
+
+		if err := d.queue.Put(j); err != nil {
+			j.fail(errors.Wrapf(err, "problem adding download job for %s", j.info.URL))
+			continue
+		}
+		d.running++
+		if j.group != nil {
+			j.group.running++
+		}
+	}
+	for i := len(pending); i < len(d.pending); i++ {
+		d.pending[i] = nil
+	}
+	d.pending = pending
+}
+
+// finish frees the worker of a job that was added to the queue, and
+// dispatches the next pending job.
+func (d *DownloadJobs) finish(j *downloadJob) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.running--
+	if j.group != nil {
+		j.group.running--
+	}
+	if !d.closed {
+		d.dispatch()
+	}
+	d.prune()
+}
+
+// prune removes the finished jobs that are older than the TTL, and then
+// the oldest finished jobs that are over the limit. The caller must hold
+// the write lock.
+func (d *DownloadJobs) prune() {
+	cutoff := time.Now().Add(-d.ttl)
+	finished := 0
+	order := d.order[:0]
+	for _, id := range d.order {
+		end, done := d.jobs[id].finishedAt()
+		if done && end.Before(cutoff) {
+			delete(d.jobs, id)
+			continue
+		}
+		if done {
+			finished++
+		}
+		order = append(order, id)
+	}
+
+	extra := finished - d.maxFinished
+	d.order = order[:0]
+	for _, id := range order {
+		if _, done := d.jobs[id].finishedAt(); done && extra > 0 {
+			delete(d.jobs, id)
+			extra--
+			continue
+		}
+		d.order = append(d.order, id)
+	}
+}
+
+// StartMongoDB starts a job to download and extract each of the MongoDB
+// releases that is not already extracted, and adds the files to the cache
+// once each job completes. It returns the statuses of the jobs that it
+// started.
+func (d *DownloadJobs) StartMongoDB(ctx context.Context, cache *lru.Cache, opts MongoDBDownloadOptions) ([]DownloadJobStatus, error) {
+	catcher := grip.NewBasicCatcher()
+	releases, err := ResolveMongoDBReleases(ctx, opts)
+	if releases == nil && err != nil {
+		return nil, err
+	}
+	catcher.Add(errors.Wrap(err, "problem initializing download jobs"))
+
+	var group *downloadGroup
+	if opts.Workers > 0 {
+		group = &downloadGroup{workers: opts.Workers}
+	}
+
+	statuses := []DownloadJobStatus{}
+	for _, release := range releases {
+		if release.Present {
+			continue
+		}
+
+		// Match the file names of the bond download jobs, so that the
+		// archives can be found in the cache and by their format.
+		fileName := mongoDBArchiveFile(release.URL)
+		info := DownloadInfo{
+			URL:    release.URL,
+			Path:   filepath.Join(opts.Path, fileName),
+			SHA256: release.SHA256,
+			ArchiveOpts: ArchiveOptions{
+				ShouldExtract:   true,
+				Format:          ArchiveAuto,
+				TargetPath:      release.Path,
+				StripComponents: 1,
+			},
+			Sources:           opts.Sources,
+			MaxBytesPerSecond: opts.MaxBytesPerSecond,
+		}
+
+		status, err := d.start(info, group, func() error {
+			return addMongoDBFilesToCache(cache, opts.Path)(fileName)
+		})
+		if err != nil {
+			catcher.Add(errors.Wrapf(err, "problem creating download job for %s", release.URL))
+			continue
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, catcher.Resolve()
+}
+
+func (d *DownloadJobs) get(id string) (*downloadJob, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	j, ok := d.jobs[id]
+	if !ok {
+		return nil, errors.Errorf("no download job '%s' found", id)
+	}
+	return j, nil
+}
+
+// Get returns the status of the download job with the given ID.
+func (d *DownloadJobs) Get(id string) (DownloadJobStatus, error) {
+	j, err := d.get(id)
+	if err != nil {
+		return DownloadJobStatus{}, err
+	}
+	return j.status(), nil
+}
+
+// List returns the statuses of all download jobs, in the order in which
+// they were started.
+func (d *DownloadJobs) List() []DownloadJobStatus {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	statuses := make([]DownloadJobStatus, 0, len(d.order))
+	for _, id := range d.order {
+		statuses = append(statuses, d.jobs[id].status())
+	}
+	return statuses
+}
+
+// Cancel cancels the download job with the given ID. It returns an error
+// if the job has already finished.
+func (d *DownloadJobs) Cancel(id string) error {
+	j, err := d.get(id)
+	if err != nil {
+		return err
+	}
+	return j.stop()
+}
+
+// Wait blocks until the download job with the given ID finishes or the
+// context is done, and returns the status of the job. It does not return
+// an error if the job failed or was canceled; check the State of the
+// status instead.
+func (d *DownloadJobs) Wait(ctx context.Context, id string) (DownloadJobStatus, error) {
+	j, err := d.get(id)
+	if err != nil {
+		return DownloadJobStatus{}, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return j.status(), errors.Wrapf(ctx.Err(), "problem waiting for download job '%s'", id)
+	case <-j.done:
+		return j.status(), nil
+	}
+}
+
+// downloadJob is an amboy.Job that runs a download and records its
+// progress.
+type downloadJob struct {
+	*job.Base
+	info       DownloadInfo
+	cache      *DownloadCache
+	limiter    *bandwidthLimiter
+	group      *downloadGroup
+	onComplete func() error
+	finished   func()
+	progress   *downloadProgress
+	extracted  ArchiveReport
+	state      DownloadJobState
+	err        error
+	startTime  time.Time
+	endTime    time.Time
+	canceled   bool
+	cancel     context.CancelFunc
+	done       chan struct{}
+	mu         sync.RWMutex
+}
+
+func newDownloadJob(info DownloadInfo, cache *DownloadCache, onComplete func() error) *downloadJob {
+	j := &downloadJob{
+		Base: &job.Base{
+			JobType: amboy.JobType{
+				Name:    "jasper-download-file",
+				Version: 0,
+			},
+		},
+		info:       info,
+		cache:      cache,
+		onComplete: onComplete,
+		progress:   newDownloadProgress(),
+		state:      DownloadJobPending,
+		done:       make(chan struct{}),
+	}
+	j.SetID(uuid.Must(uuid.NewV4()).String())
+	j.SetDependency(dependency.NewAlways())
+
+	return j
+}
+
+func (j *downloadJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.finished != nil {
+		defer j.finished()
+	}
+
+	j.mu.Lock()
+	if j.canceled {
+		j.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	j.cancel = cancel
+	j.state = DownloadJobRunning
+	j.startTime = time.Now()
+	j.mu.Unlock()
+
+	info := j.info
+	info.limiter = j.limiter
+	extracted, err := j.cache.download(ctx, info, j.progress)
+	if err == nil && j.onComplete != nil {
+		err = j.onComplete()
+	}
+	j.AddError(err)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.err = err
+	j.extracted = extracted
+	switch {
+	case j.canceled:
+		j.state = DownloadJobCanceled
+	case err != nil:
+		j.state = DownloadJobFailed
+	default:
+		j.state = DownloadJobCompleted
+	}
+	j.endTime = time.Now()
+	close(j.done)
+}
+
+// stop cancels the job. A job that has not started yet is marked as
+// canceled immediately, and does not run.
+func (j *downloadJob) stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.state.Done() {
+		return errors.Errorf("download job '%s' is already %s", j.ID(), j.state)
+	}
+	j.canceled = true
+
+	if j.state == DownloadJobPending {
+		j.state = DownloadJobCanceled
+		j.endTime = time.Now()
+		close(j.done)
+		return nil
+	}
+	j.cancel()
+
+	return nil
+}
+
+// finishedAt returns when the job finished, and whether it has.
+func (j *downloadJob) finishedAt() (time.Time, bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return j.endTime, j.state.Done()
+}
+
+// fail marks a job that could not be run as failed, unless it was
+// already canceled.
+func (j *downloadJob) fail(err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.state.Done() {
+		return
+	}
+	j.err = err
+	j.state = DownloadJobFailed
+	j.endTime = time.Now()
+	close(j.done)
+}
+
+func (j *downloadJob) status() DownloadJobStatus {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	status := DownloadJobStatus{
+		ID:               j.ID(),
+		Info:             j.info,
+		State:            j.state,
+		BytesTransferred: atomic.LoadInt64(&j.progress.transferred),
+		BytesTotal:       atomic.LoadInt64(&j.progress.total),
+		StartTime:        j.startTime,
+		EndTime:          j.endTime,
+		Extracted:        j.extracted,
+	}
+	if j.err != nil {
+		status.Error = j.err.Error()
+	}
+
+	if !j.startTime.IsZero() {
+		end := j.endTime
+		if end.IsZero() {
+			end = time.Now()
+		}
+		if elapsed := end.Sub(j.startTime).Seconds(); elapsed > 0 {
+			status.Rate = float64(status.BytesTransferred) / elapsed
+		}
+	}
+
+	return status
+}
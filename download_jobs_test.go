@@ -0,0 +1,285 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitForDownloadJobState polls the download job until it reaches the
+// state.
+func waitForDownloadJobState(ctx context.Context, t *testing.T, jobs *DownloadJobs, id string, state DownloadJobState) {
+	for {
+		status, err := jobs.Get(id)
+		require.NoError(t, err)
+		if status.State == state {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "download job did not reach state", "job is %s instead of %s", status.State, state)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestDownloadJobs(t *testing.T) {
+	content := bytes.Repeat([]byte("foobar"), 1024)
+
+	for name, test := range map[string]func(context.Context, *testing.T, *DownloadJobs, *flakyDownloadServer, DownloadInfo){
+		"CompletesAndReportsProgress": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			status, err := jobs.Start(info)
+			require.NoError(t, err)
+			assert.NotEmpty(t, status.ID)
+			assert.Equal(t, info, status.Info)
+
+			status, err = jobs.Wait(ctx, status.ID)
+			require.NoError(t, err)
+			assert.Equal(t, DownloadJobCompleted, status.State)
+			assert.Empty(t, status.Error)
+			assert.EqualValues(t, len(content), status.BytesTransferred)
+			assert.EqualValues(t, len(content), status.BytesTotal)
+			assert.False(t, status.StartTime.IsZero())
+			assert.False(t, status.EndTime.IsZero())
+
+			downloaded, err := ioutil.ReadFile(info.Path)
+			require.NoError(t, err)
+			assert.Equal(t, content, downloaded)
+		},
+		"ReportsFailures": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			s.failures = 1
+			status, err := jobs.Start(info)
+			require.NoError(t, err)
+
+			status, err = jobs.Wait(ctx, status.ID)
+			require.NoError(t, err)
+			assert.Equal(t, DownloadJobFailed, status.State)
+			assert.NotEmpty(t, status.Error)
+		},
+		"StartFailsWithInvalidInfo": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			info.Path = "foo"
+			_, err := jobs.Start(info)
+			assert.Error(t, err)
+			assert.Empty(t, jobs.List())
+		},
+		"CancelStopsRunningJob": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			status, err := jobs.Start(info)
+			require.NoError(t, err)
+
+			for status.State == DownloadJobPending {
+				time.Sleep(10 * time.Millisecond)
+				status, err = jobs.Get(status.ID)
+				require.NoError(t, err)
+			}
+			require.NoError(t, jobs.Cancel(status.ID))
+
+			status, err = jobs.Wait(ctx, status.ID)
+			require.NoError(t, err)
+			assert.Equal(t, DownloadJobCanceled, status.State)
+			_, err = os.Stat(info.Path)
+			assert.True(t, os.IsNotExist(err))
+
+			assert.Error(t, jobs.Cancel(status.ID))
+		},
+		"CancelStopsPendingJob": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			running, err := jobs.Start(info)
+			require.NoError(t, err)
+			pending, err := jobs.Start(info)
+			require.NoError(t, err)
+			assert.Equal(t, DownloadJobPending, pending.State)
+
+			require.NoError(t, jobs.Cancel(pending.ID))
+			pending, err = jobs.Wait(ctx, pending.ID)
+			require.NoError(t, err)
+			assert.Equal(t, DownloadJobCanceled, pending.State)
+			assert.True(t, pending.StartTime.IsZero())
+
+			require.NoError(t, jobs.Cancel(running.ID))
+		},
+		"ListReturnsJobsInOrder": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			first, err := jobs.Start(info)
+			require.NoError(t, err)
+			info.Path += "2"
+			second, err := jobs.Start(info)
+			require.NoError(t, err)
+
+			statuses := jobs.List()
+			require.Len(t, statuses, 2)
+			assert.Equal(t, first.ID, statuses[0].ID)
+			assert.Equal(t, second.ID, statuses[1].ID)
+		},
+		"WaitReturnsWhenContextIsDone": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			status, err := jobs.Start(info)
+			require.NoError(t, err)
+
+			waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			_, err = jobs.Wait(waitCtx, status.ID)
+			assert.Error(t, err)
+
+			require.NoError(t, jobs.Cancel(status.ID))
+		},
+		"ConfigureChangesRunningJobs": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			ids := []string{}
+			for i := 0; i < 3; i++ {
+				status, err := jobs.Start(info)
+				require.NoError(t, err)
+				ids = append(ids, status.ID)
+			}
+			waitForDownloadJobState(ctx, t, jobs, ids[0], DownloadJobRunning)
+			status := jobs.Status()
+			assert.Equal(t, 1, status.Running)
+			assert.Equal(t, 2, status.Pending)
+
+			require.NoError(t, jobs.Configure(DownloadOptions{Workers: 2}))
+			waitForDownloadJobState(ctx, t, jobs, ids[1], DownloadJobRunning)
+			status = jobs.Status()
+			assert.Equal(t, 2, status.Running)
+			assert.Equal(t, 1, status.Pending)
+
+			require.NoError(t, jobs.Cancel(ids[0]))
+			waitForDownloadJobState(ctx, t, jobs, ids[2], DownloadJobRunning)
+			for _, id := range ids[1:] {
+				require.NoError(t, jobs.Cancel(id))
+			}
+		},
+		"GroupsLimitRunningJobs": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			require.NoError(t, jobs.Configure(DownloadOptions{Workers: 3}))
+			group := &downloadGroup{workers: 1}
+			first, err := jobs.start(info, group, nil)
+			require.NoError(t, err)
+			second, err := jobs.start(info, group, nil)
+			require.NoError(t, err)
+			other, err := jobs.Start(info)
+			require.NoError(t, err)
+
+			waitForDownloadJobState(ctx, t, jobs, first.ID, DownloadJobRunning)
+			waitForDownloadJobState(ctx, t, jobs, other.ID, DownloadJobRunning)
+			second, err = jobs.Get(second.ID)
+			require.NoError(t, err)
+			assert.Equal(t, DownloadJobPending, second.State)
+
+			require.NoError(t, jobs.Cancel(first.ID))
+			waitForDownloadJobState(ctx, t, jobs, second.ID, DownloadJobRunning)
+			require.NoError(t, jobs.Cancel(second.ID))
+			require.NoError(t, jobs.Cancel(other.ID))
+		},
+		"BandwidthLimitSlowsDownloads": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			require.NoError(t, jobs.Configure(DownloadOptions{Workers: 2, MaxBytesPerSecond: 8 * 1024}))
+			info.MaxBytesPerSecond = 4 * 1024
+
+			start := time.Now()
+			status, err := jobs.Start(info)
+			require.NoError(t, err)
+			info.Path += "2"
+			other, err := jobs.Start(info)
+			require.NoError(t, err)
+
+			for _, id := range []string{status.ID, other.ID} {
+				status, err = jobs.Wait(ctx, id)
+				require.NoError(t, err)
+				assert.Equal(t, DownloadJobCompleted, status.State, status.Error)
+			}
+			// Each download is limited to 4KiB/s and both share 8KiB/s,
+			// so the 6KiB downloads take at least a second.
+			assert.True(t, time.Since(start) >= time.Second)
+		},
+		"StatusReportsConfiguration": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			assert.Equal(t, DownloadStatus{Options: DownloadOptions{Workers: 1}}, jobs.Status())
+
+			assert.Error(t, jobs.Configure(DownloadOptions{Workers: -1}))
+			assert.Error(t, jobs.Configure(DownloadOptions{Workers: MaxDownloadJobWorkers + 1}))
+			assert.Error(t, jobs.Configure(DownloadOptions{MaxBytesPerSecond: -1}))
+
+			require.NoError(t, jobs.Configure(DownloadOptions{MaxBytesPerSecond: 1024}))
+			assert.Equal(t, DownloadOptions{Workers: DefaultDownloadJobWorkers, MaxBytesPerSecond: 1024}, jobs.Status().Options)
+		},
+		"CloseCancelsJobs": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			running, err := jobs.Start(info)
+			require.NoError(t, err)
+			pending, err := jobs.Start(info)
+			require.NoError(t, err)
+			waitForDownloadJobState(ctx, t, jobs, running.ID, DownloadJobRunning)
+
+			jobs.Close()
+			for _, id := range []string{running.ID, pending.ID} {
+				status, err := jobs.Wait(ctx, id)
+				require.NoError(t, err)
+				assert.Equal(t, DownloadJobCanceled, status.State)
+			}
+			_, err = jobs.Start(info)
+			assert.Error(t, err)
+			jobs.Close()
+		},
+		"PrunesFinishedJobs": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			jobs.maxFinished = 1
+			ids := []string{}
+			for i := 0; i < 2; i++ {
+				status, err := jobs.Start(info)
+				require.NoError(t, err)
+				_, err = jobs.Wait(ctx, status.ID)
+				require.NoError(t, err)
+				ids = append(ids, status.ID)
+			}
+
+			s.hang = true
+			running, err := jobs.Start(info)
+			require.NoError(t, err)
+			statuses := jobs.List()
+			require.Len(t, statuses, 2)
+			assert.Equal(t, ids[1], statuses[0].ID)
+			_, err = jobs.Get(ids[0])
+			assert.Error(t, err)
+
+			jobs.ttl = 0
+			_, err = jobs.Start(info)
+			require.NoError(t, err)
+			statuses = jobs.List()
+			require.Len(t, statuses, 2)
+			assert.Equal(t, running.ID, statuses[0].ID)
+			jobs.Close()
+		},
+		"MissingJobsAreErrors": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {
+			_, err := jobs.Get("foo")
+			assert.Error(t, err)
+			_, err = jobs.Wait(ctx, "foo")
+			assert.Error(t, err)
+			assert.Error(t, jobs.Cancel("foo"))
+		},
+		// "": func(ctx context.Context, t *testing.T, jobs *DownloadJobs, s *flakyDownloadServer, info DownloadInfo) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			s := &flakyDownloadServer{content: content}
+			server := httptest.NewServer(s)
+			defer server.Close()
+
+			dir, err := ioutil.TempDir("build", "download_jobs")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			absDir, err := filepath.Abs(dir)
+			require.NoError(t, err)
+
+			jobs, err := NewDownloadJobs(ctx, 1)
+			require.NoError(t, err)
+
+			test(ctx, t, jobs, s, DownloadInfo{URL: server.URL, Path: filepath.Join(absDir, "foo")})
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package jasper
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/tychoish/bond"
+)
+
+// MongoDBRelease is a build of MongoDB that a version spec resolved to,
+// and where it is, or will be, extracted under the download path.
+//
+// Version specs are exact versions, such as "4.0.3" or "4.2.0-rc1"; the
+// newest release of a series, such as "3.6.x"; the release of a series
+// that the feed marks as current, such as "3.6-current" or "3.6-stable";
+// the nightly build of a series, such as "4.0-latest" or "4.0"; or the
+// newest release of any series, "latest-stable".
+type MongoDBRelease struct {
+	// Spec is the version spec that resolved to the release.
+	Spec string `json:"spec"`
+	// Version is the version of the release, or the series followed
+	// by "-latest" for nightly builds.
+	Version string `json:"version"`
+	// URL is the URL of the release's archive.
+	URL string `json:"url"`
+	// SHA256 is the checksum of the archive, if the feed has one.
+	SHA256 string `json:"sha256,omitempty"`
+	// Path is the directory that the archive is extracted into.
+	Path string `json:"path"`
+	// BinPath is the directory of the release's binaries.
+	BinPath string `json:"bin_path"`
+	// Present is whether the release is already extracted.
+	Present bool `json:"present"`
+}
+
+// ResolveMongoDBReleases resolves each of the version specs in the
+// releases of the options to a build of MongoDB, using the feed of
+// releases under the download path, and reports whether each build is
+// already extracted there. It does not download the builds.
+func ResolveMongoDBReleases(ctx context.Context, opts MongoDBDownloadOptions) ([]MongoDBRelease, error) {
+	if err := makeEnclosingDirectories(opts.Path); err != nil {
+		return nil, errors.Wrap(err, "problem creating enclosing directories")
+	}
+
+	feed, err := getArtifactsFeed(ctx, opts.Path, opts.Sources)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem making artifacts feed")
+	}
+
+	return resolveMongoDBReleases(feed, opts)
+}
+
+// resolveMongoDBReleases resolves the releases of the options with the
+// feed. It returns the releases that it resolved along with an error for
+// those that it could not.
+func resolveMongoDBReleases(feed *bond.ArtifactsFeed, opts MongoDBDownloadOptions) ([]MongoDBRelease, error) {
+	catcher := grip.NewBasicCatcher()
+	releases := make([]MongoDBRelease, 0, len(opts.Releases))
+	for _, spec := range opts.Releases {
+		release, err := resolveMongoDBRelease(feed, spec, opts.BuildOpts)
+		if err != nil {
+			catcher.Add(errors.Wrapf(err, "problem resolving MongoDB version '%s'", spec))
+			continue
+		}
+
+		release.Path = filepath.Join(opts.Path, mongoDBArchiveDirectory(release.URL))
+		release.BinPath = filepath.Join(release.Path, "bin")
+		mongod := "mongod"
+		if runtime.GOOS == "windows" {
+			mongod += ".exe"
+		}
+		if stat, err := os.Stat(filepath.Join(release.BinPath, mongod)); err == nil && stat.Mode().IsRegular() {
+			release.Present = true
+		}
+
+		releases = append(releases, release)
+	}
+
+	return releases, catcher.Resolve()
+}
+
+func resolveMongoDBRelease(feed *bond.ArtifactsFeed, spec string, opts bond.BuildOptions) (MongoDBRelease, error) {
+	release := MongoDBRelease{Spec: spec}
+	spec = strings.TrimSpace(spec)
+
+	var version *bond.ArtifactVersion
+	switch {
+	case spec == "":
+		return release, errors.New("version spec is empty")
+	case spec == "latest-stable" || spec == "latest stable" || spec == "stable":
+		version = newestMongoDBRelease(feed, "")
+		if version == nil {
+			return release, errors.New("feed has no stable releases")
+		}
+	case strings.HasSuffix(spec, ".x") || strings.HasSuffix(spec, ".*"):
+		series := strings.TrimPrefix(spec[:len(spec)-2], "v")
+		version = newestMongoDBRelease(feed, series)
+		if version == nil {
+			return release, errors.Errorf("feed has no stable releases in series '%s'", series)
+		}
+	case strings.HasSuffix(spec, "-current") || strings.HasSuffix(spec, "-stable"):
+		var err error
+		if version, err = feed.GetStableRelease(spec[:strings.LastIndex(spec, "-")]); err != nil {
+			return release, errors.WithStack(err)
+		}
+	case strings.HasSuffix(spec, "-latest") || isMongoDBSeries(spec):
+		series := strings.TrimPrefix(strings.TrimSuffix(spec, "-latest"), "v")
+		if !isMongoDBSeries(series) {
+			return release, errors.Errorf("'%s' is not a release series", series)
+		}
+		url, err := feed.GetLatestArchive(series, opts)
+		if err != nil {
+			return release, errors.WithStack(err)
+		}
+		// Nightly builds change, so their checksums are not known.
+		release.Version = series + "-latest"
+		release.URL = url
+		return release, nil
+	default:
+		var ok bool
+		if version, ok = feed.GetVersion(strings.TrimPrefix(spec, "v")); !ok {
+			return release, errors.New("feed has no such version")
+		}
+	}
+
+	dl, err := version.GetDownload(opts)
+	if err != nil {
+		return release, errors.WithStack(err)
+	}
+	release.Version = version.Version
+	if opts.Debug {
+		release.URL = dl.Archive.Debug
+	} else {
+		release.URL = dl.Archive.URL
+		release.SHA256 = dl.Archive.Sha256
+	}
+	if release.URL == "" {
+		return release, errors.Errorf("feed has no archive for version %s", version.Version)
+	}
+
+	return release, nil
+}
+
+// isMongoDBSeries returns whether the string is a release series, such
+// as "4.0".
+func isMongoDBSeries(series string) bool {
+	parts := strings.Split(strings.TrimPrefix(series, "v"), ".")
+	if len(parts) != 2 {
+		return false
+	}
+	for _, part := range parts {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// newestMongoDBRelease returns the newest production release in the
+// feed that is in the series, or in any series if it is empty.
+func newestMongoDBRelease(feed *bond.ArtifactsFeed, series string) *bond.ArtifactVersion {
+	var newest *bond.ArtifactVersion
+	var newestParts []int
+	for _, version := range feed.Versions {
+		if !version.ProductionRelease || (series != "" && !strings.HasPrefix(version.Version, series+".")) {
+			continue
+		}
+		parts, ok := parseMongoDBVersion(version.Version)
+		if !ok {
+			continue
+		}
+		if newest == nil || compareMongoDBVersions(parts, newestParts) > 0 {
+			newest = version
+			newestParts = parts
+		}
+	}
+	return newest
+}
+
+// parseMongoDBVersion returns the numeric parts of a release version,
+// such as "4.0.3". Release candidates and other pre-releases are not
+// parsed.
+func parseMongoDBVersion(version string) ([]int, bool) {
+	fields := strings.Split(version, ".")
+	if len(fields) != 3 {
+		return nil, false
+	}
+
+	parts := make([]int, 0, len(fields))
+	for _, field := range fields {
+		part, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, false
+		}
+		parts = append(parts, part)
+	}
+	return parts, true
+}
+
+func compareMongoDBVersions(a, b []int) int {
+	for i := range a {
+		switch {
+		case a[i] > b[i]:
+			return 1
+		case a[i] < b[i]:
+			return -1
+		}
+	}
+	return 0
+}
+
+// mongoDBArchiveFile returns the name of the file that the MongoDB archive
+// at the URL is downloaded to, which matches the names used by bond.
+func mongoDBArchiveFile(url string) string {
+	fileName := path.Base(url)
+	if strings.HasSuffix(fileName, ".tar.gz") {
+		fileName = strings.TrimSuffix(fileName, ".tar.gz") + ".tgz"
+	}
+	return fileName
+}
+
+// mongoDBArchiveDirectory returns the name of the directory that the
+// MongoDB archive at the URL is extracted into, which is the name of the
+// archive without its extension.
+func mongoDBArchiveDirectory(url string) string {
+	fileName := path.Base(url)
+	for _, ext := range archiveExtensions {
+		if strings.HasSuffix(fileName, ext.ext) {
+			return strings.TrimSuffix(fileName, ext.ext)
+		}
+	}
+	return fileName
+}
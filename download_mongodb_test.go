@@ -0,0 +1,268 @@
+package jasper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tychoish/bond"
+	"github.com/tychoish/lru"
+)
+
+const testMongoDBURLPrefix = "http://downloads.example.invalid/linux/"
+
+// writeTestMongoDBFeed writes a feed of MongoDB releases for linux to the
+// directory, with the given checksum for the 4.0.3 archive.
+func writeTestMongoDBFeed(t *testing.T, dir, sha256 string) {
+	type archive struct {
+		URL    string `json:"url"`
+		Sha256 string `json:"sha256"`
+		Debug  string `json:"debug_symbols"`
+	}
+	type download struct {
+		Target  string  `json:"target"`
+		Arch    string  `json:"arch"`
+		Edition string  `json:"edition"`
+		Archive archive `json:"archive"`
+	}
+	type version struct {
+		Version           string     `json:"version"`
+		ProductionRelease bool       `json:"production_release"`
+		Current           bool       `json:"current"`
+		Downloads         []download `json:"downloads"`
+	}
+
+	versions := []version{}
+	for _, v := range []struct {
+		version    string
+		production bool
+		current    bool
+	}{
+		{version: "4.2.0-rc1"},
+		{version: "4.0.3", production: true, current: true},
+		{version: "4.0.1", production: true},
+		{version: "4.0.0", production: true},
+		{version: "3.6.9", production: true, current: true},
+		{version: "3.6.10-rc0"},
+	} {
+		name := "mongodb-linux-x86_64-" + v.version
+		sum := "sum-" + v.version
+		if v.version == "4.0.3" {
+			sum = sha256
+		}
+		versions = append(versions, version{
+			Version:           v.version,
+			ProductionRelease: v.production,
+			Current:           v.current,
+			Downloads: []download{{
+				Target:  "linux_x86_64",
+				Arch:    "x86_64",
+				Edition: "base",
+				Archive: archive{
+					URL:    testMongoDBURLPrefix + name + ".tgz",
+					Sha256: sum,
+					Debug:  testMongoDBURLPrefix + name + "-debugsymbols.tgz",
+				},
+			}},
+		})
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"versions": versions})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "full.json"), data, 0644))
+}
+
+// writeTestMongoDBMirror writes an archive of release 4.0.3 to a mirror
+// next to the feed, and configures the options to download release 4.0.x
+// from the mirror. It returns the extension of the release's executables.
+func writeTestMongoDBMirror(t *testing.T, opts *MongoDBDownloadOptions) string {
+	mirror := filepath.Join(filepath.Dir(opts.Path), "mirror")
+	require.NoError(t, os.MkdirAll(mirror, 0755))
+
+	var exe string
+	if runtime.GOOS == "windows" {
+		exe = ".exe"
+	}
+	archive := makeTestTar(t, []testArchiveEntry{
+		{name: "mongodb-linux-x86_64-4.0.3/bin/mongod" + exe, body: "mongod"},
+		{name: "mongodb-linux-x86_64-4.0.3/bin/mongos" + exe, body: "mongos"},
+	})
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	_, err := gzw.Write(archive)
+	require.NoError(t, err)
+	require.NoError(t, gzw.Close())
+	require.NoError(t, ioutil.WriteFile(filepath.Join(mirror, "mongodb-linux-x86_64-4.0.3.tgz"), buf.Bytes(), 0644))
+	sum := sha256.Sum256(buf.Bytes())
+	writeTestMongoDBFeed(t, opts.Path, hex.EncodeToString(sum[:]))
+
+	opts.Releases = []string{"4.0.x"}
+	opts.Sources.Rewrites = map[string]string{
+		testMongoDBURLPrefix: (&url.URL{Scheme: "file", Path: filepath.ToSlash(mirror) + "/"}).String(),
+	}
+
+	return exe
+}
+
+func TestResolveMongoDBReleases(t *testing.T) {
+	buildOpts := bond.BuildOptions{Target: "linux", Arch: bond.AMD64, Edition: bond.Base}
+
+	for name, test := range map[string]func(context.Context, *testing.T, MongoDBDownloadOptions){
+		"ResolvesVersionSpecs": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			for spec, expected := range map[string]string{
+				"4.0.3":         "4.0.3",
+				"v4.0.1":        "4.0.1",
+				"4.2.0-rc1":     "4.2.0-rc1",
+				"4.0.x":         "4.0.3",
+				"3.6.*":         "3.6.9",
+				"3.6-current":   "3.6.9",
+				"4.0-stable":    "4.0.3",
+				"latest-stable": "4.0.3",
+				"latest stable": "4.0.3",
+				"4.0-latest":    "4.0-latest",
+				"4.0":           "4.0-latest",
+			} {
+				opts.Releases = []string{spec}
+				releases, err := ResolveMongoDBReleases(ctx, opts)
+				require.NoError(t, err, spec)
+				require.Len(t, releases, 1, spec)
+				assert.Equal(t, spec, releases[0].Spec)
+				assert.Equal(t, expected, releases[0].Version, spec)
+			}
+		},
+		"ReportsArchiveAndBinaryPaths": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			opts.Releases = []string{"4.0.3", "4.0-latest"}
+			releases, err := ResolveMongoDBReleases(ctx, opts)
+			require.NoError(t, err)
+			require.Len(t, releases, 2)
+
+			assert.Equal(t, testMongoDBURLPrefix+"mongodb-linux-x86_64-4.0.3.tgz", releases[0].URL)
+			assert.Equal(t, "sum-4.0.3", releases[0].SHA256)
+			assert.Equal(t, filepath.Join(opts.Path, "mongodb-linux-x86_64-4.0.3"), releases[0].Path)
+			assert.Equal(t, filepath.Join(opts.Path, "mongodb-linux-x86_64-4.0.3", "bin"), releases[0].BinPath)
+			assert.False(t, releases[0].Present)
+
+			assert.Equal(t, testMongoDBURLPrefix+"mongodb-linux-x86_64-v4.0-latest.tgz", releases[1].URL)
+			assert.Empty(t, releases[1].SHA256)
+			assert.Equal(t, filepath.Join(opts.Path, "mongodb-linux-x86_64-v4.0-latest", "bin"), releases[1].BinPath)
+		},
+		"ReportsPresentReleases": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			mongod := "mongod"
+			if runtime.GOOS == "windows" {
+				mongod += ".exe"
+			}
+			binPath := filepath.Join(opts.Path, "mongodb-linux-x86_64-4.0.3", "bin")
+			require.NoError(t, os.MkdirAll(binPath, 0755))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(binPath, mongod), []byte("mongod"), 0755))
+
+			opts.Releases = []string{"4.0.x", "3.6.x"}
+			releases, err := ResolveMongoDBReleases(ctx, opts)
+			require.NoError(t, err)
+			require.Len(t, releases, 2)
+			assert.True(t, releases[0].Present)
+			assert.False(t, releases[1].Present)
+		},
+		"ResolvesDebugSymbols": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			opts.BuildOpts.Debug = true
+			opts.Releases = []string{"4.0.3"}
+			releases, err := ResolveMongoDBReleases(ctx, opts)
+			require.NoError(t, err)
+			require.Len(t, releases, 1)
+			assert.Equal(t, testMongoDBURLPrefix+"mongodb-linux-x86_64-4.0.3-debugsymbols.tgz", releases[0].URL)
+			assert.Empty(t, releases[0].SHA256)
+		},
+		"ReturnsResolvedReleasesWithErrors": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			opts.Releases = []string{"5.0.0", "4.0.3", "4.4.x", "4.4-current", "foo-latest", ""}
+			releases, err := ResolveMongoDBReleases(ctx, opts)
+			assert.Error(t, err)
+			require.Len(t, releases, 1)
+			assert.Equal(t, "4.0.3", releases[0].Version)
+		},
+		"RejectsBuildsMissingFromFeed": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			opts.BuildOpts.Target = "osx"
+			opts.Releases = []string{"4.0.3"}
+			releases, err := ResolveMongoDBReleases(ctx, opts)
+			assert.Error(t, err)
+			assert.Empty(t, releases)
+		},
+		"StartMongoDBDownloadsMissingReleases": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			exe := writeTestMongoDBMirror(t, &opts)
+
+			jobs, err := NewDownloadJobs(ctx, 1)
+			require.NoError(t, err)
+			jobs.SetCache(nil)
+			statuses, err := jobs.StartMongoDB(ctx, lru.NewCache(), opts)
+			require.NoError(t, err)
+			require.Len(t, statuses, 1)
+			status, err := jobs.Wait(ctx, statuses[0].ID)
+			require.NoError(t, err)
+			require.Equal(t, DownloadJobCompleted, status.State, status.Error)
+
+			releases, err := ResolveMongoDBReleases(ctx, opts)
+			require.NoError(t, err)
+			require.Len(t, releases, 1)
+			assert.True(t, releases[0].Present)
+			contents, err := ioutil.ReadFile(filepath.Join(releases[0].BinPath, "mongos"+exe))
+			require.NoError(t, err)
+			assert.Equal(t, "mongos", string(contents))
+
+			statuses, err = jobs.StartMongoDB(ctx, lru.NewCache(), opts)
+			require.NoError(t, err)
+			assert.Empty(t, statuses)
+		},
+		"SetupDownloadMongoDBReleasesDownloadsInBackground": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {
+			writeTestMongoDBMirror(t, &opts)
+			cache := lru.NewCache()
+			require.NoError(t, SetupDownloadMongoDBReleases(ctx, cache, opts))
+
+			timer := time.NewTimer(0)
+			defer timer.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					assert.Fail(t, "release was not downloaded")
+					return
+				case <-timer.C:
+					if cache.Count() > 0 {
+						releases, err := ResolveMongoDBReleases(ctx, opts)
+						require.NoError(t, err)
+						require.Len(t, releases, 1)
+						assert.True(t, releases[0].Present)
+						return
+					}
+					timer.Reset(10 * time.Millisecond)
+				}
+			}
+		},
+		// "": func(ctx context.Context, t *testing.T, opts MongoDBDownloadOptions) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+			defer cancel()
+
+			dir, err := ioutil.TempDir("build", "download_mongodb")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			dir, err = filepath.Abs(dir)
+			require.NoError(t, err)
+
+			path := filepath.Join(dir, "mongodb")
+			require.NoError(t, os.MkdirAll(path, 0755))
+			writeTestMongoDBFeed(t, path, "sum-4.0.3")
+
+			test(ctx, t, MongoDBDownloadOptions{BuildOpts: buildOpts, Path: path})
+		})
+	}
+}
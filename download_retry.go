@@ -0,0 +1,128 @@
+package jasper
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultDownloadRetryBackoff is the time that downloads wait before
+	// the first retry of a failed request if DownloadInfo does not
+	// specify one.
+	DefaultDownloadRetryBackoff = time.Second
+
+	// partialDownloadSuffix is appended to the path of a download to
+	// name the file that holds it until it completes.
+	partialDownloadSuffix = ".partial"
+)
+
+// retry calls attempt until it succeeds, it fails with an error that
+// should not be retried, the retries are exhausted, or the context is
+// done.
+func (info DownloadInfo) retry(ctx context.Context, attempt func() (bool, error)) error {
+	backoff := info.RetryBackoff
+	if backoff == 0 {
+		backoff = DefaultDownloadRetryBackoff
+	}
+
+	for retries := 0; ; retries++ {
+		retry, err := attempt()
+		if err == nil || !retry || retries >= info.MaxRetries {
+			return err
+		}
+		grip.Debug(message.WrapError(err, message.Fields{
+			"message": "retrying failed download",
+			"url":     info.URL,
+			"retry":   retries + 1,
+			"backoff": backoff.String(),
+		}))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(ctx.Err(), "download canceled after error: %s", err)
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// attempt makes a single attempt to download the file at the URL into
+// the partial file, resuming from the end of the partial file if it is
+// not empty, and reading no faster than the limiters allow. It reports
+// whether the download should be retried if it fails.
+func (info DownloadInfo) attempt(ctx context.Context, client *http.Client, url string, partial string, progress *downloadProgress, limiters []*bandwidthLimiter) (bool, error) {
+	var offset int64
+	if stat, err := os.Stat(partial); err == nil {
+		offset = stat.Size()
+	}
+
+	body, retry, err := openDownload(ctx, client, url, offset)
+	if err != nil {
+		return retry, err
+	}
+	defer body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	if body.offset > 0 {
+		flags |= os.O_APPEND
+	} else {
+		flags |= os.O_TRUNC
+	}
+
+	var verifier *checksumVerifier
+	if sums := info.checksums(); len(sums) > 0 {
+		verifier = newChecksumVerifier(sums)
+		if body.offset > 0 {
+			if err = hashFile(verifier, partial); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	file, err := os.OpenFile(partial, flags, 0644)
+	if err != nil {
+		return false, errors.Wrapf(err, "problem opening partial download %s", partial)
+	}
+
+	r := newThrottledReader(ctx, body, limiters...)
+	if verifier != nil {
+		r = io.TeeReader(r, verifier)
+	}
+	if progress != nil {
+		progress.reset(body.offset, body.total)
+		r = io.TeeReader(r, progress)
+	}
+	_, err = io.Copy(file, r)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		return false, errors.Wrapf(closeErr, "problem closing partial download %s", partial)
+	}
+	if err != nil {
+		return ctx.Err() == nil, errors.Wrapf(err, "problem downloading file for url %s", url)
+	}
+
+	if verifier != nil {
+		return false, verifier.verify()
+	}
+	return false, nil
+}
+
+// hashFile writes the contents of the file to the verifier.
+func hashFile(verifier *checksumVerifier, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "problem opening partial download %s", path)
+	}
+	defer file.Close()
+
+	_, err = io.Copy(verifier, file)
+	return errors.Wrapf(err, "problem reading partial download %s", path)
+}
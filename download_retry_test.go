@@ -0,0 +1,145 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyDownloadServer serves content, and fails or interrupts the first
+// requests that it receives.
+type flakyDownloadServer struct {
+	content     []byte
+	failures    int
+	interrupts  int
+	ignoreRange bool
+	hang        bool
+	requests    []*http.Request
+	mu          sync.Mutex
+}
+
+func (s *flakyDownloadServer) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r)
+	attempt := len(s.requests)
+	s.mu.Unlock()
+
+	switch {
+	case s.hang:
+		<-r.Context().Done()
+	case attempt <= s.failures:
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	case attempt <= s.failures+s.interrupts:
+		rw.Header().Set("Content-Length", strconv.Itoa(len(s.content)))
+		_, _ = rw.Write(s.content[:len(s.content)/2])
+		conn, _, err := rw.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	case s.ignoreRange:
+		_, _ = rw.Write(s.content)
+	default:
+		http.ServeContent(rw, r, "", time.Time{}, bytes.NewReader(s.content))
+	}
+}
+
+func TestDownloadRetries(t *testing.T) {
+	content := bytes.Repeat([]byte("foobar"), 1024)
+	sum := sha256.Sum256(content)
+	contentSHA256 := hex.EncodeToString(sum[:])
+
+	for name, test := range map[string]func(context.Context, *testing.T, *flakyDownloadServer, DownloadInfo){
+		"RetriesServerErrors": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.failures = 2
+			info.MaxRetries = 2
+			require.NoError(t, info.DownloadContext(ctx))
+			assert.Len(t, s.requests, 3)
+		},
+		"GivesUpAfterMaxRetries": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.failures = 3
+			info.MaxRetries = 1
+			assert.Error(t, info.DownloadContext(ctx))
+			assert.Len(t, s.requests, 2)
+		},
+		"DoesNotRetryByDefault": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.failures = 1
+			assert.Error(t, info.DownloadContext(ctx))
+			assert.Len(t, s.requests, 1)
+		},
+		"ResumesInterruptedDownloads": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.interrupts = 2
+			info.MaxRetries = 2
+			info.SHA256 = contentSHA256
+			require.NoError(t, info.DownloadContext(ctx))
+			require.Len(t, s.requests, 3)
+			assert.Empty(t, s.requests[0].Header.Get("Range"))
+			assert.Equal(t, "bytes="+strconv.Itoa(len(content)/2)+"-", s.requests[1].Header.Get("Range"))
+		},
+		"RestartsWithoutRangeSupport": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.interrupts = 1
+			s.ignoreRange = true
+			info.MaxRetries = 1
+			info.SHA256 = contentSHA256
+			require.NoError(t, info.DownloadContext(ctx))
+			assert.Len(t, s.requests, 2)
+		},
+		"CanceledByContext": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			info.MaxRetries = 5
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			assert.Error(t, info.DownloadContext(ctx))
+			assert.Len(t, s.requests, 1)
+		},
+		"CanceledByTimeout": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.hang = true
+			info.Timeout = 10 * time.Millisecond
+			assert.Error(t, info.DownloadContext(ctx))
+		},
+		"DownloadWithoutContextRetries": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {
+			s.failures = 1
+			info.MaxRetries = 1
+			require.NoError(t, info.Download())
+			assert.Len(t, s.requests, 2)
+		},
+		// "": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, info DownloadInfo) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+			defer cancel()
+
+			s := &flakyDownloadServer{content: content}
+			srv := httptest.NewServer(s)
+			defer srv.Close()
+
+			dir, err := ioutil.TempDir("build", "download")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			dir, err = filepath.Abs(dir)
+			require.NoError(t, err)
+
+			info := DownloadInfo{URL: srv.URL, Path: filepath.Join(dir, "foo"), RetryBackoff: time.Millisecond}
+			require.NoError(t, info.Validate())
+			test(ctx, t, s, info)
+
+			_, err = os.Stat(info.Path + partialDownloadSuffix)
+			assert.True(t, os.IsNotExist(err), "partial download should not be left behind")
+			if contents, err := ioutil.ReadFile(info.Path); err == nil {
+				assert.Equal(t, content, contents)
+			}
+		})
+	}
+}
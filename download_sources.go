@@ -0,0 +1,235 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// DownloadSources configures where files are downloaded from.
+//
+// Rewrites maps URL prefixes to the prefixes that replace them, and is
+// applied to the URL of each download before anything else; if more than
+// one prefix matches, the longest one is used. Mirrors is an ordered list
+// of base URLs that are tried, with the path of the download's URL
+// appended, before the URL itself. Each source is tried in turn until one
+// of them succeeds. Mirrors are not used for file:// URLs.
+type DownloadSources struct {
+	Rewrites map[string]string `json:"rewrites,omitempty"`
+	Mirrors  []string          `json:"mirrors,omitempty"`
+}
+
+// Validate checks that the rewrites and mirrors are URLs that can be
+// downloaded from.
+func (s DownloadSources) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	for prefix, replacement := range s.Rewrites {
+		if prefix == "" {
+			catcher.Add(errors.New("cannot rewrite an empty URL prefix"))
+		}
+		catcher.Add(errors.Wrapf(validateDownloadURL(replacement), "invalid rewrite of '%s'", prefix))
+	}
+	for _, mirror := range s.Mirrors {
+		catcher.Add(errors.Wrap(validateDownloadURL(mirror), "invalid mirror"))
+	}
+	return catcher.Resolve()
+}
+
+// IsZero returns whether no sources are configured.
+func (s DownloadSources) IsZero() bool {
+	return len(s.Rewrites) == 0 && len(s.Mirrors) == 0
+}
+
+// Merge returns the sources with the defaults added: rewrites of
+// prefixes that the sources do not rewrite, and mirrors that are tried
+// after the sources' mirrors.
+func (s DownloadSources) Merge(defaults DownloadSources) DownloadSources {
+	if defaults.IsZero() {
+		return s
+	}
+
+	merged := DownloadSources{}
+	if len(s.Rewrites) > 0 || len(defaults.Rewrites) > 0 {
+		merged.Rewrites = map[string]string{}
+		for prefix, replacement := range defaults.Rewrites {
+			merged.Rewrites[prefix] = replacement
+		}
+		for prefix, replacement := range s.Rewrites {
+			merged.Rewrites[prefix] = replacement
+		}
+	}
+	for _, mirror := range append(append([]string{}, s.Mirrors...), defaults.Mirrors...) {
+		if !sliceContains(merged.Mirrors, mirror) {
+			merged.Mirrors = append(merged.Mirrors, mirror)
+		}
+	}
+
+	return merged
+}
+
+// rewrite replaces the longest prefix of the URL that has a rewrite.
+func (s DownloadSources) rewrite(rawURL string) string {
+	longest := ""
+	for prefix := range s.Rewrites {
+		if strings.HasPrefix(rawURL, prefix) && len(prefix) > len(longest) {
+			longest = prefix
+		}
+	}
+	if longest == "" {
+		return rawURL
+	}
+	return s.Rewrites[longest] + strings.TrimPrefix(rawURL, longest)
+}
+
+// urls returns the URLs to try, in order, to download the URL.
+func (s DownloadSources) urls(rawURL string) []string {
+	rewritten := s.rewrite(rawURL)
+	u, err := url.Parse(rewritten)
+	if err != nil || u.Scheme == "file" {
+		return []string{rewritten}
+	}
+
+	urls := make([]string, 0, len(s.Mirrors)+1)
+	for _, mirror := range s.Mirrors {
+		mirrored := strings.TrimSuffix(mirror, "/") + u.EscapedPath()
+		if !sliceContains(urls, mirrored) {
+			urls = append(urls, mirrored)
+		}
+	}
+	if !sliceContains(urls, rewritten) {
+		urls = append(urls, rewritten)
+	}
+
+	return urls
+}
+
+// validateDownloadURL checks that the URL has a scheme that can be
+// downloaded from.
+func validateDownloadURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid URL '%s'", rawURL)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return nil
+	case "file":
+		_, err = localPath(u)
+		return err
+	default:
+		return errors.Errorf("unsupported scheme '%s' in URL '%s'", u.Scheme, rawURL)
+	}
+}
+
+// localPath returns the path on the local file system of a file:// URL.
+func localPath(u *url.URL) (string, error) {
+	if u.Host != "" && u.Host != "localhost" {
+		return "", errors.Errorf("cannot download from file URL '%s' on remote host '%s'", u, u.Host)
+	}
+	if u.Path == "" {
+		return "", errors.Errorf("file URL '%s' has no path", u)
+	}
+
+	path := u.Path
+	if runtime.GOOS == "windows" {
+		path = strings.TrimPrefix(path, "/")
+	}
+	return filepath.FromSlash(path), nil
+}
+
+// downloadBody is the content of a download.
+type downloadBody struct {
+	io.ReadCloser
+	// offset is the position in the file at which the content starts.
+	offset int64
+	// total is the size of the whole file, or -1 if it is unknown.
+	total int64
+}
+
+// openDownload opens the content at the URL, starting at offset if the
+// source supports it. It reports whether the download should be retried
+// if the content cannot be opened.
+func openDownload(ctx context.Context, client *http.Client, rawURL string, offset int64) (*downloadBody, bool, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "invalid URL '%s'", rawURL)
+	}
+	if u.Scheme == "file" {
+		return openFileDownload(u, offset)
+	}
+	return openHTTPDownload(ctx, client, rawURL, offset)
+}
+
+func openHTTPDownload(ctx context.Context, client *http.Client, rawURL string, offset int64) (*downloadBody, bool, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "problem building request")
+	}
+	req = req.WithContext(ctx)
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ctx.Err() == nil, errors.Wrapf(err, "problem downloading file for url %s", rawURL)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+	case resp.StatusCode == http.StatusOK:
+		// The server does not support range requests, so the download
+		// starts over.
+		offset = 0
+	default:
+		resp.Body.Close()
+		retry := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		return nil, retry, errors.Errorf("%s: could not download %s", resp.Status, rawURL)
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+	return &downloadBody{ReadCloser: resp.Body, offset: offset, total: total}, false, nil
+}
+
+func openFileDownload(u *url.URL, offset int64) (*downloadBody, bool, error) {
+	path, err := localPath(u)
+	if err != nil {
+		return nil, false, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "problem opening file for url %s", u)
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, false, errors.Wrapf(err, "problem finding size of file for url %s", u)
+	}
+	if !stat.Mode().IsRegular() {
+		file.Close()
+		return nil, false, errors.Errorf("url %s is not a regular file", u)
+	}
+
+	if offset > stat.Size() {
+		offset = 0
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, false, errors.Wrapf(err, "problem seeking in file for url %s", u)
+	}
+
+	return &downloadBody{ReadCloser: file, offset: offset, total: stat.Size()}, false, nil
+}
@@ -0,0 +1,168 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadSources(t *testing.T) {
+	for name, test := range map[string]func(*testing.T){
+		"RewritesLongestMatchingPrefix": func(t *testing.T) {
+			sources := DownloadSources{Rewrites: map[string]string{
+				"http://example.com/":     "http://short.example.com/",
+				"http://example.com/foo/": "file:///mirror/",
+			}}
+			assert.Equal(t, "file:///mirror/bar.tgz", sources.rewrite("http://example.com/foo/bar.tgz"))
+			assert.Equal(t, "http://short.example.com/bar.tgz", sources.rewrite("http://example.com/bar.tgz"))
+			assert.Equal(t, "http://other.com/bar.tgz", sources.rewrite("http://other.com/bar.tgz"))
+		},
+		"TriesMirrorsBeforeURL": func(t *testing.T) {
+			sources := DownloadSources{Mirrors: []string{"http://one.example.com/", "http://two.example.com/base"}}
+			assert.Equal(t, []string{
+				"http://one.example.com/foo/bar.tgz",
+				"http://two.example.com/base/foo/bar.tgz",
+				"http://example.com/foo/bar.tgz",
+			}, sources.urls("http://example.com/foo/bar.tgz"))
+		},
+		"DoesNotMirrorFileURLs": func(t *testing.T) {
+			sources := DownloadSources{
+				Rewrites: map[string]string{"http://example.com/": "file:///mirror/"},
+				Mirrors:  []string{"http://one.example.com"},
+			}
+			assert.Equal(t, []string{"file:///mirror/bar.tgz"}, sources.urls("http://example.com/bar.tgz"))
+		},
+		"MergePrefersOwnSources": func(t *testing.T) {
+			sources := DownloadSources{
+				Rewrites: map[string]string{"http://example.com/": "http://mine.example.com/"},
+				Mirrors:  []string{"http://two.example.com"},
+			}
+			defaults := DownloadSources{
+				Rewrites: map[string]string{
+					"http://example.com/": "http://default.example.com/",
+					"http://other.com/":   "http://default.example.com/",
+				},
+				Mirrors: []string{"http://one.example.com", "http://two.example.com"},
+			}
+			merged := sources.Merge(defaults)
+			assert.Equal(t, map[string]string{
+				"http://example.com/": "http://mine.example.com/",
+				"http://other.com/":   "http://default.example.com/",
+			}, merged.Rewrites)
+			assert.Equal(t, []string{"http://two.example.com", "http://one.example.com"}, merged.Mirrors)
+			assert.Equal(t, sources, sources.Merge(DownloadSources{}))
+		},
+		"ValidateRejectsUnsupportedSources": func(t *testing.T) {
+			assert.NoError(t, DownloadSources{}.Validate())
+			assert.NoError(t, DownloadSources{
+				Rewrites: map[string]string{"http://example.com/": "file:///mirror/"},
+				Mirrors:  []string{"https://mirror.example.com"},
+			}.Validate())
+			assert.Error(t, DownloadSources{Mirrors: []string{"ftp://mirror.example.com"}}.Validate())
+			assert.Error(t, DownloadSources{Mirrors: []string{"file://remote/mirror"}}.Validate())
+			assert.Error(t, DownloadSources{Rewrites: map[string]string{"": "http://example.com"}}.Validate())
+			assert.Error(t, DownloadSources{Rewrites: map[string]string{"http://example.com/": "s3://bucket/"}}.Validate())
+		},
+		// "": func(t *testing.T) {},
+	} {
+		t.Run(name, test)
+	}
+}
+
+func TestDownloadFromSources(t *testing.T) {
+	content := bytes.Repeat([]byte("foobar"), 1024)
+
+	for name, test := range map[string]func(context.Context, *testing.T, *flakyDownloadServer, string, DownloadInfo){
+		"DownloadsFileURLs": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {
+			src := filepath.Join(dir, "src")
+			require.NoError(t, ioutil.WriteFile(src, content, 0644))
+			info.URL = (&url.URL{Scheme: "file", Path: filepath.ToSlash(src)}).String()
+			require.NoError(t, info.Validate())
+			require.NoError(t, info.DownloadContext(ctx))
+			assert.Empty(t, s.requests)
+		},
+		"MissingFileURLsAreErrors": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {
+			info.URL = (&url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(dir, "missing"))}).String()
+			assert.Error(t, info.DownloadContext(ctx))
+		},
+		"RewritesToLocalDirectory": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "mirror", "foo"), 0755))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "mirror", "foo", "bar"), content, 0644))
+			info.Sources.Rewrites = map[string]string{
+				info.URL + "/": (&url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(dir, "mirror")) + "/"}).String(),
+			}
+			info.URL += "/foo/bar"
+			require.NoError(t, info.DownloadContext(ctx))
+			assert.Empty(t, s.requests)
+		},
+		"FallsBackToNextMirror": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {
+			missing := httptest.NewServer(http.NotFoundHandler())
+			defer missing.Close()
+
+			info.Sources.Mirrors = []string{missing.URL, info.URL}
+			info.URL = "http://example.invalid/foo"
+			require.NoError(t, info.DownloadContext(ctx))
+			require.Len(t, s.requests, 1)
+			assert.Equal(t, "/foo", s.requests[0].URL.Path)
+		},
+		"FallsBackToURL": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {
+			info.Sources.Mirrors = []string{"http://127.0.0.1:1"}
+			require.NoError(t, info.DownloadContext(ctx))
+			assert.Len(t, s.requests, 1)
+		},
+		"FailsWhenAllSourcesFail": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {
+			s.failures = 2
+			info.Sources.Mirrors = []string{info.URL + "/mirror"}
+			assert.Error(t, info.DownloadContext(ctx))
+			assert.Len(t, s.requests, 2)
+			_, err := os.Stat(info.Path)
+			assert.True(t, os.IsNotExist(err))
+		},
+		"ArtifactsFeedUsesSources": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "full.json"), []byte(`{"versions": []}`), 0644))
+			sources := DownloadSources{Rewrites: map[string]string{
+				artifactsFeedURL: (&url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(dir, "full.json"))}).String(),
+			}}
+			feedDir := filepath.Join(dir, "feed")
+			require.NoError(t, os.MkdirAll(feedDir, 0755))
+
+			feed, err := getArtifactsFeed(ctx, feedDir, sources)
+			require.NoError(t, err)
+			assert.NotNil(t, feed)
+			_, err = os.Stat(filepath.Join(feedDir, "full.json"))
+			assert.NoError(t, err)
+		},
+		// "": func(ctx context.Context, t *testing.T, s *flakyDownloadServer, dir string, info DownloadInfo) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+			defer cancel()
+
+			s := &flakyDownloadServer{content: content}
+			srv := httptest.NewServer(s)
+			defer srv.Close()
+
+			dir, err := ioutil.TempDir("build", "download_sources")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			dir, err = filepath.Abs(dir)
+			require.NoError(t, err)
+
+			info := DownloadInfo{URL: srv.URL, Path: filepath.Join(dir, "out")}
+			test(ctx, t, s, dir, info)
+
+			if contents, err := ioutil.ReadFile(info.Path); err == nil {
+				assert.Equal(t, content, contents)
+			}
+		})
+	}
+}
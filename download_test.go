@@ -1,18 +1,15 @@
 package jasper
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
-	"time"
 
-	"github.com/mholt/archiver"
-	"github.com/mongodb/amboy"
-	"github.com/mongodb/amboy/queue"
-	"github.com/mongodb/grip"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tychoish/bond"
@@ -84,48 +81,22 @@ func TestSetupDownloadMongoDBReleasesWithInvalidArtifactsFeed(t *testing.T) {
 	assert.Contains(t, err.Error(), "problem making artifacts feed")
 }
 
-func TestCreateValidDownloadJobs(t *testing.T) {
-	dir, err := ioutil.TempDir("build", "out")
-	require.NoError(t, err)
-	defer os.RemoveAll(dir)
-
-	urls := make(chan string)
-	go func() {
-		urls <- "https://example.com"
-		close(urls)
-	}()
-
-	catcher := grip.NewBasicCatcher()
-	jobs := createDownloadJobs(dir, urls, catcher)
-
-	count := 0
-	for job := range jobs {
-		count++
-		assert.Equal(t, 1, count)
-		assert.NotNil(t, job)
-	}
-
-	assert.NoError(t, catcher.Resolve())
-}
+func TestStartMongoDBDownloadJobsWithInvalidPath(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+	defer cancel()
 
-func TestCreateDownloadJobsWithInvalidPath(t *testing.T) {
-	dir := "download_test.go"
-	urls := make(chan string)
-	testURL := "https://example.com"
+	jobs, err := NewDownloadJobs(ctx, DefaultDownloadJobWorkers)
+	require.NoError(t, err)
+	defer jobs.Close()
 
-	catcher := grip.NewBasicCatcher()
-	go func() {
-		urls <- testURL
-		close(urls)
-	}()
-	jobs := createDownloadJobs(dir, urls, catcher)
+	opts := validMongoDBDownloadOptions()
+	opts.Path, err = filepath.Abs("download_test.go")
+	require.NoError(t, err)
 
-	for range jobs {
-		assert.Fail(t, "should not create job for bad url")
-	}
-	err := catcher.Resolve()
+	statuses, err := jobs.StartMongoDB(ctx, lru.NewCache(), opts)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "problem creating download job for "+testURL)
+	assert.Empty(t, statuses)
+	assert.Empty(t, jobs.List())
 }
 
 func TestProcessDownloadJobs(t *testing.T) {
@@ -144,29 +115,20 @@ func TestProcessDownloadJobs(t *testing.T) {
 
 	cache := lru.NewCache()
 
-	downloadOpts := validMongoDBDownloadOptions()
-	opts := downloadOpts.BuildOpts
-	releases := downloadOpts.Releases
-
-	feed, err := bond.GetArtifactsFeed(ctx, dir)
+	jobs, err := NewDownloadJobs(ctx, DefaultDownloadJobWorkers)
 	require.NoError(t, err)
+	defer jobs.Close()
 
-	catcher := grip.NewBasicCatcher()
-	urls, errs := feed.GetArchives(releases, opts)
-	jobs := createDownloadJobs(dir, urls, catcher)
-
-	q := queue.NewLocalUnordered(2)
-	require.NoError(t, q.Start(ctx))
-	require.NoError(t, amboy.PopulateQueue(ctx, q, jobs))
-	for err := range errs {
-		catcher.Add(err)
+	opts := validMongoDBDownloadOptions()
+	opts.Path = absDir
+	statuses, err := jobs.StartMongoDB(ctx, cache, opts)
+	require.NoError(t, err)
+	require.NotEmpty(t, statuses)
+	for _, status := range statuses {
+		status, err = jobs.Wait(ctx, status.ID)
+		require.NoError(t, err)
+		require.Equal(t, DownloadJobCompleted, status.State, status.Error)
 	}
-	assert.NoError(t, catcher.Resolve())
-
-	_ = amboy.WaitCtxInterval(ctx, q, 100*time.Millisecond)
-	require.NoError(t, amboy.ResolveErrors(ctx, q))
-
-	assert.NoError(t, processDownloadJobs(ctx, addMongoDBFilesToCache(cache, absDir))(q))
 
 	downloadedFiles := []string{}
 	filepath.Walk(absDir, func(path string, info os.FileInfo, err error) error {
@@ -201,47 +163,57 @@ func TestAddMongoDBFilesToCacheWithInvalidPath(t *testing.T) {
 }
 
 func TestDoExtract(t *testing.T) {
+	entries := []testArchiveEntry{{name: "out.txt", body: "out"}}
+	makeTarGz := func(t *testing.T) []byte {
+		buf := &bytes.Buffer{}
+		gzw := gzip.NewWriter(buf)
+		_, err := gzw.Write(makeTestTar(t, entries))
+		require.NoError(t, err)
+		require.NoError(t, gzw.Close())
+		return buf.Bytes()
+	}
+	makeZip := func(t *testing.T) []byte {
+		return makeTestZip(t, entries)
+	}
+
 	for testName, testCase := range map[string]struct {
-		archiveMaker  archiver.Archiver
+		archiveMaker  func(*testing.T) []byte
 		expectSuccess bool
 		fileExtension string
 		format        ArchiveFormat
 	}{
 		"Auto": {
-			archiveMaker:  archiver.TarGz,
+			archiveMaker:  makeTarGz,
 			expectSuccess: true,
 			fileExtension: ".tar.gz",
 			format:        ArchiveAuto,
 		},
 		"TarGz": {
-			archiveMaker:  archiver.TarGz,
+			archiveMaker:  makeTarGz,
 			expectSuccess: true,
 			fileExtension: ".tar.gz",
 			format:        ArchiveTarGz,
 		},
 		"Zip": {
-			archiveMaker:  archiver.Zip,
+			archiveMaker:  makeZip,
 			expectSuccess: true,
 			fileExtension: ".zip",
 			format:        ArchiveZip,
 		},
 		"InvalidArchiveFormat": {
-			archiveMaker:  archiver.TarGz,
+			archiveMaker:  makeTarGz,
 			expectSuccess: false,
 			fileExtension: ".foo",
 			format:        ArchiveFormat("foo"),
 		},
 		"MismatchedArchiveFileAndFormat": {
-			archiveMaker:  archiver.TarGz,
+			archiveMaker:  makeTarGz,
 			expectSuccess: false,
 			fileExtension: ".tar.gz",
 			format:        ArchiveZip,
 		},
 	} {
 		t.Run(testName, func(t *testing.T) {
-			file, err := ioutil.TempFile("build", "out.txt")
-			require.NoError(t, err)
-			defer os.Remove(file.Name())
 			archiveFile, err := ioutil.TempFile("build", "out"+testCase.fileExtension)
 			require.NoError(t, err)
 			defer os.Remove(archiveFile.Name())
@@ -249,7 +221,9 @@ func TestDoExtract(t *testing.T) {
 			require.NoError(t, err)
 			defer os.RemoveAll(extractDir)
 
-			require.NoError(t, testCase.archiveMaker.Make(archiveFile.Name(), []string{file.Name()}))
+			_, err = archiveFile.Write(testCase.archiveMaker(t))
+			require.NoError(t, err)
+			require.NoError(t, archiveFile.Close())
 
 			info := DownloadInfo{
 				Path: archiveFile.Name(),
@@ -260,10 +234,12 @@ func TestDoExtract(t *testing.T) {
 				},
 			}
 			if !testCase.expectSuccess {
-				assert.Error(t, doExtract(info))
+				_, err = doExtract(info)
+				assert.Error(t, err)
 				return
 			}
-			assert.NoError(t, doExtract(info))
+			_, err = doExtract(info)
+			assert.NoError(t, err)
 
 			fileInfo, err := os.Stat(archiveFile.Name())
 			require.NoError(t, err)
@@ -290,7 +266,7 @@ func TestDoExtractUnarchivedFile(t *testing.T) {
 			TargetPath:    "build",
 		},
 	}
-	err = doExtract(info)
+	_, err = doExtract(info)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "could not detect archive format")
 }
@@ -0,0 +1,119 @@
+package jasper
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// throttleChunkSize is the largest number of bytes that a throttled
+// reader reads at once, so that the reads are spread out evenly.
+const throttleChunkSize = 32 * 1024
+
+// bandwidthLimiter limits the number of bytes per second that are read
+// through it. Readers that share a limiter share its bandwidth, and the
+// rate can be changed while they use it. A nil limiter, or one with a
+// rate of zero, does not limit reads. It is safe for concurrent use.
+type bandwidthLimiter struct {
+	rate int64
+	next time.Time
+	mu   sync.Mutex
+}
+
+func newBandwidthLimiter(rate int64) *bandwidthLimiter {
+	return &bandwidthLimiter{rate: rate}
+}
+
+func (l *bandwidthLimiter) getRate() int64 {
+	if l == nil {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+func (l *bandwidthLimiter) setRate(rate int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = rate
+}
+
+// reserve accounts for n bytes that were read, and returns how long the
+// reader must wait before it reads again to stay within the rate.
+func (l *bandwidthLimiter) reserve(n int) time.Duration {
+	if l == nil {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(int64(n) * int64(time.Second) / l.rate))
+
+	return l.next.Sub(now)
+}
+
+// throttledReader reads from the underlying reader no faster than any of
+// its limiters allow.
+type throttledReader struct {
+	ctx      context.Context
+	r        io.Reader
+	limiters []*bandwidthLimiter
+}
+
+func newThrottledReader(ctx context.Context, r io.Reader, limiters ...*bandwidthLimiter) io.Reader {
+	return &throttledReader{ctx: ctx, r: r, limiters: limiters}
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	// Read at most about a second's worth of data at the slowest rate,
+	// so that slow rates do not wait for a long time after each read.
+	size := int64(throttleChunkSize)
+	for _, l := range t.limiters {
+		if rate := l.getRate(); rate > 0 && rate < size {
+			size = rate
+		}
+	}
+	if int64(len(p)) > size {
+		p = p[:size]
+	}
+
+	n, err := t.r.Read(p)
+	if n == 0 {
+		return n, err
+	}
+
+	var wait time.Duration
+	for _, l := range t.limiters {
+		if d := l.reserve(n); d > wait {
+			wait = d
+		}
+	}
+	if wait <= 0 {
+		return n, err
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-t.ctx.Done():
+		return n, errors.Wrap(t.ctx.Err(), "download canceled while throttled")
+	case <-timer.C:
+		return n, err
+	}
+}
@@ -0,0 +1,59 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandwidthLimiter(t *testing.T) {
+	for name, test := range map[string]func(context.Context, *testing.T){
+		"NilAndZeroLimitersDoNotWait": func(ctx context.Context, t *testing.T) {
+			var limiter *bandwidthLimiter
+			assert.Zero(t, limiter.reserve(1024*1024))
+			assert.Zero(t, limiter.getRate())
+			assert.Zero(t, newBandwidthLimiter(0).reserve(1024*1024))
+		},
+		"ReservationsAccumulate": func(ctx context.Context, t *testing.T) {
+			limiter := newBandwidthLimiter(1024)
+			assert.InDelta(t, float64(time.Second), float64(limiter.reserve(1024)), float64(50*time.Millisecond))
+			assert.InDelta(t, float64(2*time.Second), float64(limiter.reserve(1024)), float64(50*time.Millisecond))
+
+			limiter.setRate(0)
+			assert.Zero(t, limiter.reserve(1024))
+		},
+		"ReaderIsLimitedByAllLimiters": func(ctx context.Context, t *testing.T) {
+			content := bytes.Repeat([]byte("a"), 3*1024)
+			fast := newBandwidthLimiter(1024 * 1024)
+			slow := newBandwidthLimiter(2 * 1024)
+
+			start := time.Now()
+			out, err := ioutil.ReadAll(newThrottledReader(ctx, bytes.NewReader(content), fast, nil, slow))
+			require.NoError(t, err)
+			assert.Equal(t, content, out)
+			assert.True(t, time.Since(start) >= time.Second)
+		},
+		"ReaderStopsWhenContextIsDone": func(ctx context.Context, t *testing.T) {
+			ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			_, err := ioutil.ReadAll(newThrottledReader(ctx, bytes.NewReader(make([]byte, 1024)), newBandwidthLimiter(1)))
+			assert.Error(t, err)
+			assert.True(t, time.Since(start) < time.Second)
+		},
+		// "": func(ctx context.Context, t *testing.T) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			test(ctx, t)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package jasper
+
+import (
+	"archive/tar"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// DefaultFileTransferMaxSize is the largest size, in bytes, of a file or
+// directory that a service accepts or sends if FileTransferOptions does
+// not specify a limit.
+const DefaultFileTransferMaxSize = 1024 * 1024 * 1024
+
+// FileTransferOptions configures the paths on a host that remote clients
+// can upload files to and fetch files from. A path can be transferred only
+// if it is inside one of the AllowedPaths after following symlinks, so
+// transfers are disabled if there are none. MaxSize limits the size of a
+// file, or the total size of the files in a directory, and defaults to
+// DefaultFileTransferMaxSize.
+type FileTransferOptions struct {
+	AllowedPaths []string `json:"allowed_paths"`
+	MaxSize      int64    `json:"max_size"`
+}
+
+// Validate ensures that the allowed paths are absolute and that the
+// maximum size is not negative.
+func (opts FileTransferOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	for _, path := range opts.AllowedPaths {
+		if !filepath.IsAbs(path) {
+			catcher.Add(errors.Errorf("allowed path '%s' must be absolute", path))
+		}
+	}
+	if opts.MaxSize < 0 {
+		catcher.Add(errors.New("cannot have a negative max size"))
+	}
+	return catcher.Resolve()
+}
+
+func (opts FileTransferOptions) maxSize() int64 {
+	if opts.MaxSize == 0 {
+		return DefaultFileTransferMaxSize
+	}
+	return opts.MaxSize
+}
+
+// resolve returns the path after following symlinks, or an error if it is
+// not inside one of the allowed paths.
+func (opts FileTransferOptions) resolve(path string) (string, error) {
+	if len(opts.AllowedPaths) == 0 {
+		return "", errors.New("file transfers are not enabled")
+	}
+	if !filepath.IsAbs(path) {
+		return "", errors.Errorf("path '%s' must be absolute", path)
+	}
+
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "problem resolving path '%s'", path)
+	}
+	for _, allowed := range opts.AllowedPaths {
+		root, err := resolvePath(allowed)
+		if err != nil {
+			return "", errors.Wrapf(err, "problem resolving allowed path '%s'", allowed)
+		}
+		if pathContains(root, resolved) {
+			return resolved, nil
+		}
+	}
+
+	return "", errors.Errorf("path '%s' is not inside of an allowed path", path)
+}
+
+// Upload writes the content read from the reader to the path, which must
+// be inside of one of the allowed paths. If archive is true, the content
+// is a tar archive that is extracted into the path instead.
+func (opts FileTransferOptions) Upload(r io.Reader, path string, archive bool) error {
+	resolved, err := opts.resolve(path)
+	if err != nil {
+		return err
+	}
+
+	return ReceiveFileTransfer(r, resolved, archive, opts.maxSize())
+}
+
+// Fetch opens the path, which must be inside of one of the allowed paths,
+// to be transferred. It reports whether the content is a tar archive, as
+// it is for directories.
+func (opts FileTransferOptions) Fetch(path string) (io.ReadCloser, bool, error) {
+	resolved, err := opts.resolve(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return OpenFileTransfer(resolved, opts.maxSize())
+}
+
+// OpenFileTransfer opens the file at the path to be transferred to another
+// host. If the path is a directory, the content is a tar archive of the
+// directory, and the returned bool is true. The file, or the total size of
+// the files in the directory, cannot be larger than maxSize bytes, unless
+// maxSize is zero.
+func OpenFileTransfer(path string, maxSize int64) (io.ReadCloser, bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "problem finding '%s'", path)
+	}
+
+	switch {
+	case info.Mode().IsRegular():
+		if maxSize > 0 && info.Size() > maxSize {
+			return nil, false, errors.Errorf("'%s' is larger than the maximum of %d bytes", path, maxSize)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "problem opening '%s'", path)
+		}
+		return file, false, nil
+	case info.IsDir():
+		if maxSize > 0 {
+			size, err := directorySize(path)
+			if err != nil {
+				return nil, false, errors.Wrapf(err, "problem finding size of '%s'", path)
+			}
+			if size > maxSize {
+				return nil, false, errors.Errorf("'%s' is larger than the maximum of %d bytes", path, maxSize)
+			}
+		}
+		r, w := io.Pipe()
+		go func() {
+			w.CloseWithError(writeTarArchive(w, path, maxSize))
+		}()
+		return r, true, nil
+	default:
+		return nil, false, errors.Errorf("'%s' is not a regular file or a directory", path)
+	}
+}
+
+// ReceiveFileTransfer writes the content read from the reader, which was
+// opened with OpenFileTransfer on another host, to the path. If archive is
+// true, the content is a tar archive that is extracted into the path. The
+// file, or the total size of the extracted files, cannot be larger than
+// maxSize bytes; if maxSize is zero, files are not limited and archives are
+// limited to DefaultArchiveMaxSize.
+func ReceiveFileTransfer(r io.Reader, path string, archive bool, maxSize int64) error {
+	if archive {
+		if err := makeEnclosingDirectories(path); err != nil {
+			return errors.Wrap(err, "problem making enclosing directories")
+		}
+
+		e := newExtractor(ArchiveOptions{TargetPath: path, MaxSize: maxSize})
+		err := e.extractTar(r)
+		if err == nil {
+			err = e.finish()
+		}
+		return errors.Wrapf(err, "problem extracting archive to '%s'", path)
+	}
+
+	if err := makeEnclosingDirectories(filepath.Dir(path)); err != nil {
+		return errors.Wrap(err, "problem making enclosing directories")
+	}
+
+	// The file is written next to the path and then moved into place, so
+	// that a failed transfer does not leave a partial file behind.
+	file, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return errors.Wrapf(err, "problem creating file for '%s'", path)
+	}
+	defer func() {
+		if err != nil {
+			grip.Warning(os.Remove(file.Name()))
+		}
+	}()
+
+	if maxSize > 0 {
+		r = io.LimitReader(r, maxSize+1)
+	}
+	n, err := io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrapf(err, "problem writing '%s'", path)
+	}
+	if maxSize > 0 && n > maxSize {
+		err = errors.Errorf("'%s' is larger than the maximum of %d bytes", path, maxSize)
+		return err
+	}
+	if err = os.Chmod(file.Name(), 0644); err != nil {
+		return errors.Wrapf(err, "problem setting permissions of '%s'", path)
+	}
+	if err = os.Rename(file.Name(), path); err != nil {
+		return errors.Wrapf(err, "problem moving file to '%s'", path)
+	}
+
+	return nil
+}
+
+// directorySize returns the total size of the regular files in the
+// directory.
+func directorySize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, errors.WithStack(err)
+}
+
+// writeTarArchive writes a tar archive of the contents of the directory.
+func writeTarArchive(w io.Writer, dir string, maxSize int64) error {
+	tw := tar.NewWriter(w)
+
+	var size int64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		var linkname string
+		switch {
+		case info.Mode().IsRegular():
+			size += info.Size()
+			if maxSize > 0 && size > maxSize {
+				return errors.Errorf("'%s' is larger than the maximum of %d bytes", dir, maxSize)
+			}
+		case info.Mode()&os.ModeSymlink != 0:
+			if linkname, err = os.Readlink(path); err != nil {
+				return errors.WithStack(err)
+			}
+		case info.IsDir():
+		default:
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, linkname)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		header.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err = tw.WriteHeader(header); err != nil {
+			return errors.Wrapf(err, "problem writing archive entry '%s'", header.Name)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer file.Close()
+		_, err = io.CopyN(tw, file, info.Size())
+		return errors.Wrapf(err, "problem writing archive entry '%s'", header.Name)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "problem archiving '%s'", dir)
+	}
+
+	return errors.Wrap(tw.Close(), "problem writing archive")
+}
@@ -0,0 +1,132 @@
+package jasper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTransfers(t *testing.T) {
+	for name, test := range map[string]func(*testing.T, string, FileTransferOptions){
+		"UploadsFiles": func(t *testing.T, dir string, opts FileTransferOptions) {
+			path := filepath.Join(opts.AllowedPaths[0], "sub", "foo")
+			require.NoError(t, opts.Upload(bytes.NewBufferString("foobar"), path, false))
+
+			contents, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, "foobar", string(contents))
+		},
+		"TransfersDirectoriesAsArchives": func(t *testing.T, dir string, opts FileTransferOptions) {
+			src := filepath.Join(opts.AllowedPaths[0], "src")
+			require.NoError(t, os.MkdirAll(filepath.Join(src, "bin"), 0755))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(src, "README.txt"), []byte("readme"), 0644))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(src, "bin", "tool"), []byte("tool"), 0755))
+
+			content, archive, err := opts.Fetch(src)
+			require.NoError(t, err)
+			defer content.Close()
+			assert.True(t, archive)
+
+			dst := filepath.Join(opts.AllowedPaths[0], "dst")
+			require.NoError(t, opts.Upload(content, dst, archive))
+			assertArchiveFiles(t, dst, "README.txt", "bin/tool")
+			contents, err := ioutil.ReadFile(filepath.Join(dst, "bin", "tool"))
+			require.NoError(t, err)
+			assert.Equal(t, "tool", string(contents))
+		},
+		"FetchesFiles": func(t *testing.T, dir string, opts FileTransferOptions) {
+			path := filepath.Join(opts.AllowedPaths[0], "foo")
+			require.NoError(t, ioutil.WriteFile(path, []byte("foobar"), 0644))
+
+			content, archive, err := opts.Fetch(path)
+			require.NoError(t, err)
+			defer content.Close()
+			assert.False(t, archive)
+			contents, err := ioutil.ReadAll(content)
+			require.NoError(t, err)
+			assert.Equal(t, "foobar", string(contents))
+		},
+		"RejectsPathsOutsideOfAllowedPaths": func(t *testing.T, dir string, opts FileTransferOptions) {
+			outside := filepath.Join(dir, "outside")
+			require.NoError(t, ioutil.WriteFile(outside, []byte("secret"), 0644))
+
+			for _, path := range []string{
+				outside,
+				filepath.Join(opts.AllowedPaths[0], "..", "outside"),
+				"outside",
+			} {
+				_, _, err := opts.Fetch(path)
+				assert.Error(t, err, path)
+				assert.Error(t, opts.Upload(bytes.NewBufferString("foobar"), path, false), path)
+			}
+
+			contents, err := ioutil.ReadFile(outside)
+			require.NoError(t, err)
+			assert.Equal(t, "secret", string(contents))
+		},
+		"RejectsSymlinksOutOfAllowedPaths": func(t *testing.T, dir string, opts FileTransferOptions) {
+			if runtime.GOOS == "windows" {
+				t.Skip("symlinks require elevated privileges on windows")
+			}
+			link := filepath.Join(opts.AllowedPaths[0], "link")
+			require.NoError(t, os.Symlink(dir, link))
+
+			_, _, err := opts.Fetch(link)
+			assert.Error(t, err)
+			assert.Error(t, opts.Upload(bytes.NewBufferString("foobar"), filepath.Join(link, "foo"), false))
+			_, err = os.Stat(filepath.Join(dir, "foo"))
+			assert.True(t, os.IsNotExist(err))
+		},
+		"EnforcesMaxSize": func(t *testing.T, dir string, opts FileTransferOptions) {
+			opts.MaxSize = 4
+			path := filepath.Join(opts.AllowedPaths[0], "foo")
+			assert.Error(t, opts.Upload(bytes.NewBufferString("foobar"), path, false))
+			_, err := os.Stat(path)
+			assert.True(t, os.IsNotExist(err))
+			files, err := ioutil.ReadDir(opts.AllowedPaths[0])
+			require.NoError(t, err)
+			assert.Empty(t, files, "failed uploads should not leave files behind")
+
+			require.NoError(t, ioutil.WriteFile(path, []byte("foobar"), 0644))
+			_, _, err = opts.Fetch(path)
+			assert.Error(t, err)
+			_, _, err = opts.Fetch(opts.AllowedPaths[0])
+			assert.Error(t, err)
+		},
+		"DisabledWithoutAllowedPaths": func(t *testing.T, dir string, opts FileTransferOptions) {
+			path := filepath.Join(opts.AllowedPaths[0], "foo")
+			require.NoError(t, ioutil.WriteFile(path, []byte("foobar"), 0644))
+
+			opts = FileTransferOptions{}
+			_, _, err := opts.Fetch(path)
+			assert.Error(t, err)
+			assert.Error(t, opts.Upload(bytes.NewBufferString("foobar"), path, false))
+		},
+		"ValidateRejectsInvalidOptions": func(t *testing.T, dir string, opts FileTransferOptions) {
+			assert.NoError(t, opts.Validate())
+			assert.NoError(t, FileTransferOptions{}.Validate())
+			assert.Error(t, FileTransferOptions{AllowedPaths: []string{"relative"}}.Validate())
+			assert.Error(t, FileTransferOptions{MaxSize: -1}.Validate())
+		},
+		// "": func(t *testing.T, dir string, opts FileTransferOptions) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("build", "file_transfer")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			dir, err = filepath.Abs(dir)
+			require.NoError(t, err)
+
+			allowed := filepath.Join(dir, "allowed")
+			require.NoError(t, os.Mkdir(allowed, 0755))
+
+			test(t, dir, FileTransferOptions{AllowedPaths: []string{allowed}})
+		})
+	}
+}
@@ -6,8 +6,6 @@ import (
 	"io/ioutil"
 	"os"
 	"time"
-
-	"github.com/mongodb/grip/send"
 )
 
 const (
@@ -59,7 +57,7 @@ func inMemoryLoggerCase(ctx context.Context, c *caseDefinition) result {
 	size := make(chan int64)
 	opts := makeCreateOpts(c.timeout, Logger{Type: logType, Options: logOptions})
 	opts.closers = append(opts.closers, func() (_ error) {
-		logger := opts.Output.outputSender.Sender.(*send.InMemorySender)
+		logger := opts.Output.Loggers[0].sender.(inMemorySender)
 		size <- logger.TotalBytesSent()
 		return
 	})
@@ -2,7 +2,10 @@ package jasper
 
 import (
 	"context"
+	"io"
 	"syscall"
+
+	"github.com/mongodb/grip/message"
 )
 
 // TODO
@@ -30,6 +33,97 @@ type Manager interface {
 	Close(context.Context) error
 }
 
+// RemoteClient provides an interface to access all functionality of
+// a remote jasper service, regardless of the transport (REST or
+// GRPC). In addition to the Manager methods, the service exposes
+// operations on the host and on its processes that are not part of
+// the Manager interface.
+type RemoteClient interface {
+	Manager
+
+	// DownloadFile downloads the file described by the
+	// DownloadInfo onto the remote host.
+	DownloadFile(context.Context, DownloadInfo) error
+	// DownloadMongoDB downloads the desired MongoDB releases onto
+	// the remote host.
+	DownloadMongoDB(context.Context, MongoDBDownloadOptions) error
+	// ResolveMongoDB resolves the version specs of the releases to
+	// builds of MongoDB, and reports where each build is extracted
+	// on the remote host and whether it is already there, without
+	// downloading anything.
+	ResolveMongoDB(context.Context, MongoDBDownloadOptions) ([]MongoDBRelease, error)
+	// StartDownload starts downloading the file described by the
+	// DownloadInfo onto the remote host in the background, and
+	// returns the status of the new download job.
+	StartDownload(context.Context, DownloadInfo) (DownloadJobStatus, error)
+	// ListDownloads returns the statuses of all download jobs on
+	// the remote host, including those started by DownloadMongoDB.
+	ListDownloads(context.Context) ([]DownloadJobStatus, error)
+	// GetDownload returns the status of the download job with the
+	// given ID.
+	GetDownload(context.Context, string) (DownloadJobStatus, error)
+	// CancelDownload cancels the download job with the given ID.
+	CancelDownload(context.Context, string) error
+	// WaitDownload blocks until the download job with the given ID
+	// finishes, and returns its status.
+	WaitDownload(context.Context, string) (DownloadJobStatus, error)
+	// ConfigureCache changes the configuration of the remote
+	// host's download cache.
+	ConfigureCache(context.Context, CacheOptions) error
+	// ListCachedDownloads returns the entries of the remote
+	// host's download cache.
+	ListCachedDownloads(context.Context) ([]DownloadCacheEntry, error)
+	// GetCachedDownload returns the download cache entry with the
+	// given digest.
+	GetCachedDownload(context.Context, string) (DownloadCacheEntry, error)
+	// PinCachedDownload sets whether the download cache entry with
+	// the given digest is pinned, which keeps it from being
+	// evicted.
+	PinCachedDownload(context.Context, string, bool) error
+	// EvictCachedDownload removes the download cache entry with the
+	// given digest.
+	EvictCachedDownload(context.Context, string) error
+	// ConfigureDownloadSources sets the URL rewrites and mirrors
+	// that the remote host uses for downloads, in addition to the
+	// sources of each download.
+	ConfigureDownloadSources(context.Context, DownloadSources) error
+	// ConfigureDownloads changes the number of download jobs that
+	// the remote host runs at once and the total bandwidth limit of
+	// its downloads.
+	ConfigureDownloads(context.Context, DownloadOptions) error
+	// GetDownloadStatus returns the download configuration of the
+	// remote host, the number of its download jobs that are pending
+	// and running, and the size of its download cache.
+	GetDownloadStatus(context.Context) (DownloadStatus, error)
+	// UploadFile copies the local file or directory at the first
+	// path to the second path on the remote host, which must be
+	// allowed by the service's FileTransferOptions.
+	UploadFile(context.Context, string, string) error
+	// FetchFile copies the file or directory at the first path on
+	// the remote host, which must be allowed by the service's
+	// FileTransferOptions, to the second, local, path.
+	FetchFile(context.Context, string, string) error
+
+	// GetBuildloggerURLs returns the buildlogger URLs of the
+	// process with the given ID.
+	GetBuildloggerURLs(context.Context, string) ([]string, error)
+	// GetLogs returns the lines logged by the in-memory logger of
+	// the process with the given ID.
+	GetLogs(context.Context, string) ([]string, error)
+	// QueryLogs returns the lines logged by all of the in-memory
+	// loggers of the process with the given ID that match the
+	// query.
+	QueryLogs(context.Context, string, LogQuery) ([]LogRecord, error)
+	// GetArtifact returns the contents of the artifact with the
+	// given name (e.g. ArtifactOutput) of the process with the
+	// given ID, from the service's process artifact directory. The
+	// caller must close the returned reader.
+	GetArtifact(context.Context, string, string) (io.ReadCloser, error)
+	// GetProcessMetrics returns system metrics for the process
+	// with the given ID and all of its children.
+	GetProcessMetrics(context.Context, string) ([]message.ProcessInfo, error)
+}
+
 // Process objects reflect ways of starting and managing
 // processes. Process generally reflect only the primary process at
 // the top of a tree and "child" processes are not directly
@@ -104,4 +198,10 @@ type ProcessInfo struct {
 	Complete   bool
 	Timeout    bool
 	Options    CreateOptions
+
+	// OutputTruncated and ErrorTruncated report whether the output
+	// or error of the process exceeded the limit in the output
+	// options' Limits.
+	OutputTruncated bool
+	ErrorTruncated  bool
 }
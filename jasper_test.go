@@ -3,8 +3,11 @@ package jasper
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"syscall"
+	"testing"
 	"time"
 
 	"github.com/mongodb/grip"
@@ -13,6 +16,25 @@ import (
 
 var intSource <-chan int
 
+// TestMain stores the default download cache in a temporary directory, so
+// that the services in the tests do not serve downloads that were cached
+// by earlier test runs.
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "jasper-download-cache")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err = SetDefaultDownloadCacheDirectory(dir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
 func init() {
 	intSource = func() <-chan int {
 		out := make(chan int, 25)
@@ -94,6 +116,7 @@ outerRetry:
 			}
 			go func() {
 				app.Run(ctx)
+				srv.Close()
 			}()
 
 			timer := time.NewTimer(5 * time.Millisecond)
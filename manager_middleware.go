@@ -0,0 +1,450 @@
+package jasper
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/logging"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// ManagerMiddleware wraps a Manager and returns a Manager that adds some
+// cross-cutting behavior (e.g. locking or logging) around the wrapped
+// Manager's operations. Middleware can wrap any Manager implementation,
+// including remote managers.
+type ManagerMiddleware func(Manager) Manager
+
+// WrapManager applies the given middleware to the Manager. The first
+// middleware is the outermost layer, so it observes each operation before
+// (and its result after) all of the middleware that follows it.
+func WrapManager(m Manager, middleware ...ManagerMiddleware) Manager {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		m = middleware[i](m)
+	}
+
+	return m
+}
+
+// LockingMiddleware serializes access to the wrapped Manager. Operations
+// that modify the manager take an exclusive lock, while introspection
+// operations (List, Group, Get) share a read lock.
+func LockingMiddleware() ManagerMiddleware {
+	return func(m Manager) Manager {
+		return &lockingManager{Manager: m}
+	}
+}
+
+type lockingManager struct {
+	Manager
+	mu sync.RWMutex
+}
+
+func (m *lockingManager) Create(ctx context.Context, opts *CreateOptions) (Process, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	proc, err := m.Manager.Create(ctx, opts)
+	return proc, errors.WithStack(err)
+}
+
+func (m *lockingManager) Register(ctx context.Context, proc Process) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return errors.WithStack(m.Manager.Register(ctx, proc))
+}
+
+func (m *lockingManager) List(ctx context.Context, f Filter) ([]Process, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	procs, err := m.Manager.List(ctx, f)
+	return procs, errors.WithStack(err)
+}
+
+func (m *lockingManager) Group(ctx context.Context, name string) ([]Process, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	procs, err := m.Manager.Group(ctx, name)
+	return procs, errors.WithStack(err)
+}
+
+func (m *lockingManager) Get(ctx context.Context, id string) (Process, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	proc, err := m.Manager.Get(ctx, id)
+	return proc, errors.WithStack(err)
+}
+
+func (m *lockingManager) Clear(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Manager.Clear(ctx)
+}
+
+func (m *lockingManager) Close(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return errors.WithStack(m.Manager.Close(ctx))
+}
+
+// CapacityMiddleware limits the number of processes that the wrapped Manager
+// may track at once. When the limit is reached, the middleware clears
+// completed processes from the manager before rejecting new processes, in
+// the same way as the self-clearing manager.
+func CapacityMiddleware(maxProcs int) ManagerMiddleware {
+	return func(m Manager) Manager {
+		return &capacityManager{Manager: m, maxProcs: maxProcs}
+	}
+}
+
+type capacityManager struct {
+	Manager
+	maxProcs int
+	mu       sync.Mutex
+}
+
+func (m *capacityManager) numProcs(ctx context.Context) int {
+	// managers return an error rather than an empty list when
+	// they have no processes.
+	procs, _ := m.Manager.List(ctx, All)
+	return len(procs)
+}
+
+func (m *capacityManager) checkProcCapacity(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return errors.New("context canceled")
+	}
+
+	if m.numProcs(ctx) >= m.maxProcs {
+		// We are at capacity, we can try to perform a clear.
+		m.Manager.Clear(ctx)
+		if m.numProcs(ctx) >= m.maxProcs {
+			return errors.New("cannot create any more processes")
+		}
+	}
+
+	return nil
+}
+
+func (m *capacityManager) Create(ctx context.Context, opts *CreateOptions) (Process, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkProcCapacity(ctx); err != nil {
+		return nil, err
+	}
+
+	proc, err := m.Manager.Create(ctx, opts)
+	return proc, errors.WithStack(err)
+}
+
+func (m *capacityManager) Register(ctx context.Context, proc Process) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkProcCapacity(ctx); err != nil {
+		return err
+	}
+
+	return errors.WithStack(m.Manager.Register(ctx, proc))
+}
+
+// AuditMiddleware logs every operation on the wrapped Manager, along with
+// its outcome, to the given logger. If the logger is nil, the middleware
+// uses the global grip logger.
+func AuditMiddleware(logger grip.Journaler) ManagerMiddleware {
+	if logger == nil {
+		logger = logging.MakeGrip(grip.GetSender())
+	}
+
+	return func(m Manager) Manager {
+		return &auditManager{Manager: m, logger: logger}
+	}
+}
+
+type auditManager struct {
+	Manager
+	logger grip.Journaler
+}
+
+func (m *auditManager) audit(op string, start time.Time, err error, fields message.Fields) {
+	fields["op"] = op
+	fields["duration_secs"] = time.Since(start).Seconds()
+
+	if err != nil {
+		m.logger.Warning(message.WrapError(err, fields))
+		return
+	}
+
+	m.logger.Info(fields)
+}
+
+func (m *auditManager) Create(ctx context.Context, opts *CreateOptions) (Process, error) {
+	start := time.Now()
+	if opts == nil {
+		err := errors.New("cannot create a process without options")
+		m.audit("create", start, err, message.Fields{})
+		return nil, err
+	}
+	proc, err := m.Manager.Create(ctx, opts)
+
+	fields := message.Fields{"args": opts.Args}
+	if proc != nil {
+		fields["id"] = proc.ID()
+	}
+	m.audit("create", start, err, fields)
+
+	return proc, errors.WithStack(err)
+}
+
+func (m *auditManager) Register(ctx context.Context, proc Process) error {
+	start := time.Now()
+	err := m.Manager.Register(ctx, proc)
+
+	fields := message.Fields{}
+	if proc != nil {
+		fields["id"] = proc.ID()
+	}
+	m.audit("register", start, err, fields)
+
+	return errors.WithStack(err)
+}
+
+func (m *auditManager) List(ctx context.Context, f Filter) ([]Process, error) {
+	start := time.Now()
+	procs, err := m.Manager.List(ctx, f)
+	m.audit("list", start, err, message.Fields{"filter": f, "num_procs": len(procs)})
+
+	return procs, errors.WithStack(err)
+}
+
+func (m *auditManager) Group(ctx context.Context, name string) ([]Process, error) {
+	start := time.Now()
+	procs, err := m.Manager.Group(ctx, name)
+	m.audit("group", start, err, message.Fields{"group": name, "num_procs": len(procs)})
+
+	return procs, errors.WithStack(err)
+}
+
+func (m *auditManager) Get(ctx context.Context, id string) (Process, error) {
+	start := time.Now()
+	proc, err := m.Manager.Get(ctx, id)
+	m.audit("get", start, err, message.Fields{"id": id})
+
+	return proc, errors.WithStack(err)
+}
+
+func (m *auditManager) Clear(ctx context.Context) {
+	start := time.Now()
+	m.Manager.Clear(ctx)
+	m.audit("clear", start, nil, message.Fields{})
+}
+
+func (m *auditManager) Close(ctx context.Context) error {
+	start := time.Now()
+	err := m.Manager.Close(ctx)
+	m.audit("close", start, err, message.Fields{})
+
+	return errors.WithStack(err)
+}
+
+// ManagerOperationStats reports the number of calls to a single Manager
+// operation, the number of those calls that failed, and the total time
+// spent in the operation.
+type ManagerOperationStats struct {
+	Calls    int64         `json:"calls"`
+	Failures int64         `json:"failures"`
+	Duration time.Duration `json:"duration"`
+}
+
+// ManagerMetrics collects per-operation statistics from every Manager that
+// it is attached to with MetricsMiddleware. It is safe for concurrent use.
+type ManagerMetrics struct {
+	mu  sync.Mutex
+	ops map[string]ManagerOperationStats
+}
+
+// NewManagerMetrics constructs an empty ManagerMetrics.
+func NewManagerMetrics() *ManagerMetrics {
+	return &ManagerMetrics{ops: map[string]ManagerOperationStats{}}
+}
+
+func (m *ManagerMetrics) record(op string, start time.Time, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := m.ops[op]
+	stats.Calls++
+	if err != nil {
+		stats.Failures++
+	}
+	stats.Duration += time.Since(start)
+	m.ops[op] = stats
+}
+
+// Stats returns a copy of the statistics collected so far, keyed by the
+// name of the operation (e.g. "create", "list").
+func (m *ManagerMetrics) Stats() map[string]ManagerOperationStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string]ManagerOperationStats, len(m.ops))
+	for op, stats := range m.ops {
+		out[op] = stats
+	}
+
+	return out
+}
+
+// MetricsMiddleware records the number of calls, failures, and time spent
+// in each operation on the wrapped Manager in the given ManagerMetrics.
+func MetricsMiddleware(metrics *ManagerMetrics) ManagerMiddleware {
+	return func(m Manager) Manager {
+		return &metricsManager{Manager: m, metrics: metrics}
+	}
+}
+
+type metricsManager struct {
+	Manager
+	metrics *ManagerMetrics
+}
+
+func (m *metricsManager) Create(ctx context.Context, opts *CreateOptions) (Process, error) {
+	start := time.Now()
+	proc, err := m.Manager.Create(ctx, opts)
+	m.metrics.record("create", start, err)
+
+	return proc, errors.WithStack(err)
+}
+
+func (m *metricsManager) Register(ctx context.Context, proc Process) error {
+	start := time.Now()
+	err := m.Manager.Register(ctx, proc)
+	m.metrics.record("register", start, err)
+
+	return errors.WithStack(err)
+}
+
+func (m *metricsManager) List(ctx context.Context, f Filter) ([]Process, error) {
+	start := time.Now()
+	procs, err := m.Manager.List(ctx, f)
+	m.metrics.record("list", start, err)
+
+	return procs, errors.WithStack(err)
+}
+
+func (m *metricsManager) Group(ctx context.Context, name string) ([]Process, error) {
+	start := time.Now()
+	procs, err := m.Manager.Group(ctx, name)
+	m.metrics.record("group", start, err)
+
+	return procs, errors.WithStack(err)
+}
+
+func (m *metricsManager) Get(ctx context.Context, id string) (Process, error) {
+	start := time.Now()
+	proc, err := m.Manager.Get(ctx, id)
+	m.metrics.record("get", start, err)
+
+	return proc, errors.WithStack(err)
+}
+
+func (m *metricsManager) Clear(ctx context.Context) {
+	start := time.Now()
+	m.Manager.Clear(ctx)
+	m.metrics.record("clear", start, nil)
+}
+
+func (m *metricsManager) Close(ctx context.Context) error {
+	start := time.Now()
+	err := m.Manager.Close(ctx)
+	m.metrics.record("close", start, err)
+
+	return errors.WithStack(err)
+}
+
+// DefaultTagsMiddleware adds the given tags to every process created by or
+// registered with the wrapped Manager.
+func DefaultTagsMiddleware(tags ...string) ManagerMiddleware {
+	return func(m Manager) Manager {
+		return &defaultTagsManager{Manager: m, tags: tags}
+	}
+}
+
+type defaultTagsManager struct {
+	Manager
+	tags []string
+}
+
+func (m *defaultTagsManager) Create(ctx context.Context, opts *CreateOptions) (Process, error) {
+	if opts != nil {
+		// Add the tags to a copy, so that the caller's options are not
+		// modified.
+		tagged := *opts
+		tagged.Tags = append([]string{}, opts.Tags...)
+		for _, t := range m.tags {
+			if !sliceContains(tagged.Tags, t) {
+				tagged.Tags = append(tagged.Tags, t)
+			}
+		}
+		opts = &tagged
+	}
+
+	proc, err := m.Manager.Create(ctx, opts)
+	return proc, errors.WithStack(err)
+}
+
+func (m *defaultTagsManager) Register(ctx context.Context, proc Process) error {
+	if err := m.Manager.Register(ctx, proc); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, t := range m.tags {
+		proc.Tag(t)
+	}
+
+	return nil
+}
+
+// DefaultEnvironmentMiddleware sets the given environment variables for every
+// process created by the wrapped Manager, unless the process' CreateOptions
+// already define a value for the variable.
+func DefaultEnvironmentMiddleware(env map[string]string) ManagerMiddleware {
+	return func(m Manager) Manager {
+		return &defaultEnvironmentManager{Manager: m, env: env}
+	}
+}
+
+type defaultEnvironmentManager struct {
+	Manager
+	env map[string]string
+}
+
+func (m *defaultEnvironmentManager) Create(ctx context.Context, opts *CreateOptions) (Process, error) {
+	if opts != nil {
+		// Add the variables to a copy, so that the caller's options are
+		// not modified.
+		withEnv := *opts
+		withEnv.Environment = make(map[string]string, len(opts.Environment)+len(m.env))
+		for k, v := range opts.Environment {
+			withEnv.Environment[k] = v
+		}
+		for k, v := range m.env {
+			if _, ok := withEnv.Environment[k]; !ok {
+				withEnv.Environment[k] = v
+			}
+		}
+		opts = &withEnv
+	}
+
+	proc, err := m.Manager.Create(ctx, opts)
+	return proc, errors.WithStack(err)
+}
@@ -0,0 +1,231 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/logging"
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderRecordingManager struct {
+	Manager
+	name  string
+	order *[]string
+}
+
+func (m *orderRecordingManager) Clear(ctx context.Context) {
+	*m.order = append(*m.order, m.name)
+	m.Manager.Clear(ctx)
+}
+
+func TestWrapManager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	order := []string{}
+	record := func(name string) ManagerMiddleware {
+		return func(m Manager) Manager {
+			return &orderRecordingManager{Manager: m, name: name, order: &order}
+		}
+	}
+
+	base := NewLocalManager()
+	assert.Equal(t, base, WrapManager(base))
+
+	manager := WrapManager(base, record("outer"), record("middle"), record("inner"))
+	manager.Clear(ctx)
+	assert.Equal(t, []string{"outer", "middle", "inner"}, order)
+}
+
+func TestManagerMiddleware(t *testing.T) {
+	httpClient := &http.Client{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for mname, factory := range map[string]func(ctx context.Context, t *testing.T) Manager{
+		"Local": func(ctx context.Context, t *testing.T) Manager {
+			return NewLocalManager()
+		},
+		"REST": func(ctx context.Context, t *testing.T) Manager {
+			srv, port := makeAndStartService(ctx, httpClient)
+			require.NotNil(t, srv)
+
+			return &restClient{
+				prefix: fmt.Sprintf("http://localhost:%d/jasper/v1", port),
+				client: httpClient,
+			}
+		},
+	} {
+		t.Run(mname, func(t *testing.T) {
+			for name, test := range map[string]func(context.Context, *testing.T, Manager){
+				"LockingPassesThroughOperations": func(ctx context.Context, t *testing.T, base Manager) {
+					manager := WrapManager(base, LockingMiddleware())
+
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					sameProc, err := manager.Get(ctx, proc.ID())
+					require.NoError(t, err)
+					assert.Equal(t, proc.ID(), sameProc.ID())
+
+					procs, err := manager.List(ctx, All)
+					require.NoError(t, err)
+					assert.Len(t, procs, 1)
+
+					manager.Clear(ctx)
+					_, err = manager.Get(ctx, proc.ID())
+					assert.Error(t, err)
+				},
+				"CapacityRejectsProcessesWhenFull": func(ctx context.Context, t *testing.T, base Manager) {
+					manager := WrapManager(base, CapacityMiddleware(2))
+
+					procs, err := createProcs(ctx, sleepCreateOpts(10), manager, 2)
+					require.NoError(t, err)
+					require.Len(t, procs, 2)
+
+					proc, err := manager.Create(ctx, sleepCreateOpts(10))
+					assert.Error(t, err)
+					assert.Nil(t, proc)
+
+					assert.NoError(t, TerminateAll(ctx, procs))
+				},
+				"CapacityClearsCompletedProcesses": func(ctx context.Context, t *testing.T, base Manager) {
+					manager := WrapManager(base, CapacityMiddleware(2))
+
+					procs, err := createProcs(ctx, trueCreateOpts(), manager, 2)
+					require.NoError(t, err)
+					for _, proc := range procs {
+						_, err = proc.Wait(ctx)
+						require.NoError(t, err)
+					}
+
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					assert.NoError(t, err)
+					assert.NotNil(t, proc)
+				},
+				"AuditLogsOperations": func(ctx context.Context, t *testing.T, base Manager) {
+					sender, err := send.NewInMemorySender("audit", send.LevelInfo{Default: level.Info, Threshold: level.Info}, 100)
+					require.NoError(t, err)
+					manager := WrapManager(base, AuditMiddleware(logging.MakeGrip(sender)))
+
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = manager.Get(ctx, "foo")
+					assert.Error(t, err)
+
+					msgs, err := sender.(*send.InMemorySender).GetString()
+					require.NoError(t, err)
+					require.Len(t, msgs, 2)
+					assert.True(t, strings.Contains(msgs[0], "create"))
+					assert.True(t, strings.Contains(msgs[0], proc.ID()))
+					assert.True(t, strings.Contains(msgs[1], "get"))
+					assert.True(t, strings.Contains(msgs[1], "foo"))
+				},
+				"AuditAllowsNilOptions": func(ctx context.Context, t *testing.T, base Manager) {
+					sender, err := send.NewInMemorySender("audit", send.LevelInfo{Default: level.Info, Threshold: level.Info}, 100)
+					require.NoError(t, err)
+					manager := WrapManager(base, AuditMiddleware(logging.MakeGrip(sender)))
+
+					_, err = manager.Create(ctx, nil)
+					assert.Error(t, err)
+
+					msgs, err := sender.(*send.InMemorySender).GetString()
+					require.NoError(t, err)
+					require.Len(t, msgs, 1)
+					assert.True(t, strings.Contains(msgs[0], "create"))
+				},
+				"MetricsCountsCallsAndFailures": func(ctx context.Context, t *testing.T, base Manager) {
+					metrics := NewManagerMetrics()
+					manager := WrapManager(base, MetricsMiddleware(metrics))
+
+					_, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = manager.Create(ctx, &CreateOptions{})
+					require.Error(t, err)
+					_, err = manager.Get(ctx, "foo")
+					require.Error(t, err)
+
+					stats := metrics.Stats()
+					assert.EqualValues(t, 2, stats["create"].Calls)
+					assert.EqualValues(t, 1, stats["create"].Failures)
+					assert.EqualValues(t, 1, stats["get"].Calls)
+					assert.EqualValues(t, 1, stats["get"].Failures)
+					assert.True(t, stats["create"].Duration > 0)
+					_, ok := stats["list"]
+					assert.False(t, ok)
+				},
+				"DefaultTagsAreAddedToProcesses": func(ctx context.Context, t *testing.T, base Manager) {
+					manager := WrapManager(base, DefaultTagsMiddleware("foo", "bar"))
+
+					opts := trueCreateOpts()
+					opts.Tags = []string{"foo"}
+					proc, err := manager.Create(ctx, opts)
+					require.NoError(t, err)
+
+					procs, err := manager.Group(ctx, "bar")
+					require.NoError(t, err)
+					require.Len(t, procs, 1)
+					assert.Equal(t, proc.ID(), procs[0].ID())
+					assert.Len(t, proc.GetTags(), 2)
+					assert.Equal(t, []string{"foo"}, opts.Tags, "caller's options should not be modified")
+				},
+				"DefaultEnvironmentDoesNotOverrideOptions": func(ctx context.Context, t *testing.T, base Manager) {
+					manager := WrapManager(base, DefaultEnvironmentMiddleware(map[string]string{
+						"foo": "bar",
+						"baz": "qux",
+					}))
+
+					opts := trueCreateOpts()
+					opts.AddEnvVar("baz", "quux")
+					proc, err := manager.Create(ctx, opts)
+					require.NoError(t, err)
+
+					env := proc.Info(ctx).Options.Environment
+					assert.Equal(t, "bar", env["foo"])
+					assert.Equal(t, "quux", env["baz"])
+					assert.Equal(t, map[string]string{"baz": "quux"}, opts.Environment, "caller's options should not be modified")
+				},
+				"MiddlewareComposes": func(ctx context.Context, t *testing.T, base Manager) {
+					metrics := NewManagerMetrics()
+					manager := WrapManager(base,
+						LockingMiddleware(),
+						MetricsMiddleware(metrics),
+						CapacityMiddleware(1),
+						DefaultTagsMiddleware("foo"),
+						DefaultEnvironmentMiddleware(map[string]string{"foo": "bar"}),
+					)
+
+					proc, err := manager.Create(ctx, sleepCreateOpts(10))
+					require.NoError(t, err)
+					assert.Equal(t, []string{"foo"}, proc.GetTags())
+					assert.Equal(t, "bar", proc.Info(ctx).Options.Environment["foo"])
+
+					_, err = manager.Create(ctx, sleepCreateOpts(10))
+					assert.Error(t, err)
+
+					stats := metrics.Stats()
+					assert.EqualValues(t, 2, stats["create"].Calls)
+					assert.EqualValues(t, 1, stats["create"].Failures)
+
+					assert.NoError(t, Terminate(ctx, proc))
+				},
+				// "": func(ctx context.Context, t *testing.T, manager Manager) {},
+			} {
+				t.Run(name, func(t *testing.T) {
+					tctx, cancel := context.WithTimeout(ctx, managerTestTimeout)
+					defer cancel()
+					test(tctx, t, factory(tctx, t))
+				})
+			}
+		})
+	}
+}
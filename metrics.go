@@ -0,0 +1,325 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// MetricsContentType is the content type of the Prometheus text exposition
+// format written by ServiceMetrics.
+const MetricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// metricsLatencyBuckets are the upper bounds, in seconds, of the request
+// latency histogram buckets. These match the Prometheus client defaults.
+var metricsLatencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type latencyHistogram struct {
+	counts []int64
+	count  int64
+	sum    float64
+}
+
+// ServiceMetrics collects operational metrics for a jasper service (process
+// creation and outcomes, triggers, download cache activity, and request
+// latencies) and reports them in the Prometheus text exposition format. It
+// is safe for concurrent use.
+type ServiceMetrics struct {
+	mu sync.Mutex
+
+	created        int64
+	createErrors   int64
+	failed         int64
+	timedOut       int64
+	triggers       map[string]int64
+	cacheSize      int64
+	cacheFiles     int64
+	prunes         int64
+	pruneErrors    int64
+	prunedBytes    int64
+	requestLatency map[string]*latencyHistogram
+
+	downloadCacheSize        int64
+	downloadCacheEntries     int64
+	downloadCachePrunes      int64
+	downloadCachePruneErrors int64
+	downloadCachePrunedBytes int64
+}
+
+// NewServiceMetrics constructs an empty ServiceMetrics.
+func NewServiceMetrics() *ServiceMetrics {
+	return &ServiceMetrics{
+		triggers:       map[string]int64{},
+		requestLatency: map[string]*latencyHistogram{},
+	}
+}
+
+// ObserveCreate records the outcome of an attempt to create a process. When
+// the process is created successfully, ObserveCreate registers a trigger on
+// the process to record how it completes. The follow-up processes that the
+// process' triggers start are only recorded if it was created with a
+// context from WithServiceMetrics.
+func (m *ServiceMetrics) ObserveCreate(ctx context.Context, proc Process, err error) {
+	m.mu.Lock()
+	if err != nil || proc == nil {
+		m.createErrors++
+		m.mu.Unlock()
+		return
+	}
+	m.created++
+	m.mu.Unlock()
+
+	if err = proc.RegisterTrigger(ctx, m.observeCompletion); err != nil {
+		// The process may have already exited, in which case the
+		// trigger will never run.
+		if info := getProcInfoNoHang(ctx, proc); info.Complete {
+			m.observeCompletion(info)
+		}
+	}
+}
+
+func (m *ServiceMetrics) observeCompletion(info ProcessInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case info.Timeout:
+		m.timedOut++
+	case !info.Successful:
+		m.failed++
+	}
+}
+
+type serviceMetricsKey struct{}
+
+// WithServiceMetrics returns a copy of the context in which the triggers
+// of processes created with the context record the follow-up processes
+// that they start in the given ServiceMetrics.
+func WithServiceMetrics(ctx context.Context, m *ServiceMetrics) context.Context {
+	return context.WithValue(ctx, serviceMetricsKey{}, m)
+}
+
+// serviceMetricsFromContext returns the ServiceMetrics that the context
+// was created with, or nil if there is none.
+func serviceMetricsFromContext(ctx context.Context) *ServiceMetrics {
+	m, _ := ctx.Value(serviceMetricsKey{}).(*ServiceMetrics)
+	return m
+}
+
+// observeTrigger records a follow-up process that was started by the
+// given trigger. It does nothing if the ServiceMetrics is nil.
+func (m *ServiceMetrics) observeTrigger(trigger string) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.triggers[trigger]++
+}
+
+// ObserveRequest records the latency of a single request to the given route
+// or RPC method.
+func (m *ServiceMetrics) ObserveRequest(route string, dur time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hist, ok := m.requestLatency[route]
+	if !ok {
+		hist = &latencyHistogram{counts: make([]int64, len(metricsLatencyBuckets))}
+		m.requestLatency[route] = hist
+	}
+
+	secs := dur.Seconds()
+	for i, bound := range metricsLatencyBuckets {
+		if secs <= bound {
+			hist.counts[i]++
+		}
+	}
+	hist.count++
+	hist.sum += secs
+}
+
+// ObserveCache records the current size, in bytes, and number of files in
+// the cache of downloaded MongoDB files.
+func (m *ServiceMetrics) ObserveCache(size, count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.cacheSize = int64(size)
+	m.cacheFiles = int64(count)
+}
+
+// ObservePrune records a pass of the pruner of the cache of downloaded
+// MongoDB files, given the size of the cache, in bytes, before and after
+// the pass.
+func (m *ServiceMetrics) ObservePrune(sizeBefore, sizeAfter int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prunes++
+	if err != nil {
+		m.pruneErrors++
+	}
+	if sizeBefore > sizeAfter {
+		m.prunedBytes += int64(sizeBefore - sizeAfter)
+	}
+	m.cacheSize = int64(sizeAfter)
+}
+
+// ObserveDownloadCachePrune records a pass of the DownloadCache pruner,
+// given the size of the cache, in bytes, before and after the pass, and the
+// number of entries left in the cache.
+func (m *ServiceMetrics) ObserveDownloadCachePrune(sizeBefore, sizeAfter int64, entries int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.downloadCachePrunes++
+	if err != nil {
+		m.downloadCachePruneErrors++
+	}
+	if sizeBefore > sizeAfter {
+		m.downloadCachePrunedBytes += sizeBefore - sizeAfter
+	}
+	m.downloadCacheSize = sizeAfter
+	m.downloadCacheEntries = int64(entries)
+}
+
+// InstrumentHandler wraps an HTTP handler to record its latency under the
+// given route.
+func (m *ServiceMetrics) InstrumentHandler(route string, h http.HandlerFunc) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		h(rw, r)
+		m.ObserveRequest(route, time.Since(start))
+	}
+}
+
+// Handler returns an HTTP handler that reports the collected metrics, along
+// with the current state of the processes in the given manager.
+func (m *ServiceMetrics) Handler(manager Manager) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", MetricsContentType)
+		grip.Warning(errors.Wrap(m.Write(r.Context(), rw, manager), "problem writing metrics"))
+	}
+}
+
+// Write reports the collected metrics, along with the current state of the
+// processes in the given manager, in the Prometheus text exposition format.
+func (m *ServiceMetrics) Write(ctx context.Context, w io.Writer, manager Manager) error {
+	states := map[Filter]int{All: 0, Running: 0, Terminated: 0, Failed: 0, Successful: 0}
+	if manager != nil {
+		// managers return an error rather than an empty list when
+		// they have no processes.
+		procs, _ := manager.List(ctx, All)
+		for _, proc := range procs {
+			info := getProcInfoNoHang(ctx, proc)
+			states[All]++
+			if info.IsRunning {
+				states[Running]++
+			}
+			if info.Complete {
+				states[Terminated]++
+				if info.Successful {
+					states[Successful]++
+				} else {
+					states[Failed]++
+				}
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mw := &metricsWriter{w: w}
+
+	mw.header("jasper_processes", "gauge", "Number of processes in the manager, by state.")
+	for _, state := range []Filter{All, Running, Terminated, Failed, Successful} {
+		mw.sample("jasper_processes", fmt.Sprintf(`state="%s"`, state), float64(states[state]))
+	}
+
+	mw.counter("jasper_processes_created_total", "Number of processes created.", m.created)
+	mw.counter("jasper_process_create_errors_total", "Number of failed attempts to create a process.", m.createErrors)
+	mw.counter("jasper_processes_failed_total", "Number of processes that exited unsuccessfully.", m.failed)
+	mw.counter("jasper_processes_timed_out_total", "Number of processes that exceeded their timeout.", m.timedOut)
+
+	mw.header("jasper_triggers_executed_total", "counter", "Number of follow-up processes started by triggers on process completion, by trigger.")
+	for _, trigger := range []string{"on_success", "on_failure", "on_timeout"} {
+		mw.sample("jasper_triggers_executed_total", fmt.Sprintf(`trigger="%s"`, trigger), float64(m.triggers[trigger]))
+	}
+
+	mw.gauge("jasper_download_cache_size_bytes", "Size of the download cache in bytes.", m.cacheSize)
+	mw.gauge("jasper_download_cache_files", "Number of files in the download cache.", m.cacheFiles)
+	mw.counter("jasper_download_cache_prunes_total", "Number of download cache prune passes.", m.prunes)
+	mw.counter("jasper_download_cache_prune_errors_total", "Number of download cache prune passes that failed.", m.pruneErrors)
+	mw.counter("jasper_download_cache_pruned_bytes_total", "Number of bytes removed from the download cache by pruning.", m.prunedBytes)
+	mw.gauge("jasper_cached_downloads_size_bytes", "Size of the files in the DownloadCache in bytes.", m.downloadCacheSize)
+	mw.gauge("jasper_cached_downloads_entries", "Number of entries in the DownloadCache.", m.downloadCacheEntries)
+	mw.counter("jasper_cached_downloads_prunes_total", "Number of DownloadCache prune passes.", m.downloadCachePrunes)
+	mw.counter("jasper_cached_downloads_prune_errors_total", "Number of DownloadCache prune passes that failed.", m.downloadCachePruneErrors)
+	mw.counter("jasper_cached_downloads_pruned_bytes_total", "Number of bytes removed from the DownloadCache by pruning.", m.downloadCachePrunedBytes)
+
+	routes := make([]string, 0, len(m.requestLatency))
+	for route := range m.requestLatency {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+
+	mw.header("jasper_request_duration_seconds", "histogram", "Latency of requests to the service, by route or RPC method.")
+	for _, route := range routes {
+		hist := m.requestLatency[route]
+		for i, bound := range metricsLatencyBuckets {
+			mw.sample("jasper_request_duration_seconds_bucket", fmt.Sprintf(`route="%s",le="%g"`, route, bound), float64(hist.counts[i]))
+		}
+		mw.sample("jasper_request_duration_seconds_bucket", fmt.Sprintf(`route="%s",le="+Inf"`, route), float64(hist.count))
+		mw.sample("jasper_request_duration_seconds_sum", fmt.Sprintf(`route="%s"`, route), hist.sum)
+		mw.sample("jasper_request_duration_seconds_count", fmt.Sprintf(`route="%s"`, route), float64(hist.count))
+	}
+
+	return errors.Wrap(mw.err, "problem writing metrics")
+}
+
+// metricsWriter writes samples in the Prometheus text exposition format,
+// retaining the first error encountered.
+type metricsWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (mw *metricsWriter) printf(format string, args ...interface{}) {
+	if mw.err != nil {
+		return
+	}
+	_, mw.err = fmt.Fprintf(mw.w, format, args...)
+}
+
+func (mw *metricsWriter) header(name, kind, help string) {
+	mw.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func (mw *metricsWriter) sample(name, labels string, value float64) {
+	if labels == "" {
+		mw.printf("%s %g\n", name, value)
+		return
+	}
+	mw.printf("%s{%s} %g\n", name, labels, value)
+}
+
+func (mw *metricsWriter) counter(name, help string, value int64) {
+	mw.header(name, "counter", help)
+	mw.sample(name, "", float64(value))
+}
+
+func (mw *metricsWriter) gauge(name, help string, value int64) {
+	mw.header(name, "gauge", help)
+	mw.sample(name, "", float64(value))
+}
@@ -0,0 +1,150 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceMetrics(t *testing.T) {
+	for name, test := range map[string]func(context.Context, *testing.T, *ServiceMetrics){
+		"EmptyMetricsReportZeroes": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, metrics.Write(ctx, buf, nil))
+
+			out := buf.String()
+			assert.Contains(t, out, "# TYPE jasper_processes gauge\n")
+			assert.Contains(t, out, `jasper_processes{state="all"} 0`)
+			assert.Contains(t, out, "jasper_processes_created_total 0\n")
+			assert.Contains(t, out, `jasper_triggers_executed_total{trigger="on_success"} 0`)
+			assert.Contains(t, out, "jasper_download_cache_size_bytes 0\n")
+			assert.Contains(t, out, "# TYPE jasper_request_duration_seconds histogram\n")
+		},
+		"ProcessOutcomesAreCounted": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {
+			manager := NewLocalManager()
+			ctx = WithServiceMetrics(ctx, metrics)
+
+			opts := trueCreateOpts()
+			opts.OnSuccess = []*CreateOptions{trueCreateOpts(), trueCreateOpts()}
+			proc, err := manager.Create(ctx, opts)
+			metrics.ObserveCreate(ctx, proc, err)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			opts = falseCreateOpts()
+			opts.OnFailure = []*CreateOptions{trueCreateOpts(), {}}
+			proc, err = manager.Create(ctx, opts)
+			metrics.ObserveCreate(ctx, proc, err)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.Error(t, err)
+
+			proc, err = manager.Create(ctx, &CreateOptions{})
+			metrics.ObserveCreate(ctx, proc, err)
+			require.Error(t, err)
+
+			// triggers run after Wait returns.
+			time.Sleep(100 * time.Millisecond)
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, metrics.Write(ctx, buf, manager))
+
+			out := buf.String()
+			assert.Contains(t, out, "jasper_processes_created_total 2\n")
+			assert.Contains(t, out, "jasper_process_create_errors_total 1\n")
+			assert.Contains(t, out, "jasper_processes_failed_total 1\n")
+			assert.Contains(t, out, "jasper_processes_timed_out_total 0\n")
+			assert.Contains(t, out, `jasper_triggers_executed_total{trigger="on_success"} 2`)
+			assert.Contains(t, out, `jasper_triggers_executed_total{trigger="on_failure"} 1`)
+			assert.Contains(t, out, `jasper_processes{state="failed"} 1`)
+			assert.Contains(t, out, `jasper_processes{state="running"} 0`)
+		},
+		"TriggersWithoutServiceMetricsAreNotCounted": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {
+			manager := NewLocalManager()
+
+			opts := trueCreateOpts()
+			opts.OnSuccess = []*CreateOptions{trueCreateOpts()}
+			proc, err := manager.Create(ctx, opts)
+			metrics.ObserveCreate(ctx, proc, err)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+			time.Sleep(100 * time.Millisecond)
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, metrics.Write(ctx, buf, nil))
+			assert.Contains(t, buf.String(), `jasper_triggers_executed_total{trigger="on_success"} 0`)
+		},
+		"CacheAndPruneActivityIsReported": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {
+			metrics.ObserveCache(2048, 4)
+			metrics.ObservePrune(2048, 1024, nil)
+			metrics.ObservePrune(1024, 1024, errors.New("prune failed"))
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, metrics.Write(ctx, buf, nil))
+
+			out := buf.String()
+			assert.Contains(t, out, "jasper_download_cache_size_bytes 1024\n")
+			assert.Contains(t, out, "jasper_download_cache_files 4\n")
+			assert.Contains(t, out, "jasper_download_cache_prunes_total 2\n")
+			assert.Contains(t, out, "jasper_download_cache_prune_errors_total 1\n")
+			assert.Contains(t, out, "jasper_download_cache_pruned_bytes_total 1024\n")
+		},
+		"DownloadCachePruneActivityIsReported": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {
+			metrics.ObserveDownloadCachePrune(4096, 1024, 2, nil)
+			metrics.ObserveDownloadCachePrune(1024, 1024, 2, errors.New("prune failed"))
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, metrics.Write(ctx, buf, nil))
+
+			out := buf.String()
+			assert.Contains(t, out, "jasper_cached_downloads_size_bytes 1024\n")
+			assert.Contains(t, out, "jasper_cached_downloads_entries 2\n")
+			assert.Contains(t, out, "jasper_cached_downloads_prunes_total 2\n")
+			assert.Contains(t, out, "jasper_cached_downloads_prune_errors_total 1\n")
+			assert.Contains(t, out, "jasper_cached_downloads_pruned_bytes_total 3072\n")
+		},
+		"RequestLatenciesAreBucketed": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {
+			metrics.ObserveRequest("/create", 20*time.Millisecond)
+			metrics.ObserveRequest("/create", 2*time.Second)
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, metrics.Write(ctx, buf, nil))
+
+			out := buf.String()
+			assert.Contains(t, out, `jasper_request_duration_seconds_bucket{route="/create",le="0.01"} 0`)
+			assert.Contains(t, out, `jasper_request_duration_seconds_bucket{route="/create",le="0.025"} 1`)
+			assert.Contains(t, out, `jasper_request_duration_seconds_bucket{route="/create",le="2.5"} 2`)
+			assert.Contains(t, out, `jasper_request_duration_seconds_bucket{route="/create",le="+Inf"} 2`)
+			assert.Contains(t, out, `jasper_request_duration_seconds_count{route="/create"} 2`)
+		},
+		"HandlerInstrumentsAndReports": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {
+			handler := metrics.InstrumentHandler("/foo", func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(http.StatusOK)
+			})
+			handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/foo", nil))
+
+			rw := httptest.NewRecorder()
+			metrics.Handler(NewLocalManager())(rw, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			assert.Equal(t, http.StatusOK, rw.Code)
+			assert.Equal(t, MetricsContentType, rw.Header().Get("Content-Type"))
+			assert.Contains(t, rw.Body.String(), `jasper_request_duration_seconds_count{route="/foo"} 1`)
+		},
+		// "": func(ctx context.Context, t *testing.T, metrics *ServiceMetrics) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+			defer cancel()
+
+			test(ctx, t, NewServiceMetrics())
+		})
+	}
+}
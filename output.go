@@ -1,12 +1,15 @@
 package jasper
 
 import (
+	"context"
 	"io"
 	"io/ioutil"
+	"strings"
 	"time"
 
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/send"
 	"github.com/pkg/errors"
 )
@@ -14,17 +17,27 @@ import (
 // OutputOptions provides a common way to define and represent the
 // output behavior of a evergreen/subprocess.Command operation.
 type OutputOptions struct {
-	Output            io.Writer `json:"-"`
-	Error             io.Writer `json:"-"`
-	SuppressOutput    bool      `json:"suppress_output"`
-	SuppressError     bool      `json:"suppress_error"`
-	SendOutputToError bool      `json:"redirect_output_to_error"`
-	SendErrorToOutput bool      `json:"redirect_error_to_output"`
-	Loggers           []Logger  `json:"loggers"`
+	Output            io.Writer          `json:"-"`
+	Error             io.Writer          `json:"-"`
+	SuppressOutput    bool               `json:"suppress_output"`
+	SuppressError     bool               `json:"suppress_error"`
+	SendOutputToError bool               `json:"redirect_output_to_error"`
+	SendErrorToOutput bool               `json:"redirect_error_to_output"`
+	Loggers           []Logger           `json:"loggers"`
+	Redaction         RedactionOptions   `json:"redaction"`
+	LineBuffer        LineBufferOptions  `json:"line_buffer"`
+	Limits            OutputLimitOptions `json:"limits"`
 	outputSender      *send.WriterSender
 	errorSender       *send.WriterSender
 	outputMulti       io.Writer
 	errorMulti        io.Writer
+	redactor          *redactor
+	fileNameData      LogFileNameData
+	outputLines       *lineWriter
+	errorLines        *lineWriter
+	outputLimit       *limitWriter
+	errorLimit        *limitWriter
+	killProcess       func()
 }
 
 // LogType is a type for representing various logging options.
@@ -41,6 +54,10 @@ const (
 	LogSplunk        = "splunk"        // nolint
 	LogSumologic     = "sumologic"     // nolint
 	LogInMemory      = "inmemory"      // nolint
+	LogRotatingFile  = "rotatingfile"  // nolint
+	LogSyslog        = "syslog"        // nolint
+	LogJournald      = "journald"      // nolint
+	LogHTTP          = "http"          // nolint
 )
 
 const (
@@ -68,14 +85,29 @@ const (
 // LogOptions contains options related to the logging done by Jasper.
 //
 // By default, logger reads from both standard output and standard error.
+//
+// In-memory loggers keep at most InMemoryCap messages and, if
+// InMemoryMaxBytes is set, at most InMemoryMaxBytes bytes of messages,
+// discarding the oldest messages first; at least one of the two must be
+// set.
+//
+// Syslog loggers are configured by SyslogOptions. Journald loggers need
+// no options, but are only available on Linux. HTTP loggers are
+// configured by HTTPOptions, and batch messages according to
+// BufferOptions.
 type LogOptions struct {
 	BufferOptions      BufferOptions             `json:"buffer_options"`
 	BuildloggerOptions send.BuildloggerConfig    `json:"buildlogger_options"`
 	DefaultPrefix      string                    `json:"default_prefix"`
 	FileName           string                    `json:"file_name"`
 	Format             LogFormat                 `json:"format"`
+	HTTPOptions        HTTPLogOptions            `json:"http_options"`
 	InMemoryCap        int                       `json:"in_memory_cap"`
+	InMemoryMaxBytes   int                       `json:"in_memory_max_bytes"`
+	LineParser         LineParserOptions         `json:"line_parser"`
+	RotationOptions    RotationOptions           `json:"rotation_options"`
 	SplunkOptions      send.SplunkConnectionInfo `json:"splunk_options"`
+	SyslogOptions      SyslogOptions             `json:"syslog_options"`
 	SumoEndpoint       string                    `json:"sumo_endpoint"`
 }
 
@@ -89,14 +121,142 @@ func (opts BufferOptions) Validate() error {
 
 // Validate ensures that LogOptions is valid.
 func (opts LogOptions) Validate() error {
-	return opts.BufferOptions.Validate()
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(opts.BufferOptions.Validate())
+	catcher.Add(opts.RotationOptions.Validate())
+	catcher.Add(opts.LineParser.Validate())
+	catcher.Add(opts.SyslogOptions.Validate())
+	catcher.Add(opts.HTTPOptions.Validate())
+	return catcher.Resolve()
+}
+
+// LogStream identifies one of the output streams of a process.
+type LogStream string
+
+const (
+	// LogStreamOutput refers to the standard output of a process.
+	LogStreamOutput LogStream = "output"
+	// LogStreamError refers to the standard error of a process.
+	LogStreamError LogStream = "error"
+)
+
+// Validate ensures that the LogStream is valid.
+func (s LogStream) Validate() error {
+	switch s {
+	case LogStreamOutput, LogStreamError:
+		return nil
+	default:
+		return errors.Errorf("unknown log stream '%s'", s)
+	}
+}
+
+// LogStreamOptions describes how a Logger handles one of the output
+// streams of a process. If Prefix is set, it is prepended to every
+// line from the stream, and if Level is set, the lines are logged at
+// that level instead of the stream's default level (info for standard
+// output and error for standard error).
+type LogStreamOptions struct {
+	Stream LogStream      `json:"stream"`
+	Prefix string         `json:"prefix,omitempty"`
+	Level  level.Priority `json:"level,omitempty"`
 }
 
-// Logger is a wrapper struct around a grip/send.Sender.
+// Validate ensures that the LogStreamOptions is valid.
+func (opts LogStreamOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(opts.Stream.Validate())
+	if opts.Level != level.Invalid && !level.IsValidPriority(opts.Level) {
+		catcher.Add(errors.Errorf("invalid level %d for log stream '%s'", opts.Level, opts.Stream))
+	}
+	return catcher.Resolve()
+}
+
+// Logger is a wrapper struct around a grip/send.Sender. Streams lists
+// the output streams that the Logger consumes; if it is empty, the
+// Logger consumes both standard output and standard error.
 type Logger struct {
-	Type    LogType    `json:"log_type"`
-	Options LogOptions `json:"log_options"`
+	Type    LogType            `json:"log_type"`
+	Options LogOptions         `json:"log_options"`
+	Streams []LogStreamOptions `json:"streams,omitempty"`
 	sender  send.Sender
+
+	fileNameData LogFileNameData
+}
+
+// streamOptions returns the options for the given stream, and whether or
+// not the Logger consumes it.
+func (l Logger) streamOptions(stream LogStream) (LogStreamOptions, bool) {
+	if len(l.Streams) == 0 {
+		return LogStreamOptions{Stream: stream}, true
+	}
+	for _, opts := range l.Streams {
+		if opts.Stream == stream {
+			return opts, true
+		}
+	}
+	return LogStreamOptions{}, false
+}
+
+// streamSender wraps the sender of a Logger to apply the prefix, level,
+// and line parser of one stream to the messages from that stream, and to
+// record the stream that they came from.
+type streamSender struct {
+	send.Sender
+	stream   LogStream
+	prefix   string
+	priority level.Priority
+	parser   lineParser
+}
+
+func (s *streamSender) Send(m message.Composer) {
+	if !m.Loggable() {
+		s.Sender.Send(m)
+		return
+	}
+
+	now := time.Now()
+	str := m.String()
+	if s.prefix == "" && s.priority == level.Invalid && s.parser == nil && !strings.Contains(str, "\n") {
+		s.Sender.Send(&streamMessage{Composer: m, stream: s.stream, time: now})
+		return
+	}
+
+	// Writers may flush several lines as a single message, so split
+	// them to apply the prefix to, and record, each line.
+	priority := m.Priority()
+	if s.priority != level.Invalid {
+		priority = s.priority
+	}
+	for _, line := range strings.Split(str, "\n") {
+		msg := message.NewDefaultMessage(priority, s.prefix+line)
+		if s.parser != nil && msg.Loggable() {
+			if fields, err := s.parser(line); err == nil {
+				msg = &parsedMessage{Composer: msg, fields: fields}
+			}
+		}
+		s.Sender.Send(&streamMessage{Composer: msg, stream: s.stream, time: now})
+	}
+}
+
+// configureStream returns the sender to use for the given stream, or nil
+// if the Logger does not consume it.
+func (l *Logger) configureStream(stream LogStream) (send.Sender, error) {
+	opts, ok := l.streamOptions(stream)
+	if !ok {
+		return nil, nil
+	}
+
+	sender, err := l.Configure()
+	if err != nil {
+		return nil, err
+	}
+
+	parser, err := l.Options.LineParser.makeParser()
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamSender{Sender: sender, stream: stream, prefix: opts.Prefix, priority: opts.Level, parser: parser}, nil
 }
 
 // Validate ensures that LogOptions is valid.
@@ -104,13 +264,31 @@ func (l Logger) Validate() error {
 	catcher := grip.NewBasicCatcher()
 	catcher.Add(l.Type.Validate())
 	catcher.Add(l.Options.Validate())
+	seen := map[LogStream]bool{}
+	for _, opts := range l.Streams {
+		catcher.Add(opts.Validate())
+		if seen[opts.Stream] {
+			catcher.Add(errors.Errorf("log stream '%s' specified more than once", opts.Stream))
+		}
+		seen[opts.Stream] = true
+	}
+	if l.Type == LogRotatingFile {
+		if l.Options.FileName == "" {
+			catcher.Add(errors.New("missing file name for output type rotatingfile"))
+		} else if _, err := parseLogFileName(l.Options.FileName); err != nil {
+			catcher.Add(err)
+		}
+		if l.Options.RotationOptions.MaxSize == 0 && l.Options.RotationOptions.Interval == 0 {
+			catcher.Add(errors.New("must specify a maximum size or an interval for output type rotatingfile"))
+		}
+	}
 	return catcher.Resolve()
 }
 
 // Validate ensures that the LogType is valid.
 func (l LogType) Validate() error {
 	switch l {
-	case LogBuildloggerV2, LogBuildloggerV3, LogDefault, LogFile, LogInherit, LogSplunk, LogSumologic, LogInMemory:
+	case LogBuildloggerV2, LogBuildloggerV3, LogDefault, LogFile, LogInherit, LogSplunk, LogSumologic, LogInMemory, LogRotatingFile, LogSyslog, LogJournald, LogHTTP:
 		return nil
 	default:
 		return errors.New("unknown log type")
@@ -182,6 +360,19 @@ func (l *Logger) Configure() (send.Sender, error) {
 			return nil, err
 		}
 		sender.SetName(DefaultLogName)
+	case LogRotatingFile:
+		fileName, err := expandLogFileName(l.Options.FileName, l.fileNameData)
+		if err != nil {
+			return nil, err
+		}
+		file, err := newRotatingFile(fileName, l.Options.RotationOptions)
+		if err != nil {
+			return nil, err
+		}
+		sender, err = newRotatingFileSender(DefaultLogName, file)
+		if err != nil {
+			return nil, err
+		}
 	case LogInherit:
 		sender = grip.GetSender()
 	case LogSplunk:
@@ -201,6 +392,13 @@ func (l *Logger) Configure() (send.Sender, error) {
 			return nil, err
 		}
 	case LogInMemory:
+		if l.Options.InMemoryMaxBytes != 0 {
+			sender, err = newCappedInMemorySender(DefaultLogName, send.LevelInfo{Default: level.Trace, Threshold: level.Trace}, l.Options.InMemoryCap, l.Options.InMemoryMaxBytes)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
 		if l.Options.InMemoryCap <= 0 {
 			return nil, errors.New("invalid inmemory capacity")
 		}
@@ -208,6 +406,21 @@ func (l *Logger) Configure() (send.Sender, error) {
 		if err != nil {
 			return nil, err
 		}
+	case LogSyslog:
+		sender, err = newSyslogSender(DefaultLogName, l.Options.SyslogOptions)
+		if err != nil {
+			return nil, err
+		}
+	case LogJournald:
+		sender, err = newJournaldSender(DefaultLogName)
+		if err != nil {
+			return nil, err
+		}
+	case LogHTTP:
+		sender, err = newHTTPSender(DefaultLogName, l.Options.HTTPOptions)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errors.New("unknown log type")
 	}
@@ -232,6 +445,20 @@ func (l *Logger) Configure() (send.Sender, error) {
 	return l.sender, nil
 }
 
+// GetInMemoryLogs returns the lines logged so far by the in-memory
+// logger of the given process. It implicitly assumes that the process
+// has at most one in-memory logger, and errors if it has none.
+func GetInMemoryLogs(ctx context.Context, proc Process) ([]string, error) {
+	info := getProcInfoNoHang(ctx, proc)
+	for _, logger := range info.Options.Output.Loggers {
+		if sender, ok := logger.sender.(inMemorySender); ok {
+			return sender.GetString()
+		}
+	}
+
+	return nil, errors.Errorf("no in-memory logger found for process '%s'", proc.ID())
+}
+
 // BufferOptions packages options for whether or not a Logger should be
 // buffered and the duration and size of the respective buffer in the case that
 // it should be.
@@ -241,6 +468,88 @@ type BufferOptions struct {
 	MaxSize  int           `json:"max_size"`
 }
 
+// setLogFileNameData makes the given data available to the file name
+// templates of the loggers and to the line prefix template.
+func (o *OutputOptions) setLogFileNameData(data LogFileNameData) {
+	o.fileNameData = data
+	for i := range o.Loggers {
+		o.Loggers[i].fileNameData = data
+	}
+}
+
+// setupRedaction prepares the redaction of output, using lookupEnv to find
+// the values of the environment variables to redact.
+func (o *OutputOptions) setupRedaction(lookupEnv func(string) (string, bool)) error {
+	var err error
+	o.redactor, err = o.Redaction.makeRedactor(lookupEnv)
+	return errors.Wrap(err, "invalid redaction options")
+}
+
+// flushOutput writes any output that is held back by line buffering,
+// redaction, or output limits.
+func (o *OutputOptions) flushOutput() error {
+	catcher := grip.NewBasicCatcher()
+	if o.outputLines != nil {
+		catcher.Add(o.outputLines.flush())
+	}
+	if o.errorLines != nil {
+		catcher.Add(o.errorLines.flush())
+	}
+	if o.outputLimit != nil {
+		catcher.Add(o.outputLimit.flush())
+	}
+	if o.errorLimit != nil {
+		catcher.Add(o.errorLimit.flush())
+	}
+	return catcher.Resolve()
+}
+
+// truncated returns whether the output and the error streams have been
+// truncated by the output limits.
+func (o OutputOptions) truncated() (output bool, err bool) {
+	return o.outputLimit.isTruncated(), o.errorLimit.isTruncated()
+}
+
+// wrapLines returns a writer that redacts and prefixes the lines written
+// to the given stream before writing them to the given writer, or nil if
+// the output of the stream does not need to be buffered into lines.
+func (o *OutputOptions) wrapLines(w io.Writer, stream LogStream) (*lineWriter, error) {
+	if o.redactor == nil {
+		if err := o.setupRedaction(nil); err != nil {
+			return nil, err
+		}
+	}
+
+	formatters := []lineFormatter{}
+	if o.redactor != nil {
+		formatters = append(formatters, o.redactor.format)
+	}
+	prefixer, err := o.LineBuffer.makePrefixer(o.fileNameData, stream)
+	if err != nil {
+		return nil, err
+	}
+	if prefixer != nil {
+		formatters = append(formatters, prefixer)
+	}
+
+	if len(formatters) == 0 && !o.LineBuffer.Enabled {
+		return nil, nil
+	}
+	return &lineWriter{writer: w, formatters: formatters}, nil
+}
+
+// closeLoggers closes the senders of all loggers, each of which may be
+// shared between the output and error streams.
+func (o *OutputOptions) closeLoggers() error {
+	catcher := grip.NewBasicCatcher()
+	for i := range o.Loggers {
+		if o.Loggers[i].sender != nil {
+			catcher.Add(o.Loggers[i].sender.Close())
+		}
+	}
+	return catcher.Resolve()
+}
+
 func (o OutputOptions) outputIsNull() bool {
 	if o.Output == nil {
 		return true
@@ -253,12 +562,21 @@ func (o OutputOptions) outputIsNull() bool {
 	return false
 }
 
+func (o OutputOptions) hasLoggers(stream LogStream) bool {
+	for _, logger := range o.Loggers {
+		if _, ok := logger.streamOptions(stream); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (o OutputOptions) outputLogging() bool {
-	return len(o.Loggers) > 0 && !o.SuppressOutput
+	return o.hasLoggers(LogStreamOutput) && !o.SuppressOutput
 }
 
 func (o OutputOptions) errorLogging() bool {
-	return len(o.Loggers) > 0 && !o.SuppressError
+	return o.hasLoggers(LogStreamError) && !o.SuppressError
 }
 
 func (o OutputOptions) errorIsNull() bool {
@@ -306,6 +624,10 @@ func (o OutputOptions) Validate() error {
 		catcher.Add(errors.New("cannot create redirect cycle between output and error"))
 	}
 
+	catcher.Add(o.Redaction.Validate())
+	catcher.Add(o.LineBuffer.Validate())
+	catcher.Add(o.Limits.Validate())
+
 	for _, logger := range o.Loggers {
 		if err := logger.Validate(); err != nil {
 			catcher.Add(err)
@@ -325,6 +647,72 @@ func (o *OutputOptions) GetOutput() (io.Writer, error) {
 		return o.GetError()
 	}
 
+	if o.outputLines != nil {
+		return o.outputLines, nil
+	}
+
+	out, err := o.outputWriter()
+	if err != nil || out == ioutil.Discard {
+		return out, err
+	}
+
+	if o.outputLimit == nil {
+		o.outputLimit = o.Limits.makeLimiter(out, o.killProcess)
+	}
+	if o.outputLimit != nil {
+		out = o.outputLimit
+	}
+
+	lines, err := o.wrapLines(out, LogStreamOutput)
+	if err != nil {
+		return ioutil.Discard, err
+	}
+	if lines == nil {
+		return out, nil
+	}
+	o.outputLines = lines
+
+	return o.outputLines, nil
+}
+
+// GetError returns an io.Writer that can be used for standard error, depending on
+// the output configuration.
+func (o *OutputOptions) GetError() (io.Writer, error) {
+	if o.SendErrorToOutput {
+		return o.GetOutput()
+	}
+
+	if o.errorLines != nil {
+		return o.errorLines, nil
+	}
+
+	out, err := o.errorWriter()
+	if err != nil || out == ioutil.Discard {
+		return out, err
+	}
+
+	if o.errorLimit == nil {
+		o.errorLimit = o.Limits.makeLimiter(out, o.killProcess)
+	}
+	if o.errorLimit != nil {
+		out = o.errorLimit
+	}
+
+	lines, err := o.wrapLines(out, LogStreamError)
+	if err != nil {
+		return ioutil.Discard, err
+	}
+	if lines == nil {
+		return out, nil
+	}
+	o.errorLines = lines
+
+	return o.errorLines, nil
+}
+
+// outputWriter returns the writer that writes to the destinations of
+// standard output.
+func (o *OutputOptions) outputWriter() (io.Writer, error) {
 	if o.outputIsNull() && !o.outputLogging() {
 		return ioutil.Discard, nil
 	}
@@ -337,11 +725,13 @@ func (o *OutputOptions) GetOutput() (io.Writer, error) {
 		outSenders := []send.Sender{}
 
 		for i := range o.Loggers {
-			sender, err := o.Loggers[i].Configure()
+			sender, err := o.Loggers[i].configureStream(LogStreamOutput)
 			if err != nil {
 				return ioutil.Discard, err
 			}
-			outSenders = append(outSenders, sender)
+			if sender != nil {
+				outSenders = append(outSenders, sender)
+			}
 		}
 
 		var outMulti send.Sender
@@ -354,7 +744,7 @@ func (o *OutputOptions) GetOutput() (io.Writer, error) {
 				return ioutil.Discard, err
 			}
 		}
-		o.outputSender = send.NewWriterSender(outMulti)
+		o.outputSender = send.MakeWriterSender(outMulti, level.Info)
 	}
 
 	if !o.outputIsNull() && o.outputLogging() {
@@ -368,13 +758,9 @@ func (o *OutputOptions) GetOutput() (io.Writer, error) {
 	return o.outputMulti, nil
 }
 
-// GetError returns an io.Writer that can be used for standard error, depending on
-// the output configuration.
-func (o *OutputOptions) GetError() (io.Writer, error) {
-	if o.SendErrorToOutput {
-		return o.GetOutput()
-	}
-
+// errorWriter returns the writer that writes to the destinations of
+// standard error.
+func (o *OutputOptions) errorWriter() (io.Writer, error) {
 	if o.errorIsNull() && !o.errorLogging() {
 		return ioutil.Discard, nil
 	}
@@ -387,18 +773,26 @@ func (o *OutputOptions) GetError() (io.Writer, error) {
 		errSenders := []send.Sender{}
 
 		for i := range o.Loggers {
-			sender, err := o.Loggers[i].Configure()
+			sender, err := o.Loggers[i].configureStream(LogStreamError)
 			if err != nil {
 				return ioutil.Discard, err
 			}
-			errSenders = append(errSenders, sender)
+			if sender != nil {
+				errSenders = append(errSenders, sender)
+			}
 		}
 
-		errMulti, err := send.NewMultiSender(DefaultLogName, send.LevelInfo{Default: level.Error, Threshold: level.Trace}, errSenders)
-		if err != nil {
-			return ioutil.Discard, err
+		var errMulti send.Sender
+		if len(errSenders) == 1 {
+			errMulti = errSenders[0]
+		} else {
+			var err error
+			errMulti, err = send.NewMultiSender(DefaultLogName, send.LevelInfo{Default: level.Error, Threshold: level.Trace}, errSenders)
+			if err != nil {
+				return ioutil.Discard, err
+			}
 		}
-		o.errorSender = send.NewWriterSender(errMulti)
+		o.errorSender = send.MakeWriterSender(errMulti, level.Error)
 	}
 
 	if !o.errorIsNull() && o.errorLogging() {
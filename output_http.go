@@ -0,0 +1,268 @@
+package jasper
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultHTTPLogTimeout is the timeout of each request made by LogHTTP
+	// loggers if HTTPLogOptions does not specify one.
+	DefaultHTTPLogTimeout = 30 * time.Second
+	// DefaultHTTPLogRetryBackoff is the time that LogHTTP loggers wait
+	// before the first retry of a failed request if HTTPLogOptions does
+	// not specify one.
+	DefaultHTTPLogRetryBackoff = time.Second
+	// HTTPLogQueueSize is the number of requests that LogHTTP loggers
+	// hold while they wait to be sent. Messages logged while the queue
+	// is full are dropped.
+	HTTPLogQueueSize = 1000
+)
+
+// HTTPLogOptions configures a LogHTTP logger, which POSTs log messages as
+// JSON to URL. Use the logger's BufferOptions to send messages in
+// batches; otherwise, each message is sent in its own request.
+//
+// The payload is a JSON array of records, or, if BatchKey is set, an
+// object whose BatchKey field holds the array. Each record has the
+// fields "timestamp", "level", and "message", as well as the static
+// Fields, which cannot replace them.
+//
+// Headers are added to every request, and Username and Password, if set,
+// are sent with HTTP basic authentication. The Password is redacted from
+// the ProcessInfo of processes that use the logger.
+//
+// Requests are sent in the background, in the order in which the messages
+// were logged, so that logging does not wait for the endpoint. Requests
+// that fail with a network error or a 5xx or 429 status are retried up to
+// MaxRetries times, waiting RetryBackoff (DefaultHTTPLogRetryBackoff by
+// default) before the first retry and twice as long before each
+// subsequent retry. Each request times out after Timeout
+// (DefaultHTTPLogTimeout by default). Closing the logger waits for the
+// pending requests to finish. Requests that fail, and messages that are
+// dropped because HTTPLogQueueSize requests are already pending, are
+// reported to the logger's error handler.
+type HTTPLogOptions struct {
+	URL          string            `json:"url"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Username     string            `json:"username,omitempty"`
+	Password     string            `json:"password,omitempty"`
+	BatchKey     string            `json:"batch_key,omitempty"`
+	Fields       map[string]string `json:"fields,omitempty"`
+	MaxRetries   int               `json:"max_retries"`
+	RetryBackoff time.Duration     `json:"retry_backoff"`
+	Timeout      time.Duration     `json:"timeout"`
+}
+
+// Validate ensures that the HTTPLogOptions is valid.
+func (opts HTTPLogOptions) Validate() error {
+	if opts.MaxRetries < 0 || opts.RetryBackoff < 0 || opts.Timeout < 0 {
+		return errors.New("cannot have negative retries, retry backoff, or timeout")
+	}
+	for _, key := range []string{"timestamp", "level", "message"} {
+		if _, ok := opts.Fields[key]; ok {
+			return errors.Errorf("cannot replace the '%s' field of log records", key)
+		}
+	}
+	return nil
+}
+
+// httpLogRecord is a single message in the payload of a LogHTTP logger.
+type httpLogRecord map[string]string
+
+// httpLogRequest is a payload that is waiting to be sent, along with the
+// message that it was created from.
+type httpLogRequest struct {
+	body []byte
+	msg  message.Composer
+}
+
+// httpSender is a send.Sender that POSTs messages to an HTTP endpoint. The
+// requests are sent by a background goroutine, which runs until the
+// sender is closed.
+type httpSender struct {
+	opts    HTTPLogOptions
+	client  *http.Client
+	pending chan httpLogRequest
+	done    chan struct{}
+	closed  bool
+	mu      sync.RWMutex
+	*send.Base
+}
+
+func newHTTPSender(name string, opts HTTPLogOptions) (send.Sender, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid http log options")
+	}
+	if opts.URL == "" {
+		return nil, errors.New("missing url for output type http")
+	}
+	if _, err := url.ParseRequestURI(opts.URL); err != nil {
+		return nil, errors.Wrapf(err, "invalid url '%s' for output type http", opts.URL)
+	}
+	if opts.RetryBackoff == 0 {
+		opts.RetryBackoff = DefaultHTTPLogRetryBackoff
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultHTTPLogTimeout
+	}
+
+	s := &httpSender{
+		opts:    opts,
+		client:  &http.Client{Timeout: opts.Timeout},
+		pending: make(chan httpLogRequest, HTTPLogQueueSize),
+		done:    make(chan struct{}),
+	}
+	s.Base = send.MakeBase(name, func() {}, s.close)
+
+	fallback := log.New(os.Stderr, "", log.LstdFlags)
+	if err := s.SetErrorHandler(send.ErrorHandlerFromLogger(fallback)); err != nil {
+		return nil, err
+	}
+	if err := s.SetLevel(send.LevelInfo{Default: level.Trace, Threshold: level.Trace}); err != nil {
+		return nil, err
+	}
+
+	go s.sendPending()
+
+	return s, nil
+}
+
+func (s *httpSender) Send(m message.Composer) {
+	msgs := []message.Composer{m}
+	if group, ok := m.(*message.GroupComposer); ok {
+		msgs = group.Messages()
+	}
+
+	records := make([]httpLogRecord, 0, len(msgs))
+	for _, msg := range msgs {
+		if !s.Level().ShouldLog(msg) {
+			continue
+		}
+		formatted, err := s.Formatter(msg)
+		if err != nil {
+			s.ErrorHandler(err, msg)
+			continue
+		}
+
+		record := httpLogRecord{}
+		for key, value := range s.opts.Fields {
+			record[key] = value
+		}
+		record["timestamp"] = time.Now().Format(time.RFC3339Nano)
+		record["level"] = msg.Priority().String()
+		record["message"] = formatted
+		records = append(records, record)
+	}
+	if len(records) == 0 {
+		return
+	}
+
+	var payload interface{} = records
+	if s.opts.BatchKey != "" {
+		payload = map[string]interface{}{s.opts.BatchKey: records}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.ErrorHandler(errors.Wrap(err, "problem marshaling log payload"), m)
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		s.ErrorHandler(errors.New("cannot send logs with a closed http logger"), m)
+		return
+	}
+
+	select {
+	case s.pending <- httpLogRequest{body: body, msg: m}:
+	default:
+		s.ErrorHandler(errors.Errorf("dropping logs for '%s' because %d requests are pending", s.opts.URL, HTTPLogQueueSize), m)
+	}
+}
+
+// sendPending sends the pending requests until the sender is closed.
+func (s *httpSender) sendPending() {
+	defer close(s.done)
+
+	for req := range s.pending {
+		if err := s.post(req.body); err != nil {
+			s.ErrorHandler(err, req.msg)
+		}
+	}
+}
+
+// close stops the sender from accepting messages, and waits for the
+// pending requests to be sent.
+func (s *httpSender) close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.pending)
+	}
+	s.mu.Unlock()
+
+	<-s.done
+
+	return nil
+}
+
+// post sends the payload, retrying failed requests with exponential
+// backoff.
+func (s *httpSender) post(body []byte) error {
+	backoff := s.opts.RetryBackoff
+	var err error
+	for attempt := 0; attempt <= s.opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+
+		var retry bool
+		if retry, err = s.doRequest(body); err == nil || !retry {
+			return err
+		}
+	}
+	return errors.Wrapf(err, "giving up after %d attempts", s.opts.MaxRetries+1)
+}
+
+// doRequest makes a single request, and reports whether it should be
+// retried if it fails.
+func (s *httpSender) doRequest(body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, s.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "problem building log request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range s.opts.Headers {
+		req.Header.Set(key, value)
+	}
+	if s.opts.Username != "" || s.opts.Password != "" {
+		req.SetBasicAuth(s.opts.Username, s.opts.Password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, errors.Wrapf(err, "problem sending logs to '%s'", s.opts.URL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, errors.Errorf("sending logs to '%s' failed with status '%s'", s.opts.URL, resp.Status)
+}
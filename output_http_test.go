@@ -0,0 +1,214 @@
+package jasper
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPLogOptions(t *testing.T) {
+	for name, opts := range map[string]HTTPLogOptions{
+		"Empty":  {},
+		"Fields": {URL: "http://localhost", Fields: map[string]string{"host": "foo"}, MaxRetries: 3},
+	} {
+		t.Run("Valid"+name, func(t *testing.T) {
+			assert.NoError(t, opts.Validate())
+		})
+	}
+
+	for name, opts := range map[string]HTTPLogOptions{
+		"NegativeRetries":    {MaxRetries: -1},
+		"NegativeBackoff":    {RetryBackoff: -1},
+		"NegativeTimeout":    {Timeout: -1},
+		"ReplacedFieldLevel": {Fields: map[string]string{"level": "foo"}},
+	} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			assert.Error(t, opts.Validate())
+		})
+	}
+}
+
+// httpLogCollector is an HTTP handler that records the log payloads that
+// it receives, and fails the first failures requests.
+type httpLogCollector struct {
+	failures int
+	requests []*http.Request
+	payloads [][]byte
+	mu       sync.Mutex
+}
+
+func (c *httpLogCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	payload := json.RawMessage{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.requests = append(c.requests, r)
+	if len(c.requests) <= c.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	c.payloads = append(c.payloads, payload)
+}
+
+func (c *httpLogCollector) records(t *testing.T) []httpLogRecord {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	records := []httpLogRecord{}
+	for _, payload := range c.payloads {
+		batch := []httpLogRecord{}
+		require.NoError(t, json.Unmarshal(payload, &batch))
+		records = append(records, batch...)
+	}
+	return records
+}
+
+func TestHTTPSender(t *testing.T) {
+	for name, test := range map[string]func(*testing.T, *httpLogCollector, string){
+		"SendsRecordsWithHeadersAndAuth": func(t *testing.T, c *httpLogCollector, url string) {
+			l := Logger{Type: LogHTTP, Options: LogOptions{
+				Format: LogFormatPlain,
+				HTTPOptions: HTTPLogOptions{
+					URL:      url,
+					Headers:  map[string]string{"X-Api-Key": "foo"},
+					Username: "user",
+					Password: "pass",
+					Fields:   map[string]string{"service": "bar"},
+				},
+			}}
+			require.NoError(t, l.Validate())
+			sender, err := l.Configure()
+			require.NoError(t, err)
+
+			sender.Send(message.NewDefaultMessage(level.Warning, "baz"))
+			require.NoError(t, sender.Close())
+
+			require.Len(t, c.requests, 1)
+			req := c.requests[0]
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			assert.Equal(t, "foo", req.Header.Get("X-Api-Key"))
+			user, pass, ok := req.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", user)
+			assert.Equal(t, "pass", pass)
+
+			records := c.records(t)
+			require.Len(t, records, 1)
+			assert.Equal(t, "baz", records[0]["message"])
+			assert.Equal(t, level.Warning.String(), records[0]["level"])
+			assert.Equal(t, "bar", records[0]["service"])
+			_, err = time.Parse(time.RFC3339Nano, records[0]["timestamp"])
+			assert.NoError(t, err)
+		},
+		"BufferOptionsBatchMessages": func(t *testing.T, c *httpLogCollector, url string) {
+			l := Logger{Type: LogHTTP, Options: LogOptions{
+				Format:        LogFormatPlain,
+				BufferOptions: BufferOptions{Buffered: true, Duration: time.Hour, MaxSize: 10},
+				HTTPOptions:   HTTPLogOptions{URL: url},
+			}}
+			sender, err := l.Configure()
+			require.NoError(t, err)
+
+			for _, msg := range []string{"foo", "bar", "baz"} {
+				sender.Send(message.NewDefaultMessage(level.Info, msg))
+			}
+			require.NoError(t, sender.Close())
+
+			require.Len(t, c.requests, 1)
+			records := c.records(t)
+			require.Len(t, records, 3)
+			for idx, msg := range []string{"foo", "bar", "baz"} {
+				assert.Equal(t, msg, records[idx]["message"])
+			}
+		},
+		"BatchKeyWrapsRecords": func(t *testing.T, c *httpLogCollector, url string) {
+			sender, err := newHTTPSender("test", HTTPLogOptions{URL: url, BatchKey: "logs"})
+			require.NoError(t, err)
+			sender.Send(message.NewDefaultMessage(level.Info, "foo"))
+			require.NoError(t, sender.Close())
+
+			require.Len(t, c.payloads, 1)
+			payload := map[string][]httpLogRecord{}
+			require.NoError(t, json.Unmarshal(c.payloads[0], &payload))
+			require.Len(t, payload["logs"], 1)
+			assert.Contains(t, payload["logs"][0]["message"], "foo")
+		},
+		"RetriesFailedRequests": func(t *testing.T, c *httpLogCollector, url string) {
+			c.failures = 2
+			sender, err := newHTTPSender("test", HTTPLogOptions{URL: url, MaxRetries: 2, RetryBackoff: time.Millisecond})
+			require.NoError(t, err)
+			sender.Send(message.NewDefaultMessage(level.Info, "foo"))
+			require.NoError(t, sender.Close())
+
+			assert.Len(t, c.requests, 3)
+			assert.Len(t, c.records(t), 1)
+		},
+		"GivesUpAfterMaxRetries": func(t *testing.T, c *httpLogCollector, url string) {
+			c.failures = 5
+			sender, err := newHTTPSender("test", HTTPLogOptions{URL: url, MaxRetries: 1, RetryBackoff: time.Millisecond})
+			require.NoError(t, err)
+
+			var handled error
+			require.NoError(t, sender.SetErrorHandler(func(err error, _ message.Composer) { handled = err }))
+			sender.Send(message.NewDefaultMessage(level.Info, "foo"))
+			require.NoError(t, sender.Close())
+
+			assert.Len(t, c.requests, 2)
+			assert.Empty(t, c.records(t))
+			assert.Error(t, handled)
+		},
+		"SendDoesNotWaitForRetries": func(t *testing.T, c *httpLogCollector, url string) {
+			c.failures = 1
+			sender, err := newHTTPSender("test", HTTPLogOptions{URL: url, MaxRetries: 1, RetryBackoff: time.Second})
+			require.NoError(t, err)
+
+			start := time.Now()
+			sender.Send(message.NewDefaultMessage(level.Info, "foo"))
+			assert.True(t, time.Since(start) < 500*time.Millisecond)
+
+			require.NoError(t, sender.Close())
+			assert.Len(t, c.requests, 2)
+			assert.Len(t, c.records(t), 1)
+		},
+		"SendFailsAfterClose": func(t *testing.T, c *httpLogCollector, url string) {
+			sender, err := newHTTPSender("test", HTTPLogOptions{URL: url})
+			require.NoError(t, err)
+			require.NoError(t, sender.Close())
+			require.NoError(t, sender.Close())
+
+			var handled error
+			require.NoError(t, sender.SetErrorHandler(func(err error, _ message.Composer) { handled = err }))
+			sender.Send(message.NewDefaultMessage(level.Info, "foo"))
+
+			assert.Error(t, handled)
+			assert.Empty(t, c.requests)
+		},
+		"ConfigureFailsWithoutURL": func(t *testing.T, c *httpLogCollector, url string) {
+			l := Logger{Type: LogHTTP, Options: LogOptions{Format: LogFormatPlain}}
+			sender, err := l.Configure()
+			assert.Error(t, err)
+			assert.Nil(t, sender)
+		},
+		// "": func(t *testing.T, c *httpLogCollector, url string) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			collector := &httpLogCollector{}
+			server := httptest.NewServer(collector)
+			defer server.Close()
+
+			test(t, collector, server.URL)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package jasper
+
+import (
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+// newJournaldSender returns a sender that writes to the systemd journal.
+func newJournaldSender(name string) (send.Sender, error) {
+	sender, err := send.NewSystemdLogger(name, send.LevelInfo{Default: level.Trace, Threshold: level.Trace})
+	return sender, errors.Wrap(err, "problem connecting to journald")
+}
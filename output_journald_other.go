@@ -0,0 +1,15 @@
+//go:build !linux
+// +build !linux
+
+package jasper
+
+import (
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+// newJournaldSender returns an error, because the systemd journal is only
+// available on Linux.
+func newJournaldSender(name string) (send.Sender, error) {
+	return nil, errors.New("journald logging is only supported on linux")
+}
@@ -0,0 +1,244 @@
+package jasper
+
+import (
+	"io"
+	"sync"
+
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+// OutputLimitPolicy is a type for representing what happens to the output
+// of a process once it exceeds the limit in OutputLimitOptions.
+type OutputLimitPolicy string
+
+const (
+	// OutputLimitKeepHead keeps the first bytes of output up to the
+	// limit, and discards the rest. It is the default policy.
+	OutputLimitKeepHead OutputLimitPolicy = "keep_head"
+	// OutputLimitKeepTail keeps the last bytes of output up to the
+	// limit. Because it cannot know which bytes are last until the
+	// process exits, no output is written until then.
+	OutputLimitKeepTail OutputLimitPolicy = "keep_tail"
+	// OutputLimitDrop discards the write that would exceed the limit
+	// and all output after it. If output is line-buffered, each write
+	// is a whole line, so no partial line is kept.
+	OutputLimitDrop OutputLimitPolicy = "drop"
+	// OutputLimitKill keeps the first bytes of output up to the limit,
+	// like OutputLimitKeepHead, and kills the process once the limit
+	// is exceeded.
+	OutputLimitKill OutputLimitPolicy = "kill"
+)
+
+// Validate ensures that the OutputLimitPolicy is valid.
+func (p OutputLimitPolicy) Validate() error {
+	switch p {
+	case "", OutputLimitKeepHead, OutputLimitKeepTail, OutputLimitDrop, OutputLimitKill:
+		return nil
+	default:
+		return errors.Errorf("unknown output limit policy '%s'", p)
+	}
+}
+
+// OutputLimitOptions limits the number of bytes of each stream of output
+// that a process writes to its writers and loggers. The limit applies
+// to each stream separately, unless one is redirected to the other, and
+// counts bytes after any line prefixes are added. Whether a stream was
+// truncated is reported in the process' ProcessInfo. A MaxBytes of 0
+// disables the limit.
+type OutputLimitOptions struct {
+	MaxBytes int64             `json:"max_bytes"`
+	Policy   OutputLimitPolicy `json:"policy,omitempty"`
+}
+
+// Validate ensures that the OutputLimitOptions is valid.
+func (opts OutputLimitOptions) Validate() error {
+	if opts.MaxBytes < 0 {
+		return errors.New("cannot have a negative output limit")
+	}
+	if opts.MaxBytes == 0 && opts.Policy != "" {
+		return errors.New("cannot specify an output limit policy without a limit")
+	}
+	return opts.Policy.Validate()
+}
+
+// makeLimiter returns a writer that enforces the limit on the output
+// written to w, or nil if there is no limit. kill is called, at most once,
+// if the policy is to kill the process.
+func (opts OutputLimitOptions) makeLimiter(w io.Writer, kill func()) *limitWriter {
+	if opts.MaxBytes == 0 {
+		return nil
+	}
+	policy := opts.Policy
+	if policy == "" {
+		policy = OutputLimitKeepHead
+	}
+	return &limitWriter{writer: w, limit: opts.MaxBytes, policy: policy, kill: kill}
+}
+
+// limitWriter enforces an OutputLimitOptions on the output written to the
+// underlying writer. It never returns an error for output that it
+// discards, so that the process is not interrupted.
+type limitWriter struct {
+	writer    io.Writer
+	limit     int64
+	policy    OutputLimitPolicy
+	kill      func()
+	written   int64
+	tail      []byte
+	truncated bool
+	mu        sync.Mutex
+}
+
+func (w *limitWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.policy == OutputLimitKeepTail {
+		w.tail = append(w.tail, p...)
+		if excess := int64(len(w.tail)) - w.limit; excess > 0 {
+			w.truncated = true
+			w.tail = append(w.tail[:0], w.tail[excess:]...)
+		}
+		return len(p), nil
+	}
+
+	remaining := w.limit - w.written
+	if int64(len(p)) <= remaining {
+		w.written += int64(len(p))
+		_, err := w.writer.Write(p)
+		return len(p), err
+	}
+
+	var err error
+	if remaining > 0 && w.policy != OutputLimitDrop {
+		_, err = w.writer.Write(p[:remaining])
+	}
+	w.written = w.limit
+
+	if !w.truncated && w.policy == OutputLimitKill && w.kill != nil {
+		w.kill()
+	}
+	w.truncated = true
+
+	return len(p), err
+}
+
+// flush writes the output that is held back by the OutputLimitKeepTail
+// policy.
+func (w *limitWriter) flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.tail) == 0 {
+		return nil
+	}
+
+	_, err := w.writer.Write(w.tail)
+	w.tail = nil
+
+	return err
+}
+
+func (w *limitWriter) isTruncated() bool {
+	if w == nil {
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.truncated
+}
+
+// inMemorySender is implemented by the senders of in-memory loggers.
+type inMemorySender interface {
+	send.Sender
+	Get() []message.Composer
+	GetString() ([]string, error)
+	Formatter(message.Composer) (string, error)
+	TotalBytesSent() int64
+}
+
+// cappedInMemorySender is an in-memory sender that holds at most
+// maxBytes bytes of messages and, if capacity is positive, at most
+// capacity messages, discarding the oldest messages first.
+type cappedInMemorySender struct {
+	*send.Base
+	capacity int
+	maxBytes int
+	size     int
+	sent     int64
+	buffer   []message.Composer
+	mu       sync.RWMutex
+}
+
+func newCappedInMemorySender(name string, info send.LevelInfo, capacity, maxBytes int) (send.Sender, error) {
+	if capacity < 0 || maxBytes <= 0 {
+		return nil, errors.New("invalid inmemory capacity")
+	}
+
+	s := &cappedInMemorySender{Base: send.NewBase(name), capacity: capacity, maxBytes: maxBytes}
+	if err := s.SetLevel(info); err != nil {
+		return nil, err
+	}
+	if err := s.SetFormatter(send.MakeDefaultFormatter()); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *cappedInMemorySender) Send(msg message.Composer) {
+	if !s.Level().ShouldLog(msg) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buffer = append(s.buffer, msg)
+	s.size += len(msg.String())
+	s.sent += int64(len(msg.String()))
+
+	drop := 0
+	for drop < len(s.buffer) && (s.size > s.maxBytes || (s.capacity > 0 && len(s.buffer)-drop > s.capacity)) {
+		s.size -= len(s.buffer[drop].String())
+		drop++
+	}
+	if drop > 0 {
+		s.buffer = append(s.buffer[:0], s.buffer[drop:]...)
+	}
+}
+
+// Get returns the messages in the buffer.
+func (s *cappedInMemorySender) Get() []message.Composer {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]message.Composer{}, s.buffer...)
+}
+
+// TotalBytesSent returns the total number of bytes sent, including the
+// bytes of messages that have since been discarded.
+func (s *cappedInMemorySender) TotalBytesSent() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.sent
+}
+
+// GetString returns the messages in the buffer as formatted strings.
+func (s *cappedInMemorySender) GetString() ([]string, error) {
+	msgs := s.Get()
+	strs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		str, err := s.Formatter(msg)
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
@@ -0,0 +1,177 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputLimitOptions(t *testing.T) {
+	for name, opts := range map[string]OutputLimitOptions{
+		"Disabled":       {},
+		"DefaultPolicy":  {MaxBytes: 1},
+		"KeepHeadPolicy": {MaxBytes: 1, Policy: OutputLimitKeepHead},
+		"KeepTailPolicy": {MaxBytes: 1, Policy: OutputLimitKeepTail},
+		"DropPolicy":     {MaxBytes: 1, Policy: OutputLimitDrop},
+		"KillPolicy":     {MaxBytes: 1, Policy: OutputLimitKill},
+	} {
+		t.Run("Valid"+name, func(t *testing.T) {
+			assert.NoError(t, opts.Validate())
+		})
+	}
+
+	for name, opts := range map[string]OutputLimitOptions{
+		"NegativeLimit":      {MaxBytes: -1},
+		"PolicyWithoutLimit": {Policy: OutputLimitDrop},
+		"UnknownPolicy":      {MaxBytes: 1, Policy: "foo"},
+	} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			assert.Error(t, opts.Validate())
+		})
+	}
+}
+
+func TestLimitWriter(t *testing.T) {
+	for name, test := range map[string]struct {
+		policy    OutputLimitPolicy
+		expected  string
+		truncated bool
+		killed    bool
+	}{
+		"KeepHeadWithinLimit":   {policy: OutputLimitKeepHead, expected: "foo", truncated: false},
+		"KeepHeadOverLimit":     {policy: OutputLimitKeepHead, expected: "foobarbaz", truncated: true},
+		"KeepTailOverLimit":     {policy: OutputLimitKeepTail, expected: "azquxquux", truncated: true},
+		"DropOverLimit":         {policy: OutputLimitDrop, expected: "foobar", truncated: true},
+		"KillOverLimit":         {policy: OutputLimitKill, expected: "foobarbaz", truncated: true, killed: true},
+		"DefaultPolicyKeepHead": {policy: "", expected: "foobarbaz", truncated: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			chunks := []string{"foo", "bar", "bazqux", "quux"}
+			if !test.truncated {
+				chunks = chunks[:1]
+			}
+
+			buf := &bytes.Buffer{}
+			kills := 0
+			w := OutputLimitOptions{MaxBytes: 9, Policy: test.policy}.makeLimiter(buf, func() { kills++ })
+			require.NotNil(t, w)
+			for _, chunk := range chunks {
+				n, err := w.Write([]byte(chunk))
+				require.NoError(t, err)
+				assert.Equal(t, len(chunk), n)
+			}
+			if test.policy == OutputLimitKeepTail {
+				assert.Empty(t, buf.String(), "the tail should be held until the output is flushed")
+			}
+			require.NoError(t, w.flush())
+
+			assert.Equal(t, test.expected, buf.String())
+			assert.Equal(t, test.truncated, w.isTruncated())
+			if test.killed {
+				assert.Equal(t, 1, kills)
+			} else {
+				assert.Zero(t, kills)
+			}
+		})
+	}
+	t.Run("NoLimit", func(t *testing.T) {
+		assert.Nil(t, OutputLimitOptions{}.makeLimiter(&bytes.Buffer{}, nil))
+		var w *limitWriter
+		assert.False(t, w.isTruncated())
+	})
+}
+
+func TestCappedInMemorySender(t *testing.T) {
+	_, err := newCappedInMemorySender("test", send.LevelInfo{Default: level.Info, Threshold: level.Info}, 0, 0)
+	assert.Error(t, err)
+
+	sender, err := newCappedInMemorySender("test", send.LevelInfo{Default: level.Info, Threshold: level.Info}, 3, 8)
+	require.NoError(t, err)
+	capped, ok := sender.(inMemorySender)
+	require.True(t, ok)
+
+	for _, msg := range []string{"foo", "bar", "baz"} {
+		sender.Send(message.NewDefaultMessage(level.Info, msg))
+	}
+	logs, err := capped.GetString()
+	require.NoError(t, err)
+	assert.Len(t, logs, 2, "oldest message should be discarded to stay within the byte limit")
+	assert.Contains(t, logs[0], "bar")
+	assert.Contains(t, logs[1], "baz")
+
+	sender.Send(message.NewDefaultMessage(level.Debug, "ignored"))
+	for _, msg := range []string{"a", "b", "c"} {
+		sender.Send(message.NewDefaultMessage(level.Info, msg))
+	}
+	assert.Len(t, capped.Get(), 3, "oldest messages should be discarded to stay within the capacity")
+	assert.EqualValues(t, 12, capped.TotalBytesSent())
+}
+
+func TestProcessOutputLimits(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a shell script")
+	}
+
+	for pname, makeProc := range map[string]processConstructor{
+		"Basic":    newBasicProcess,
+		"Blocking": newBlockingProcess,
+	} {
+		t.Run(pname, func(t *testing.T) {
+			for name, test := range map[string]func(context.Context, *testing.T, processConstructor){
+				"TruncationIsReportedInInfo": func(ctx context.Context, t *testing.T, makeProc processConstructor) {
+					stdout := &bytes.Buffer{}
+					stderr := &bytes.Buffer{}
+					opts := &CreateOptions{Args: []string{"sh", "-c", "echo foobar; echo baz >&2"}}
+					opts.Output.Output = stdout
+					opts.Output.Error = stderr
+					opts.Output.Limits = OutputLimitOptions{MaxBytes: 4, Policy: OutputLimitKeepTail}
+
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					info := proc.Info(ctx)
+					assert.True(t, info.OutputTruncated)
+					assert.False(t, info.ErrorTruncated)
+					assert.Equal(t, "bar\n", stdout.String())
+					assert.Equal(t, "baz\n", stderr.String())
+				},
+				"KillPolicyStopsProcess": func(ctx context.Context, t *testing.T, makeProc processConstructor) {
+					stdout := &bytes.Buffer{}
+					opts := &CreateOptions{Args: []string{"sh", "-c", "while true; do echo foo; done"}}
+					opts.Output.Output = stdout
+					opts.Output.Limits = OutputLimitOptions{MaxBytes: 1024, Policy: OutputLimitKill}
+
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.Error(t, err)
+					require.NoError(t, ctx.Err(), "process should be killed before the test times out")
+
+					info := proc.Info(ctx)
+					assert.True(t, info.Complete)
+					assert.False(t, info.Successful)
+					assert.True(t, info.OutputTruncated)
+					assert.Equal(t, strings.Repeat("foo\n", 256), stdout.String())
+				},
+				// "": func(ctx context.Context, t *testing.T, makeProc processConstructor) {},
+			} {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+					defer cancel()
+
+					test(ctx, t, makeProc)
+				})
+			}
+		})
+	}
+}
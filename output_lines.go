@@ -0,0 +1,178 @@
+package jasper
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultLineTimestampFormat is the format of the timestamp in line
+// prefixes if LineBufferOptions does not specify one.
+const DefaultLineTimestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// maxLineBuffer is the largest partial line that is held back from a
+// writer while waiting for the rest of the line.
+const maxLineBuffer = 64 * 1024
+
+// LineBufferOptions configures the output of a process to be written a
+// line at a time, so that the output of processes that share a writer or
+// logger (e.g. LogInherit or LogDefault) does not interleave mid-line.
+// Each stream is buffered separately, and lines longer than 64KiB are
+// written in parts.
+//
+// If Prefix is set, it is a template, expanded with LinePrefixData, that
+// is written before every line (e.g. "[{{.ID}} {{.Stream}}] "). Prefixes
+// are added before the output reaches any writer or logger, so loggers
+// with a line parser parse the prefixed line.
+type LineBufferOptions struct {
+	Enabled         bool   `json:"enabled"`
+	Prefix          string `json:"prefix,omitempty"`
+	TimestampFormat string `json:"timestamp_format,omitempty"`
+}
+
+// LinePrefixData is the data available to the template in the Prefix of
+// LineBufferOptions. ID, Tag, and Tags describe the process as in
+// LogFileNameData, Stream is the stream that the line was written to
+// (after any redirection between the streams), and Timestamp is the time
+// at which the line was written, formatted with TimestampFormat
+// (DefaultLineTimestampFormat by default).
+type LinePrefixData struct {
+	ID        string
+	Tag       string
+	Tags      []string
+	Stream    LogStream
+	Timestamp string
+}
+
+// Validate ensures that the LineBufferOptions is valid.
+func (opts LineBufferOptions) Validate() error {
+	if !opts.Enabled {
+		if opts.Prefix != "" || opts.TimestampFormat != "" {
+			return errors.New("cannot specify a line prefix or timestamp format without enabling line buffering")
+		}
+		return nil
+	}
+
+	if opts.Prefix == "" {
+		return nil
+	}
+	tmpl, err := parseLinePrefix(opts.Prefix)
+	if err != nil {
+		return err
+	}
+	return errors.Wrapf(tmpl.Execute(ioutil.Discard, LinePrefixData{}), "invalid line prefix template '%s'", opts.Prefix)
+}
+
+func parseLinePrefix(prefix string) (*template.Template, error) {
+	tmpl, err := template.New("line_prefix").Option("missingkey=error").Parse(prefix)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid line prefix template '%s'", prefix)
+	}
+	return tmpl, nil
+}
+
+// makePrefixer returns a lineFormatter that adds the prefix to each line
+// written to the given stream, or nil if there is no prefix.
+func (opts LineBufferOptions) makePrefixer(data LogFileNameData, stream LogStream) (lineFormatter, error) {
+	if opts.Prefix == "" {
+		return nil, nil
+	}
+	tmpl, err := parseLinePrefix(opts.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	format := opts.TimestampFormat
+	if format == "" {
+		format = DefaultLineTimestampFormat
+	}
+
+	prefixData := LinePrefixData{
+		ID:     data.ID,
+		Tag:    data.Tag,
+		Tags:   data.Tags,
+		Stream: stream,
+	}
+	return func(line []byte, start bool) []byte {
+		if !start {
+			return line
+		}
+		prefixData.Timestamp = time.Now().Format(format)
+		buf := &bytes.Buffer{}
+		if err := tmpl.Execute(buf, prefixData); err != nil {
+			return line
+		}
+		return append(buf.Bytes(), line...)
+	}, nil
+}
+
+// lineFormatter transforms a line of output, including its trailing
+// newline, if any, before it is written. start is false if the line is
+// the continuation of a line that was too long to buffer.
+type lineFormatter func(line []byte, start bool) []byte
+
+// lineWriter buffers its input into lines and formats each line before
+// writing it to the underlying writer.
+type lineWriter struct {
+	writer     io.Writer
+	formatters []lineFormatter
+	buffer     []byte
+	partial    bool
+	mu         sync.Mutex
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buffer = append(w.buffer, p...)
+
+	out := []byte{}
+	for {
+		end := bytes.IndexByte(w.buffer, '\n') + 1
+		if end == 0 {
+			if len(w.buffer) <= maxLineBuffer {
+				break
+			}
+			end = len(w.buffer)
+		}
+
+		out = append(out, w.format(w.buffer[:end])...)
+		w.buffer = w.buffer[end:]
+	}
+	w.buffer = append([]byte{}, w.buffer...)
+
+	if len(out) == 0 {
+		return len(p), nil
+	}
+	_, err := w.writer.Write(out)
+	return len(p), err
+}
+
+func (w *lineWriter) format(line []byte) []byte {
+	start := !w.partial
+	w.partial = line[len(line)-1] != '\n'
+	for _, formatter := range w.formatters {
+		line = formatter(line, start)
+	}
+	return line
+}
+
+// flush writes any partial line that is held back.
+func (w *lineWriter) flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.buffer) == 0 {
+		return nil
+	}
+
+	_, err := w.writer.Write(w.format(w.buffer))
+	w.buffer = w.buffer[:0]
+
+	return err
+}
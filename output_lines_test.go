@@ -0,0 +1,116 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineBufferOptions(t *testing.T) {
+	for name, opts := range map[string]LineBufferOptions{
+		"Disabled":        {},
+		"Enabled":         {Enabled: true},
+		"Prefix":          {Enabled: true, Prefix: "[{{.ID}} {{.Tag}} {{.Stream}} {{.Timestamp}}] "},
+		"TimestampFormat": {Enabled: true, Prefix: "{{.Timestamp}} ", TimestampFormat: time.Kitchen},
+	} {
+		t.Run("Valid"+name, func(t *testing.T) {
+			assert.NoError(t, opts.Validate())
+		})
+	}
+
+	for name, opts := range map[string]LineBufferOptions{
+		"PrefixWithoutEnabled": {Prefix: "foo"},
+		"FormatWithoutEnabled": {TimestampFormat: time.Kitchen},
+		"PrefixSyntax":         {Enabled: true, Prefix: "{{.ID"},
+		"PrefixField":          {Enabled: true, Prefix: "{{.Foo}}"},
+	} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			assert.Error(t, opts.Validate())
+		})
+	}
+}
+
+type writeRecorder struct {
+	writes []string
+}
+
+func (r *writeRecorder) Write(p []byte) (int, error) {
+	r.writes = append(r.writes, string(p))
+	return len(p), nil
+}
+
+func TestLineWriter(t *testing.T) {
+	quote := func(line []byte, start bool) []byte {
+		if !start {
+			return line
+		}
+		return append([]byte("> "), line...)
+	}
+
+	t.Run("WritesWholeLines", func(t *testing.T) {
+		rec := &writeRecorder{}
+		w := &lineWriter{writer: rec, formatters: []lineFormatter{quote}}
+		for _, chunk := range []string{"fo", "o\nba", "r\nbaz\nqu"} {
+			n, err := w.Write([]byte(chunk))
+			require.NoError(t, err)
+			assert.Equal(t, len(chunk), n)
+		}
+		assert.Equal(t, []string{"> foo\n", "> bar\n> baz\n"}, rec.writes)
+
+		require.NoError(t, w.flush())
+		assert.Equal(t, "> qu", rec.writes[2])
+		require.NoError(t, w.flush())
+		assert.Len(t, rec.writes, 3)
+	})
+	t.Run("LongLinesAreFormattedOnce", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w := &lineWriter{writer: buf, formatters: []lineFormatter{quote}}
+		long := strings.Repeat("x", maxLineBuffer+1)
+		_, err := w.Write([]byte(long))
+		require.NoError(t, err)
+		_, err = w.Write([]byte("y\nz\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "> "+long+"y\n> z\n", buf.String())
+	})
+}
+
+func TestLinePrefixes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a shell script")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+	defer cancel()
+
+	opts := &CreateOptions{
+		Args: []string{"sh", "-c", "printf 'foo\\nbar'; echo baz >&2"},
+		Tags: []string{"tag"},
+	}
+	opts.Output.LineBuffer = LineBufferOptions{Enabled: true, Prefix: "[{{.ID}} {{.Tag}} {{.Stream}}] "}
+	opts.Output.Loggers = []Logger{{
+		Type:    LogInMemory,
+		Options: LogOptions{Format: LogFormatPlain, InMemoryCap: 100},
+	}}
+	require.NoError(t, opts.Validate())
+
+	proc, err := newBasicProcess(ctx, opts)
+	require.NoError(t, err)
+	_, err = proc.Wait(ctx)
+	require.NoError(t, err)
+
+	sender, ok := opts.Output.Loggers[0].sender.(*send.InMemorySender)
+	require.True(t, ok)
+	logs, err := sender.GetString()
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	assert.Contains(t, logs, "["+proc.ID()+" tag output] foo")
+	assert.Contains(t, logs, "["+proc.ID()+" tag output] bar")
+	assert.Contains(t, logs, "["+proc.ID()+" tag error] baz")
+}
@@ -0,0 +1,185 @@
+package jasper
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// LineParserType is a type for representing the ways in which a Logger can
+// parse the lines of a process' output into structured fields.
+type LineParserType string
+
+const (
+	// LineParserNone leaves lines as unstructured text.
+	LineParserNone LineParserType = ""
+	// LineParserJSON parses each line as a JSON object.
+	LineParserJSON LineParserType = "json"
+	// LineParserLogfmt parses each line as logfmt-style key=value
+	// pairs.
+	LineParserLogfmt LineParserType = "logfmt"
+	// LineParserRegex parses each line with a regular expression, whose
+	// named groups become the fields.
+	LineParserRegex LineParserType = "regex"
+)
+
+// Validate ensures that the LineParserType is valid.
+func (t LineParserType) Validate() error {
+	switch t {
+	case LineParserNone, LineParserJSON, LineParserLogfmt, LineParserRegex:
+		return nil
+	default:
+		return errors.Errorf("unknown line parser type '%s'", t)
+	}
+}
+
+// LineParserOptions configures how a Logger parses the lines of a
+// process' output into structured fields before sending them. Parsed
+// lines keep their text, so formatters that only use the text of a
+// message are unaffected, but senders that log structured data (e.g.
+// the JSON format, Splunk, or in-memory log queries) receive the
+// fields. Lines that cannot be parsed are sent as unstructured text.
+//
+// Pattern is the regular expression for LineParserRegex, which must
+// have at least one named group.
+type LineParserOptions struct {
+	Type    LineParserType `json:"type,omitempty"`
+	Pattern string         `json:"pattern,omitempty"`
+}
+
+// Validate ensures that the LineParserOptions is valid.
+func (opts LineParserOptions) Validate() error {
+	_, err := opts.makeParser()
+	return err
+}
+
+// lineParser parses a line of output into fields.
+type lineParser func(string) (message.Fields, error)
+
+// makeParser returns the parser described by the options, or nil if lines
+// should not be parsed.
+func (opts LineParserOptions) makeParser() (lineParser, error) {
+	if err := opts.Type.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Type != LineParserRegex && opts.Pattern != "" {
+		return nil, errors.Errorf("cannot specify a pattern for line parser type '%s'", opts.Type)
+	}
+
+	switch opts.Type {
+	case LineParserJSON:
+		return parseJSONLine, nil
+	case LineParserLogfmt:
+		return parseLogfmtLine, nil
+	case LineParserRegex:
+		return makeRegexLineParser(opts.Pattern)
+	default:
+		return nil, nil
+	}
+}
+
+func parseJSONLine(line string) (message.Fields, error) {
+	fields := message.Fields{}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return nil, errors.Wrap(err, "line is not a JSON object")
+	}
+	return fields, nil
+}
+
+// parseLogfmtLine parses space-separated key=value pairs, where values
+// may be double-quoted. Keys without a value are set to true.
+func parseLogfmtLine(line string) (message.Fields, error) {
+	fields := message.Fields{}
+
+	for line = strings.TrimSpace(line); line != ""; line = strings.TrimLeftFunc(line, unicode.IsSpace) {
+		end := strings.IndexFunc(line, func(r rune) bool { return r == '=' || unicode.IsSpace(r) })
+		if end == 0 {
+			return nil, errors.New("missing key in logfmt line")
+		}
+		if end < 0 {
+			end = len(line)
+		}
+		key := line[:end]
+		line = line[end:]
+
+		if !strings.HasPrefix(line, "=") {
+			fields[key] = true
+			continue
+		}
+		line = line[1:]
+
+		if strings.HasPrefix(line, `"`) {
+			quoted, err := strconv.QuotedPrefix(line)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid quoted value for key '%s'", key)
+			}
+			value, err := strconv.Unquote(quoted)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid quoted value for key '%s'", key)
+			}
+			fields[key] = value
+			line = line[len(quoted):]
+			continue
+		}
+
+		end = strings.IndexFunc(line, unicode.IsSpace)
+		if end < 0 {
+			end = len(line)
+		}
+		fields[key] = line[:end]
+		line = line[end:]
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("no fields in logfmt line")
+	}
+
+	return fields, nil
+}
+
+func makeRegexLineParser(pattern string) (lineParser, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid line parser pattern '%s'", pattern)
+	}
+
+	named := false
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			named = true
+			break
+		}
+	}
+	if !named {
+		return nil, errors.Errorf("line parser pattern '%s' has no named groups", pattern)
+	}
+
+	return func(line string) (message.Fields, error) {
+		match := re.FindStringSubmatch(line)
+		if match == nil {
+			return nil, errors.New("line does not match pattern")
+		}
+
+		fields := message.Fields{}
+		for idx, name := range re.SubexpNames() {
+			if name != "" {
+				fields[name] = match[idx]
+			}
+		}
+		return fields, nil
+	}, nil
+}
+
+// parsedMessage is a line of output that was parsed into fields. Its
+// string form is the original line, and its raw form is the fields.
+type parsedMessage struct {
+	message.Composer
+	fields message.Fields
+}
+
+func (m *parsedMessage) Raw() interface{} { return m.fields }
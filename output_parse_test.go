@@ -0,0 +1,118 @@
+package jasper
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineParserOptions(t *testing.T) {
+	for name, opts := range map[string]LineParserOptions{
+		"None":   {},
+		"JSON":   {Type: LineParserJSON},
+		"Logfmt": {Type: LineParserLogfmt},
+		"Regex":  {Type: LineParserRegex, Pattern: `^(?P<level>\w+): (?P<msg>.*)$`},
+	} {
+		t.Run("Valid"+name, func(t *testing.T) {
+			assert.NoError(t, opts.Validate())
+		})
+	}
+
+	for name, opts := range map[string]LineParserOptions{
+		"Type":                {Type: LineParserType("foo")},
+		"RegexPattern":        {Type: LineParserRegex, Pattern: "("},
+		"RegexWithoutGroups":  {Type: LineParserRegex, Pattern: `^\w+$`},
+		"PatternWithoutRegex": {Type: LineParserJSON, Pattern: `^(?P<foo>\w+)$`},
+	} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			assert.Error(t, opts.Validate())
+		})
+	}
+}
+
+func TestLineParsers(t *testing.T) {
+	for name, test := range map[string]struct {
+		opts     LineParserOptions
+		line     string
+		expected message.Fields
+	}{
+		"JSON": {
+			opts:     LineParserOptions{Type: LineParserJSON},
+			line:     `{"t": {"$date": "2018-01-01"}, "s": "I", "msg": "Waiting for connections", "attr": {"port": 27017}}`,
+			expected: message.Fields{"t": map[string]interface{}{"$date": "2018-01-01"}, "s": "I", "msg": "Waiting for connections", "attr": map[string]interface{}{"port": 27017.0}},
+		},
+		"JSONArray":    {opts: LineParserOptions{Type: LineParserJSON}, line: `[1, 2]`},
+		"JSONText":     {opts: LineParserOptions{Type: LineParserJSON}, line: "hello world"},
+		"Logfmt":       {opts: LineParserOptions{Type: LineParserLogfmt}, line: `level=info msg="hello \"world\"" port=27017 ready`, expected: message.Fields{"level": "info", "msg": `hello "world"`, "port": "27017", "ready": true}},
+		"LogfmtEmpty":  {opts: LineParserOptions{Type: LineParserLogfmt}, line: `  key=  other=""`, expected: message.Fields{"key": "", "other": ""}},
+		"LogfmtQuote":  {opts: LineParserOptions{Type: LineParserLogfmt}, line: `msg="unterminated`},
+		"LogfmtNoKey":  {opts: LineParserOptions{Type: LineParserLogfmt}, line: `=value`},
+		"LogfmtBlank":  {opts: LineParserOptions{Type: LineParserLogfmt}, line: "   "},
+		"Regex":        {opts: LineParserOptions{Type: LineParserRegex, Pattern: `^(?P<level>\w+): (?P<msg>.*)$`}, line: "WARN: disk is full", expected: message.Fields{"level": "WARN", "msg": "disk is full"}},
+		"RegexNoMatch": {opts: LineParserOptions{Type: LineParserRegex, Pattern: `^(?P<level>\w+): (?P<msg>.*)$`}, line: "disk is full"},
+		// "": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			parser, err := test.opts.makeParser()
+			require.NoError(t, err)
+			require.NotNil(t, parser)
+
+			fields, err := parser(test.line)
+			if test.expected == nil {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, fields)
+		})
+	}
+}
+
+func TestParsedOutput(t *testing.T) {
+	lines := `{"s": "I", "msg": "foo"}` + "\n" + "not json\n"
+	makeLogger := func(format LogFormat) Logger {
+		return Logger{
+			Type: LogInMemory,
+			Options: LogOptions{
+				Format:      format,
+				InMemoryCap: 10,
+				LineParser:  LineParserOptions{Type: LineParserJSON},
+			},
+		}
+	}
+
+	opts := OutputOptions{Loggers: []Logger{makeLogger(LogFormatPlain), makeLogger(LogFormatJSON)}}
+	require.NoError(t, opts.Validate())
+	out, err := opts.GetOutput()
+	require.NoError(t, err)
+	_, err = out.Write([]byte(lines))
+	require.NoError(t, err)
+	require.NoError(t, opts.outputSender.Close())
+
+	t.Run("TextIsPreserved", func(t *testing.T) {
+		sender, ok := opts.Loggers[0].sender.(*send.InMemorySender)
+		require.True(t, ok)
+		logs, err := sender.GetString()
+		require.NoError(t, err)
+		assert.Equal(t, []string{`{"s": "I", "msg": "foo"}`, "not json"}, logs)
+	})
+	t.Run("FieldsAreSent", func(t *testing.T) {
+		sender, ok := opts.Loggers[1].sender.(*send.InMemorySender)
+		require.True(t, ok)
+		msgs := sender.Get()
+		require.Len(t, msgs, 2)
+		assert.Equal(t, message.Fields{"s": "I", "msg": "foo"}, msgs[0].Raw())
+		assert.NotEqual(t, message.Fields{"s": "I", "msg": "foo"}, msgs[1].Raw())
+
+		logs, err := sender.GetString()
+		require.NoError(t, err)
+		fields := map[string]interface{}{}
+		require.NoError(t, json.NewDecoder(bytes.NewBufferString(logs[0])).Decode(&fields))
+		assert.Equal(t, map[string]interface{}{"s": "I", "msg": "foo"}, fields)
+	})
+}
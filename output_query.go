@@ -0,0 +1,130 @@
+package jasper
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// LogRecord is a line logged by one of the in-memory loggers of a
+// process. Logger is the index of the logger in the process'
+// OutputOptions.Loggers, and Fields holds the structured fields parsed
+// from the line, if the logger has a line parser.
+type LogRecord struct {
+	Timestamp time.Time      `json:"timestamp"`
+	Stream    LogStream      `json:"stream"`
+	Logger    int            `json:"logger"`
+	Line      string         `json:"line"`
+	Fields    message.Fields `json:"fields,omitempty"`
+}
+
+// LogQuery selects lines from the in-memory loggers of a process. Lines
+// are filtered by stream, time, and pattern first; Tail then keeps only
+// the last lines that remain, and Offset and Limit page through the
+// result. Zero values disable the corresponding filter.
+type LogQuery struct {
+	Stream  LogStream `json:"stream,omitempty"`
+	Since   time.Time `json:"since,omitempty"`
+	Pattern string    `json:"pattern,omitempty"`
+	Tail    int       `json:"tail,omitempty"`
+	Offset  int       `json:"offset,omitempty"`
+	Limit   int       `json:"limit,omitempty"`
+}
+
+// Validate ensures that the LogQuery is valid.
+func (q LogQuery) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	if q.Stream != "" {
+		catcher.Add(q.Stream.Validate())
+	}
+	if q.Tail < 0 || q.Offset < 0 || q.Limit < 0 {
+		catcher.Add(errors.New("cannot have negative tail, offset, or limit"))
+	}
+	if _, err := regexp.Compile(q.Pattern); err != nil {
+		catcher.Add(errors.Wrapf(err, "invalid pattern '%s'", q.Pattern))
+	}
+	return catcher.Resolve()
+}
+
+// streamMessage records the stream that a message was logged from and
+// when it was logged.
+type streamMessage struct {
+	message.Composer
+	stream LogStream
+	time   time.Time
+}
+
+// QueryInMemoryLogs returns the lines from all of the in-memory loggers of
+// the given process that match the query, ordered by the time at which
+// they were logged. It errors if the process has no in-memory loggers.
+func QueryInMemoryLogs(ctx context.Context, proc Process, q LogQuery) ([]LogRecord, error) {
+	if err := q.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid log query")
+	}
+	pattern := regexp.MustCompile(q.Pattern)
+
+	info := getProcInfoNoHang(ctx, proc)
+	found := false
+	records := []LogRecord{}
+	for idx, logger := range info.Options.Output.Loggers {
+		sender, ok := logger.sender.(inMemorySender)
+		if !ok {
+			continue
+		}
+		found = true
+
+		for _, msg := range sender.Get() {
+			line, err := sender.Formatter(msg)
+			if err != nil {
+				return nil, errors.Wrap(err, "problem formatting log line")
+			}
+
+			record := LogRecord{Logger: idx, Line: line}
+			if sm, ok := msg.(*streamMessage); ok {
+				record.Stream = sm.stream
+				record.Timestamp = sm.time
+				if pm, ok := sm.Composer.(*parsedMessage); ok {
+					record.Fields = pm.fields
+				}
+			}
+
+			if q.Stream != "" && record.Stream != q.Stream {
+				continue
+			}
+			if !q.Since.IsZero() && record.Timestamp.Before(q.Since) {
+				continue
+			}
+			if !pattern.MatchString(record.Line) {
+				continue
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	if !found {
+		return nil, errors.Errorf("no in-memory logger found for process '%s'", proc.ID())
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	if q.Tail > 0 && len(records) > q.Tail {
+		records = records[len(records)-q.Tail:]
+	}
+	if q.Offset >= len(records) {
+		return []LogRecord{}, nil
+	}
+	records = records[q.Offset:]
+	if q.Limit > 0 && len(records) > q.Limit {
+		records = records[:q.Limit]
+	}
+
+	return records, nil
+}
@@ -0,0 +1,113 @@
+package jasper
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lines(records []LogRecord) []string {
+	out := make([]string, 0, len(records))
+	for _, record := range records {
+		out = append(out, record.Line)
+	}
+	return out
+}
+
+func TestQueryInMemoryLogs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a shell script")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+	defer cancel()
+
+	opts := &CreateOptions{
+		Args: []string{"sh", "-c", "for i in 1 2 3 4 5; do echo out$i; echo err$i >&2; done"},
+		Output: OutputOptions{Loggers: []Logger{
+			{
+				Type:    LogInMemory,
+				Options: LogOptions{Format: LogFormatPlain, InMemoryCap: 100},
+				Streams: []LogStreamOptions{{Stream: LogStreamOutput}},
+			},
+			{
+				Type:    LogInMemory,
+				Options: LogOptions{Format: LogFormatPlain, InMemoryCap: 100},
+				Streams: []LogStreamOptions{{Stream: LogStreamError}},
+			},
+		}},
+	}
+	start := time.Now()
+	proc, err := newBasicProcess(ctx, opts)
+	require.NoError(t, err)
+	_, err = proc.Wait(ctx)
+	require.NoError(t, err)
+
+	t.Run("RecordsIncludeStreamAndTime", func(t *testing.T) {
+		records, err := QueryInMemoryLogs(ctx, proc, LogQuery{})
+		require.NoError(t, err)
+		require.Len(t, records, 10)
+		for idx, record := range records {
+			assert.False(t, record.Timestamp.Before(start))
+			if idx > 0 {
+				assert.False(t, record.Timestamp.Before(records[idx-1].Timestamp))
+			}
+			switch record.Stream {
+			case LogStreamOutput:
+				assert.Equal(t, 0, record.Logger)
+				assert.Contains(t, record.Line, "out")
+			case LogStreamError:
+				assert.Equal(t, 1, record.Logger)
+				assert.Contains(t, record.Line, "err")
+			default:
+				assert.Fail(t, "unexpected stream", "%s", record.Stream)
+			}
+		}
+	})
+
+	for name, test := range map[string]struct {
+		query    LogQuery
+		expected []string
+	}{
+		"Stream":            {LogQuery{Stream: LogStreamOutput}, []string{"out1", "out2", "out3", "out4", "out5"}},
+		"Pattern":           {LogQuery{Pattern: "^err[24]$"}, []string{"err2", "err4"}},
+		"Tail":              {LogQuery{Stream: LogStreamError, Tail: 2}, []string{"err4", "err5"}},
+		"OffsetAndLimit":    {LogQuery{Stream: LogStreamOutput, Offset: 1, Limit: 2}, []string{"out2", "out3"}},
+		"TailOffsetLimit":   {LogQuery{Stream: LogStreamOutput, Tail: 3, Offset: 1, Limit: 1}, []string{"out4"}},
+		"OffsetPastEnd":     {LogQuery{Offset: 20}, []string{}},
+		"SinceExcludesPast": {LogQuery{Since: time.Now().Add(time.Hour)}, []string{}},
+		"SinceIncludesNew":  {LogQuery{Stream: LogStreamError, Since: start, Limit: 1}, []string{"err1"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			records, err := QueryInMemoryLogs(ctx, proc, test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, lines(records))
+		})
+	}
+
+	for name, query := range map[string]LogQuery{
+		"InvalidStream":  {Stream: LogStream("foo")},
+		"InvalidPattern": {Pattern: "("},
+		"NegativeLimit":  {Limit: -1},
+		"NegativeTail":   {Tail: -1},
+	} {
+		t.Run("Fails"+name, func(t *testing.T) {
+			_, err := QueryInMemoryLogs(ctx, proc, query)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("FailsWithoutInMemoryLogger", func(t *testing.T) {
+		proc, err := newBasicProcess(ctx, trueCreateOpts())
+		require.NoError(t, err)
+		_, err = proc.Wait(ctx)
+		require.NoError(t, err)
+
+		_, err = QueryInMemoryLogs(ctx, proc, LogQuery{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,246 @@
+package jasper
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// RedactedValue replaces text that is redacted from the output or the
+// information of a process.
+const RedactedValue = "[REDACTED]"
+
+// RedactionOptions describes text to remove from the output of a process
+// before it reaches any writer or logger. Literals are removed verbatim,
+// Patterns are regular expressions whose matches are removed, and the
+// values of the environment variables named in EnvVars are removed,
+// whether they are set in the process' options or inherited.
+//
+// Output is redacted a line at a time, so a secret that spans lines, or
+// a line longer than 64KiB, may not be redacted. The arguments and the
+// environment of a process reported by the REST and GRPC services, and in
+// its artifacts, are redacted as well, and the credentials of its loggers
+// are removed.
+type RedactionOptions struct {
+	Literals []string `json:"literals,omitempty"`
+	Patterns []string `json:"patterns,omitempty"`
+	EnvVars  []string `json:"env_vars,omitempty"`
+}
+
+// Validate ensures that the RedactionOptions is valid.
+func (opts RedactionOptions) Validate() error {
+	_, err := opts.makeRedactor(nil)
+	return err
+}
+
+// redactor removes secrets from strings.
+type redactor struct {
+	literals []string
+	patterns []*regexp.Regexp
+}
+
+// makeRedactor returns a redactor for the options, using lookupEnv, if it
+// is not nil, to find the values of the environment variables to redact.
+// It returns nil if there is nothing to redact.
+func (opts RedactionOptions) makeRedactor(lookupEnv func(string) (string, bool)) (*redactor, error) {
+	catcher := grip.NewBasicCatcher()
+	r := &redactor{}
+
+	for _, literal := range opts.Literals {
+		if literal == "" {
+			catcher.Add(errors.New("cannot redact an empty literal"))
+			continue
+		}
+		r.literals = append(r.literals, literal)
+	}
+	for _, pattern := range opts.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			catcher.Add(errors.Wrapf(err, "invalid redaction pattern '%s'", pattern))
+			continue
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	for _, name := range opts.EnvVars {
+		if name == "" {
+			catcher.Add(errors.New("cannot redact an unnamed environment variable"))
+			continue
+		}
+		if lookupEnv == nil {
+			continue
+		}
+		if value, ok := lookupEnv(name); ok && value != "" {
+			r.literals = append(r.literals, value)
+		}
+	}
+
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+	if len(r.literals) == 0 && len(r.patterns) == 0 {
+		return nil, nil
+	}
+
+	// Redact longer literals first, so that a literal that contains
+	// another is removed entirely.
+	sort.SliceStable(r.literals, func(i, j int) bool {
+		return len(r.literals[i]) > len(r.literals[j])
+	})
+
+	return r, nil
+}
+
+func (r *redactor) redact(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, literal := range r.literals {
+		s = strings.Replace(s, literal, RedactedValue, -1)
+	}
+	for _, re := range r.patterns {
+		s = re.ReplaceAllLiteralString(s, RedactedValue)
+	}
+	return s
+}
+
+// format is a lineFormatter that redacts the line.
+func (r *redactor) format(line []byte, _ bool) []byte {
+	return []byte(r.redact(string(line)))
+}
+
+// redacted returns a copy of the options, and of the options of its
+// triggers, in which the arguments, the values of the environment, the
+// literals to redact, and the credentials of the loggers are themselves
+// redacted.
+func (opts CreateOptions) redacted() CreateOptions {
+	redactEnvVars := map[string]bool{}
+	for _, name := range opts.Output.Redaction.EnvVars {
+		redactEnvVars[name] = true
+	}
+	r, err := opts.Output.Redaction.makeRedactor(opts.lookupEnv)
+
+	if len(opts.Args) > 0 {
+		args := make([]string, len(opts.Args))
+		for idx, arg := range opts.Args {
+			if err != nil {
+				args[idx] = RedactedValue
+				continue
+			}
+			args[idx] = r.redact(arg)
+		}
+		opts.Args = args
+	}
+
+	if len(opts.Environment) > 0 {
+		env := make(map[string]string, len(opts.Environment))
+		for key, value := range opts.Environment {
+			switch {
+			case err != nil, redactEnvVars[key]:
+				env[key] = RedactedValue
+			default:
+				env[key] = r.redact(value)
+			}
+		}
+		opts.Environment = env
+	}
+
+	if len(opts.Output.Redaction.Literals) > 0 {
+		literals := make([]string, len(opts.Output.Redaction.Literals))
+		for idx := range literals {
+			literals[idx] = RedactedValue
+		}
+		opts.Output.Redaction.Literals = literals
+	}
+
+	if len(opts.Output.Loggers) > 0 {
+		loggers := make([]Logger, len(opts.Output.Loggers))
+		for idx := range opts.Output.Loggers {
+			loggers[idx] = opts.Output.Loggers[idx]
+			loggers[idx].Options = opts.Output.Loggers[idx].Options.redacted()
+		}
+		opts.Output.Loggers = loggers
+	}
+
+	redactTriggers := func(triggers []*CreateOptions) []*CreateOptions {
+		if len(triggers) == 0 {
+			return triggers
+		}
+		out := make([]*CreateOptions, 0, len(triggers))
+		for _, trigger := range triggers {
+			if trigger == nil {
+				out = append(out, trigger)
+				continue
+			}
+			redacted := trigger.redacted()
+			out = append(out, &redacted)
+		}
+		return out
+	}
+	opts.OnSuccess = redactTriggers(opts.OnSuccess)
+	opts.OnFailure = redactTriggers(opts.OnFailure)
+	opts.OnTimeout = redactTriggers(opts.OnTimeout)
+
+	return opts
+}
+
+// redacted returns a copy of the LogOptions in which the credentials of
+// the loggers are redacted: passwords and tokens, the passwords in URLs,
+// the values of HTTP headers, and the Sumo Logic endpoint, which contains
+// its own credentials.
+func (opts LogOptions) redacted() LogOptions {
+	if opts.HTTPOptions.Password != "" {
+		opts.HTTPOptions.Password = RedactedValue
+	}
+	opts.HTTPOptions.URL = redactURL(opts.HTTPOptions.URL)
+	if len(opts.HTTPOptions.Headers) > 0 {
+		headers := make(map[string]string, len(opts.HTTPOptions.Headers))
+		for key := range opts.HTTPOptions.Headers {
+			headers[key] = RedactedValue
+		}
+		opts.HTTPOptions.Headers = headers
+	}
+
+	if opts.SplunkOptions.Token != "" {
+		opts.SplunkOptions.Token = RedactedValue
+	}
+	opts.SplunkOptions.ServerURL = redactURL(opts.SplunkOptions.ServerURL)
+
+	opts.BuildloggerOptions.URL = redactURL(opts.BuildloggerOptions.URL)
+	opts.BuildloggerOptions.SetCredentials("", "")
+
+	if opts.SumoEndpoint != "" {
+		opts.SumoEndpoint = RedactedValue
+	}
+
+	return opts
+}
+
+// redactURL returns the URL with the password in its user information, if
+// any, redacted.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	if _, ok := u.User.Password(); !ok {
+		return rawURL
+	}
+	userinfo := u.User.String()
+	redacted := url.User(u.User.Username()).String() + ":" + RedactedValue
+	return strings.Replace(u.String(), userinfo+"@", redacted+"@", 1)
+}
+
+// Redacted returns a copy of the ProcessInfo in which the arguments and
+// the values of the environment of the process are redacted according to
+// its RedactionOptions. Values of the environment variables listed in
+// RedactionOptions.EnvVars are replaced entirely, as are the literals to
+// redact and the credentials of the loggers. If the options are invalid,
+// all arguments and values are replaced.
+func (info ProcessInfo) Redacted() ProcessInfo {
+	info.Options = info.Options.redacted()
+	return info
+}
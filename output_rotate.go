@@ -0,0 +1,329 @@
+package jasper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+// RotationOptions configures when a LogRotatingFile logger rotates its
+// file and how many of the rotated files it keeps. Rotation happens
+// when a write would grow the file past MaxSize bytes, or when the
+// file has been open for longer than Interval; at least one of the
+// two must be set.
+//
+// Rotated files are named by appending ".1", ".2", and so on to the
+// file name, with ".1" being the most recent. MaxFiles is the number
+// of rotated files to keep; if it is 0, all rotated files are kept.
+type RotationOptions struct {
+	MaxSize  int           `json:"max_size"`
+	Interval time.Duration `json:"interval"`
+	MaxFiles int           `json:"max_files"`
+	Compress bool          `json:"compress"`
+}
+
+// Validate ensures that RotationOptions is valid.
+func (opts RotationOptions) Validate() error {
+	if opts.MaxSize < 0 || opts.Interval < 0 || opts.MaxFiles < 0 {
+		return errors.New("cannot have negative rotation size, interval, or file count")
+	}
+	return nil
+}
+
+// LogFileNameData is the data available to the template in the
+// FileName of a LogRotatingFile logger, so that each process can log
+// to its own file (e.g. "mongod-{{.ID}}.log"). Timestamp is the UTC
+// time at which the process was created, formatted as
+// "20060102T150405Z", and Tag is the first of the process' tags.
+type LogFileNameData struct {
+	ID        string
+	Tag       string
+	Tags      []string
+	Timestamp string
+}
+
+func newLogFileNameData(opts *CreateOptions) LogFileNameData {
+	data := LogFileNameData{
+		ID:        opts.Environment[EnvironID],
+		Tags:      opts.Tags,
+		Timestamp: time.Now().UTC().Format("20060102T150405Z"),
+	}
+	if len(opts.Tags) > 0 {
+		data.Tag = opts.Tags[0]
+	}
+	return data
+}
+
+func parseLogFileName(name string) (*template.Template, error) {
+	tmpl, err := template.New("file_name").Option("missingkey=error").Parse(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid file name template '%s'", name)
+	}
+	return tmpl, nil
+}
+
+func expandLogFileName(name string, data LogFileNameData) (string, error) {
+	if !strings.Contains(name, "{{") {
+		return name, nil
+	}
+
+	tmpl, err := parseLogFileName(name)
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", errors.Wrapf(err, "problem expanding file name template '%s'", name)
+	}
+
+	return buf.String(), nil
+}
+
+// rotatingFile is an io.WriteCloser that rotates the underlying file
+// according to its RotationOptions.
+type rotatingFile struct {
+	path   string
+	opts   RotationOptions
+	file   *os.File
+	size   int64
+	opened time.Time
+	mu     sync.Mutex
+}
+
+func newRotatingFile(path string, opts RotationOptions) (*rotatingFile, error) {
+	if path == "" {
+		return nil, errors.New("cannot rotate a file without a name")
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.MaxSize == 0 && opts.Interval == 0 {
+		return nil, errors.New("must specify a maximum size or an interval for file rotation")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Wrapf(err, "problem creating directory for log file '%s'", path)
+	}
+
+	f := &rotatingFile{path: path, opts: opts}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "problem opening log file '%s'", f.path)
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(errors.Wrapf(err, "problem getting info for log file '%s'", f.path))
+		catcher.Add(file.Close())
+		return catcher.Resolve()
+	}
+
+	f.file = file
+	f.size = stat.Size()
+	f.opened = time.Now()
+
+	return nil
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, errors.New("cannot write to closed log file")
+	}
+
+	if f.shouldRotate(len(p)) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+func (f *rotatingFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+func (f *rotatingFile) shouldRotate(n int) bool {
+	if f.size == 0 {
+		return false
+	}
+	if f.opts.MaxSize > 0 && f.size+int64(n) > int64(f.opts.MaxSize) {
+		return true
+	}
+	if f.opts.Interval > 0 && time.Since(f.opened) >= f.opts.Interval {
+		return true
+	}
+	return false
+}
+
+func (f *rotatingFile) backupName(i int) string {
+	name := fmt.Sprintf("%s.%d", f.path, i)
+	if f.opts.Compress {
+		name += ".gz"
+	}
+	return name
+}
+
+// rotate closes the log file, moves it to the first backup, and opens a
+// new log file. The log file is reopened even if rotation fails, so that
+// later writes are not lost.
+func (f *rotatingFile) rotate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(errors.Wrapf(f.file.Close(), "problem closing log file '%s'", f.path))
+	f.file = nil
+
+	if !catcher.HasErrors() {
+		catcher.Add(f.shiftBackups())
+	}
+	catcher.Add(f.open())
+
+	return catcher.Resolve()
+}
+
+// shiftBackups moves the closed log file to the first backup, renaming the
+// existing backups and removing any beyond MaxFiles.
+func (f *rotatingFile) shiftBackups() error {
+	numBackups := 0
+	for {
+		if _, err := os.Stat(f.backupName(numBackups + 1)); err != nil {
+			break
+		}
+		numBackups++
+	}
+
+	if f.opts.MaxFiles > 0 {
+		for ; numBackups >= f.opts.MaxFiles; numBackups-- {
+			if err := os.Remove(f.backupName(numBackups)); err != nil {
+				return errors.Wrap(err, "problem removing old log file")
+			}
+		}
+	}
+
+	for i := numBackups; i > 0; i-- {
+		if err := os.Rename(f.backupName(i), f.backupName(i+1)); err != nil {
+			return errors.Wrap(err, "problem renaming old log file")
+		}
+	}
+
+	if f.opts.Compress {
+		if err := compressFile(f.path, f.backupName(1)); err != nil {
+			return err
+		}
+		if err := os.Remove(f.path); err != nil {
+			return errors.Wrapf(err, "problem removing log file '%s'", f.path)
+		}
+	} else if err := os.Rename(f.path, f.backupName(1)); err != nil {
+		return errors.Wrapf(err, "problem renaming log file '%s'", f.path)
+	}
+
+	return nil
+}
+
+func compressFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.Wrapf(err, "problem opening file '%s'", src)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return errors.Wrapf(err, "problem creating file '%s'", dst)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	gz := gzip.NewWriter(out)
+	_, err = io.Copy(gz, in)
+	catcher.Add(errors.Wrapf(err, "problem compressing file '%s'", src))
+	catcher.Add(gz.Close())
+	catcher.Add(out.Close())
+
+	return catcher.Resolve()
+}
+
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+
+	return err
+}
+
+// rotatingFileSender is a send.Sender that writes formatted messages
+// to a rotatingFile.
+type rotatingFileSender struct {
+	file *rotatingFile
+	*send.Base
+}
+
+func newRotatingFileSender(name string, file *rotatingFile) (send.Sender, error) {
+	s := &rotatingFileSender{
+		file: file,
+		Base: send.MakeBase(name, func() {}, file.Close),
+	}
+
+	fallback := log.New(os.Stderr, "", log.LstdFlags)
+	if err := s.SetErrorHandler(send.ErrorHandlerFromLogger(fallback)); err != nil {
+		return nil, err
+	}
+	if err := s.SetLevel(send.LevelInfo{Default: level.Trace, Threshold: level.Trace}); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *rotatingFileSender) Send(m message.Composer) {
+	if !s.Level().ShouldLog(m) {
+		return
+	}
+
+	out, err := s.Formatter(m)
+	if err != nil {
+		s.ErrorHandler(err, m)
+		return
+	}
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+
+	if _, err := s.file.WriteString(out); err != nil {
+		s.ErrorHandler(err, m)
+	}
+}
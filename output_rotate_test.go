@@ -0,0 +1,223 @@
+package jasper
+
+import (
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	for name, test := range map[string]func(*testing.T, string){
+		"RequiresSizeOrInterval": func(t *testing.T, dir string) {
+			_, err := newRotatingFile(filepath.Join(dir, "out.log"), RotationOptions{})
+			assert.Error(t, err)
+		},
+		"RotatesWhenFull": func(t *testing.T, dir string) {
+			path := filepath.Join(dir, "out.log")
+			file, err := newRotatingFile(path, RotationOptions{MaxSize: 10})
+			require.NoError(t, err)
+
+			for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n"} {
+				_, err = file.WriteString(line)
+				require.NoError(t, err)
+			}
+			require.NoError(t, file.Close())
+
+			for name, expected := range map[string]string{
+				path:        "cccccccc\n",
+				path + ".1": "bbbbbbbb\n",
+				path + ".2": "aaaaaaaa\n",
+			} {
+				data, err := ioutil.ReadFile(name)
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(data))
+			}
+		},
+		"KeepsMaxFiles": func(t *testing.T, dir string) {
+			path := filepath.Join(dir, "out.log")
+			file, err := newRotatingFile(path, RotationOptions{MaxSize: 1, MaxFiles: 2})
+			require.NoError(t, err)
+
+			for _, line := range []string{"a\n", "b\n", "c\n", "d\n", "e\n"} {
+				_, err = file.WriteString(line)
+				require.NoError(t, err)
+			}
+			require.NoError(t, file.Close())
+
+			files, err := ioutil.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Len(t, files, 3)
+
+			data, err := ioutil.ReadFile(path + ".2")
+			require.NoError(t, err)
+			assert.Equal(t, "c\n", string(data))
+		},
+		"CompressesRotatedFiles": func(t *testing.T, dir string) {
+			path := filepath.Join(dir, "out.log")
+			file, err := newRotatingFile(path, RotationOptions{MaxSize: 1, Compress: true})
+			require.NoError(t, err)
+
+			for _, line := range []string{"a\n", "b\n"} {
+				_, err = file.WriteString(line)
+				require.NoError(t, err)
+			}
+			require.NoError(t, file.Close())
+
+			_, err = os.Stat(path + ".1")
+			assert.True(t, os.IsNotExist(err))
+
+			in, err := os.Open(path + ".1.gz")
+			require.NoError(t, err)
+			defer in.Close()
+			gz, err := gzip.NewReader(in)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(gz)
+			require.NoError(t, err)
+			assert.Equal(t, "a\n", string(data))
+		},
+		"RotatesAfterInterval": func(t *testing.T, dir string) {
+			path := filepath.Join(dir, "out.log")
+			file, err := newRotatingFile(path, RotationOptions{Interval: 10 * time.Millisecond})
+			require.NoError(t, err)
+
+			_, err = file.WriteString("a\n")
+			require.NoError(t, err)
+			_, err = file.WriteString("b\n")
+			require.NoError(t, err)
+			time.Sleep(20 * time.Millisecond)
+			_, err = file.WriteString("c\n")
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+
+			data, err := ioutil.ReadFile(path + ".1")
+			require.NoError(t, err)
+			assert.Equal(t, "a\nb\n", string(data))
+			data, err = ioutil.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, "c\n", string(data))
+		},
+		"AppendsToExistingFile": func(t *testing.T, dir string) {
+			path := filepath.Join(dir, "out.log")
+			require.NoError(t, ioutil.WriteFile(path, []byte("aaaaaaaa\n"), 0644))
+
+			file, err := newRotatingFile(path, RotationOptions{MaxSize: 10})
+			require.NoError(t, err)
+			_, err = file.WriteString("b\n")
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+
+			data, err := ioutil.ReadFile(path + ".1")
+			require.NoError(t, err)
+			assert.Equal(t, "aaaaaaaa\n", string(data))
+		},
+		"WriteFailsAfterClose": func(t *testing.T, dir string) {
+			file, err := newRotatingFile(filepath.Join(dir, "out.log"), RotationOptions{MaxSize: 10})
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+			_, err = file.WriteString("a\n")
+			assert.Error(t, err)
+		},
+		"ReopensAfterFailedRotation": func(t *testing.T, dir string) {
+			path := filepath.Join(dir, "out.log")
+			require.NoError(t, os.MkdirAll(filepath.Join(path+".1", "keep"), 0755))
+
+			file, err := newRotatingFile(path, RotationOptions{MaxSize: 1, MaxFiles: 1})
+			require.NoError(t, err)
+
+			_, err = file.WriteString("a\n")
+			require.NoError(t, err)
+			_, err = file.WriteString("b\n")
+			assert.Error(t, err)
+
+			require.NoError(t, os.RemoveAll(path+".1"))
+			_, err = file.WriteString("c\n")
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+
+			data, err := ioutil.ReadFile(path + ".1")
+			require.NoError(t, err)
+			assert.Equal(t, "a\n", string(data))
+			data, err = ioutil.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, "c\n", string(data))
+		},
+		// "": func(t *testing.T, dir string) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("build", "rotate")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			test(t, dir)
+		})
+	}
+}
+
+func TestExpandLogFileName(t *testing.T) {
+	data := LogFileNameData{ID: "id", Tag: "tag", Tags: []string{"tag", "other"}, Timestamp: "20180101T000000Z"}
+
+	for name, expected := range map[string]string{
+		"out.log":                             "out.log",
+		"{{.ID}}.log":                         "id.log",
+		"{{.Tag}}-{{.Timestamp}}.log":         "tag-20180101T000000Z.log",
+		`{{join .Tags "-"}}.log`:              "",
+		`{{range .Tags}}{{.}}.{{end}}log`:     "tag.other.log",
+		"logs/{{.ID}}/{{.Timestamp}}/out.log": "logs/id/20180101T000000Z/out.log",
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := expandLogFileName(name, data)
+			if expected == "" {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expected, out)
+		})
+	}
+}
+
+func TestRotatingFileLoggerWithProcess(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+	defer cancel()
+
+	dir, err := ioutil.TempDir("build", "rotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for cname, makeProc := range map[string]processConstructor{
+		"Basic":    newBasicProcess,
+		"Blocking": newBlockingProcess,
+	} {
+		t.Run(cname, func(t *testing.T) {
+			opts := &CreateOptions{
+				Args: []string{"echo", "hello world"},
+				Tags: []string{strings.ToLower(cname)},
+				Output: OutputOptions{Loggers: []Logger{{
+					Type: LogRotatingFile,
+					Options: LogOptions{
+						Format:          LogFormatPlain,
+						FileName:        filepath.Join(dir, "{{.Tag}}-{{.ID}}.log"),
+						RotationOptions: RotationOptions{MaxSize: 1024},
+					},
+				}}},
+			}
+
+			proc, err := makeProc(ctx, opts)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			data, err := ioutil.ReadFile(filepath.Join(dir, strings.ToLower(cname)+"-"+proc.ID()+".log"))
+			require.NoError(t, err)
+			assert.Equal(t, "hello world\n", string(data))
+		})
+	}
+}
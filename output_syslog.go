@@ -0,0 +1,239 @@
+package jasper
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+// DefaultSyslogFacility is the facility of messages sent by LogSyslog
+// loggers if SyslogOptions does not specify one.
+const DefaultSyslogFacility = "user"
+
+// localSyslogSockets are the paths at which the local syslog daemon
+// usually listens.
+var localSyslogSockets = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// syslogFacilities maps the names of syslog facilities to their codes, as
+// defined in RFC 5424.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// SyslogOptions configures a LogSyslog logger, which sends each message
+// to a syslog daemon in the format described by RFC 5424.
+//
+// If Network and Address are unset, messages are sent to the local
+// daemon's socket. Otherwise, Network is one of "udp", "tcp", "unix", or
+// "unixgram", and Address is the address of the daemon. Messages sent
+// over stream connections are framed by octet counting, as described by
+// RFC 6587.
+//
+// Facility is the name of the syslog facility (e.g. "daemon" or "local0"),
+// DefaultSyslogFacility by default, and AppName identifies the sender of
+// the messages, DefaultLogName by default.
+type SyslogOptions struct {
+	Network  string `json:"network,omitempty"`
+	Address  string `json:"address,omitempty"`
+	Facility string `json:"facility,omitempty"`
+	AppName  string `json:"app_name,omitempty"`
+}
+
+// Validate ensures that the SyslogOptions is valid.
+func (opts SyslogOptions) Validate() error {
+	switch opts.Network {
+	case "":
+		if opts.Address != "" {
+			return errors.New("cannot specify a syslog address without a network")
+		}
+	case "udp", "tcp", "unix", "unixgram":
+		if opts.Address == "" {
+			return errors.New("must specify a syslog address with a network")
+		}
+	default:
+		return errors.Errorf("unknown syslog network '%s'", opts.Network)
+	}
+
+	if _, ok := syslogFacilities[opts.Facility]; opts.Facility != "" && !ok {
+		return errors.Errorf("unknown syslog facility '%s'", opts.Facility)
+	}
+
+	return nil
+}
+
+// syslogSeverity returns the RFC 5424 severity of the priority.
+func syslogSeverity(p level.Priority) int {
+	switch p {
+	case level.Emergency:
+		return 0
+	case level.Alert:
+		return 1
+	case level.Critical:
+		return 2
+	case level.Error:
+		return 3
+	case level.Warning:
+		return 4
+	case level.Notice:
+		return 5
+	case level.Info:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// syslogSender is a send.Sender that writes messages to a syslog daemon.
+// If a write fails, it reconnects once before reporting the error.
+type syslogSender struct {
+	opts     SyslogOptions
+	facility int
+	hostname string
+	conn     net.Conn
+	stream   bool
+	mu       sync.Mutex
+	*send.Base
+}
+
+func newSyslogSender(name string, opts SyslogOptions) (send.Sender, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid syslog options")
+	}
+	if opts.Facility == "" {
+		opts.Facility = DefaultSyslogFacility
+	}
+	if opts.AppName == "" {
+		opts.AppName = DefaultLogName
+	}
+
+	s := &syslogSender{opts: opts, facility: syslogFacilities[opts.Facility]}
+	s.hostname, _ = os.Hostname()
+	if s.hostname == "" {
+		s.hostname = "-"
+	}
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	s.Base = send.MakeBase(name, func() {}, s.close)
+
+	fallback := log.New(os.Stderr, "", log.LstdFlags)
+	if err := s.SetErrorHandler(send.ErrorHandlerFromLogger(fallback)); err != nil {
+		return nil, err
+	}
+	if err := s.SetLevel(send.LevelInfo{Default: level.Trace, Threshold: level.Trace}); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *syslogSender) connect() error {
+	if s.opts.Network != "" {
+		conn, err := net.Dial(s.opts.Network, s.opts.Address)
+		if err != nil {
+			return errors.Wrapf(err, "problem connecting to syslog at '%s'", s.opts.Address)
+		}
+		s.conn = conn
+		s.stream = s.opts.Network == "tcp" || s.opts.Network == "unix"
+		return nil
+	}
+
+	for _, network := range []string{"unixgram", "unix"} {
+		for _, path := range localSyslogSockets {
+			conn, err := net.Dial(network, path)
+			if err == nil {
+				s.conn = conn
+				s.stream = network == "unix"
+				return nil
+			}
+		}
+	}
+	return errors.New("could not connect to the local syslog daemon")
+}
+
+func (s *syslogSender) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return errors.WithStack(err)
+}
+
+// format returns the message in the format described by RFC 5424, framed
+// for the connection.
+func (s *syslogSender) format(p level.Priority, msg string) []byte {
+	msg = strings.TrimSuffix(msg, "\n")
+	out := fmt.Sprintf("<%d>1 %s %s %s %d - - %s",
+		s.facility*8+syslogSeverity(p),
+		time.Now().Format(time.RFC3339Nano),
+		s.hostname,
+		s.opts.AppName,
+		os.Getpid(),
+		msg)
+	if s.stream {
+		out = fmt.Sprintf("%d %s", len(out), out)
+	}
+	return []byte(out)
+}
+
+func (s *syslogSender) Send(m message.Composer) {
+	if !s.Level().ShouldLog(m) {
+		return
+	}
+
+	msg, err := s.Formatter(m)
+	if err != nil {
+		s.ErrorHandler(err, m)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	payload := s.format(m.Priority(), msg)
+	if s.conn != nil {
+		if _, err = s.conn.Write(payload); err == nil {
+			return
+		}
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	if err = s.connect(); err == nil {
+		_, err = s.conn.Write(payload)
+	}
+	if err != nil {
+		s.ErrorHandler(errors.Wrap(err, "problem writing to syslog"), m)
+	}
+}
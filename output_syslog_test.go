@@ -0,0 +1,125 @@
+package jasper
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogOptions(t *testing.T) {
+	for name, opts := range map[string]SyslogOptions{
+		"Local":    {},
+		"UDP":      {Network: "udp", Address: "localhost:514"},
+		"TCP":      {Network: "tcp", Address: "localhost:514", Facility: "local0", AppName: "foo"},
+		"Unixgram": {Network: "unixgram", Address: "/dev/log"},
+	} {
+		t.Run("Valid"+name, func(t *testing.T) {
+			assert.NoError(t, opts.Validate())
+		})
+	}
+
+	for name, opts := range map[string]SyslogOptions{
+		"AddressWithoutNetwork": {Address: "localhost:514"},
+		"NetworkWithoutAddress": {Network: "udp"},
+		"UnknownNetwork":        {Network: "foo", Address: "localhost:514"},
+		"UnknownFacility":       {Facility: "foo"},
+	} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			assert.Error(t, opts.Validate())
+		})
+	}
+}
+
+func syslogPattern(pri int, appName, msg string) *regexp.Regexp {
+	hostname, _ := os.Hostname()
+	return regexp.MustCompile(fmt.Sprintf(`^<%d>1 \S+ %s %s %d - - %s$`,
+		pri, regexp.QuoteMeta(hostname), regexp.QuoteMeta(appName), os.Getpid(), regexp.QuoteMeta(msg)))
+}
+
+func TestSyslogSender(t *testing.T) {
+	t.Run("UDP", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+
+		l := Logger{Type: LogSyslog, Options: LogOptions{
+			Format:        LogFormatPlain,
+			SyslogOptions: SyslogOptions{Network: "udp", Address: conn.LocalAddr().String(), Facility: "local0"},
+		}}
+		require.NoError(t, l.Validate())
+		sender, err := l.Configure()
+		require.NoError(t, err)
+		defer sender.Close()
+
+		sender.Send(message.NewDefaultMessage(level.Error, "foo\n"))
+
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(taskTimeout)))
+		buf := make([]byte, 1024)
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.Regexp(t, syslogPattern(16*8+3, DefaultLogName, "foo"), string(buf[:n]))
+	})
+	t.Run("TCPUsesOctetCounting", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		sender, err := newSyslogSender("test", SyslogOptions{Network: "tcp", Address: listener.Addr().String(), AppName: "bar"})
+		require.NoError(t, err)
+		defer sender.Close()
+		conn, err := listener.Accept()
+		require.NoError(t, err)
+		defer conn.Close()
+
+		sender.Send(message.NewDefaultMessage(level.Info, "foo"))
+		sender.Send(message.NewDefaultMessage(level.Debug, "bar baz"))
+
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(taskTimeout)))
+		reader := bufio.NewReader(conn)
+		for _, expected := range []*regexp.Regexp{syslogPattern(8+6, "bar", "foo"), syslogPattern(8+7, "bar", "bar baz")} {
+			length, err := reader.ReadString(' ')
+			require.NoError(t, err)
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			require.NoError(t, err)
+			msg := make([]byte, n)
+			_, err = io.ReadFull(reader, msg)
+			require.NoError(t, err)
+			assert.Regexp(t, expected, string(msg))
+		}
+	})
+	t.Run("FailsWithInvalidOptions", func(t *testing.T) {
+		sender, err := newSyslogSender("test", SyslogOptions{Network: "udp"})
+		assert.Error(t, err)
+		assert.Nil(t, sender)
+	})
+	t.Run("FailsWithoutServer", func(t *testing.T) {
+		sender, err := newSyslogSender("test", SyslogOptions{Network: "tcp", Address: "127.0.0.1:1"})
+		assert.Error(t, err)
+		assert.Nil(t, sender)
+	})
+}
+
+func TestJournaldLogger(t *testing.T) {
+	if runtime.GOOS == "linux" {
+		t.Skip("journald may be available")
+	}
+
+	l := Logger{Type: LogJournald, Options: LogOptions{Format: LogFormatPlain}}
+	require.NoError(t, l.Validate())
+	sender, err := l.Configure()
+	assert.Error(t, err)
+	assert.Nil(t, sender)
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/mongodb/grip/level"
 	"github.com/mongodb/grip/send"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -179,6 +181,32 @@ func TestOutputOptions(t *testing.T) {
 			require.Equal(t, 1, len(logOut))
 			assert.Equal(t, msg, strings.Join(logOut, ""))
 		},
+		"SingleLoggerSendsBothStreamsDirectly": func(t *testing.T, opts OutputOptions) {
+			opts.Loggers = []Logger{{Type: LogInMemory, Options: LogOptions{Format: LogFormatPlain, InMemoryCap: 100}}}
+			_, err := opts.GetOutput()
+			require.NoError(t, err)
+			_, err = opts.GetError()
+			require.NoError(t, err)
+
+			_, ok := opts.outputSender.Sender.(*streamSender)
+			assert.True(t, ok)
+			_, ok = opts.errorSender.Sender.(*streamSender)
+			assert.True(t, ok)
+
+			_, err = opts.outputSender.Write([]byte("out\n"))
+			require.NoError(t, err)
+			_, err = opts.errorSender.Write([]byte("err\n"))
+			require.NoError(t, err)
+			require.NoError(t, opts.outputSender.Close())
+			require.NoError(t, opts.errorSender.Close())
+
+			sender, ok := opts.Loggers[0].sender.(*send.InMemorySender)
+			require.True(t, ok)
+			msgs := sender.Get()
+			require.Len(t, msgs, 2)
+			assert.Equal(t, level.Info, msgs[0].Priority())
+			assert.Equal(t, level.Error, msgs[1].Priority())
+		},
 		"GetErrorWithErrorAndLogger": func(t *testing.T, opts OutputOptions) {
 			opts.Error = stderr
 			logger := Logger{Type: LogInMemory, Options: LogOptions{Format: LogFormatPlain, InMemoryCap: 100}}
@@ -200,6 +228,185 @@ func TestOutputOptions(t *testing.T) {
 			require.Equal(t, 1, len(logErr))
 			assert.Equal(t, msg, strings.Join(logErr, ""))
 		},
+		"SuppressOutputWithErrorLogger": func(t *testing.T, opts OutputOptions) {
+			opts.Loggers = []Logger{{
+				Type:    LogDefault,
+				Options: LogOptions{Format: LogFormatPlain},
+				Streams: []LogStreamOptions{{Stream: LogStreamError}},
+			}}
+			opts.SuppressOutput = true
+			assert.NoError(t, opts.Validate())
+		},
+		"RedirectOutputWithoutErrorLoggerFails": func(t *testing.T, opts OutputOptions) {
+			opts.Loggers = []Logger{{
+				Type:    LogDefault,
+				Options: LogOptions{Format: LogFormatPlain},
+				Streams: []LogStreamOptions{{Stream: LogStreamOutput}},
+			}}
+			opts.SendOutputToError = true
+			assert.Error(t, opts.Validate())
+		},
+		"LoggersReceiveOnlyTheirStreams": func(t *testing.T, opts OutputOptions) {
+			opts.Loggers = []Logger{
+				{
+					Type:    LogInMemory,
+					Options: LogOptions{Format: LogFormatPlain, InMemoryCap: 100},
+					Streams: []LogStreamOptions{{Stream: LogStreamOutput, Prefix: "[out] "}},
+				},
+				{
+					Type:    LogInMemory,
+					Options: LogOptions{Format: LogFormatPlain, InMemoryCap: 100},
+					Streams: []LogStreamOptions{{Stream: LogStreamError, Level: level.Warning}},
+				},
+			}
+			require.NoError(t, opts.Validate())
+
+			out, err := opts.GetOutput()
+			require.NoError(t, err)
+			errOut, err := opts.GetError()
+			require.NoError(t, err)
+
+			_, err = out.Write([]byte("foo\n"))
+			require.NoError(t, err)
+			_, err = errOut.Write([]byte("bar\n"))
+			require.NoError(t, err)
+			require.NoError(t, opts.outputSender.Close())
+			require.NoError(t, opts.errorSender.Close())
+
+			outSender, ok := opts.Loggers[0].sender.(*send.InMemorySender)
+			require.True(t, ok)
+			logOut, err := outSender.GetString()
+			require.NoError(t, err)
+			assert.Equal(t, []string{"[out] foo"}, logOut)
+
+			errSender, ok := opts.Loggers[1].sender.(*send.InMemorySender)
+			require.True(t, ok)
+			msgs := errSender.Get()
+			require.Len(t, msgs, 1)
+			assert.Equal(t, "bar", msgs[0].String())
+			assert.Equal(t, level.Warning, msgs[0].Priority())
+		},
+		"CloseLoggersClosesSharedSendersOnce": func(t *testing.T, opts OutputOptions) {
+			opts.Loggers = []Logger{{
+				Type:    LogDefault,
+				Options: LogOptions{Format: LogFormatPlain, BufferOptions: BufferOptions{Buffered: true}},
+			}}
+
+			_, err := opts.GetOutput()
+			require.NoError(t, err)
+			_, err = opts.GetError()
+			require.NoError(t, err)
+
+			assert.NotPanics(t, func() {
+				assert.NoError(t, opts.closeLoggers())
+			})
+		},
+		"InvalidRedactionPatternFails": func(t *testing.T, opts OutputOptions) {
+			opts.Redaction.Patterns = []string{"("}
+			assert.Error(t, opts.Validate())
+		},
+		"RedactionAppliesToOutputAndError": func(t *testing.T, opts OutputOptions) {
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			opts.Output = stdout
+			opts.Error = stderr
+			opts.Redaction.Literals = []string{"secret"}
+			require.NoError(t, opts.Validate())
+
+			out, err := opts.GetOutput()
+			require.NoError(t, err)
+			errOut, err := opts.GetError()
+			require.NoError(t, err)
+
+			_, err = out.Write([]byte("a secret\npartial secret"))
+			require.NoError(t, err)
+			_, err = errOut.Write([]byte("another secret\n"))
+			require.NoError(t, err)
+			assert.Equal(t, "a "+RedactedValue+"\n", stdout.String())
+
+			require.NoError(t, opts.flushOutput())
+			assert.Equal(t, "a "+RedactedValue+"\npartial "+RedactedValue, stdout.String())
+			assert.Equal(t, "another "+RedactedValue+"\n", stderr.String())
+		},
+		"InvalidLineBufferFails": func(t *testing.T, opts OutputOptions) {
+			opts.LineBuffer.Prefix = "{{.ID}}"
+			assert.Error(t, opts.Validate())
+		},
+		"LineBufferingSharesRedirectedWriter": func(t *testing.T, opts OutputOptions) {
+			stdout := &bytes.Buffer{}
+			opts.Output = stdout
+			opts.SendErrorToOutput = true
+			opts.LineBuffer = LineBufferOptions{Enabled: true, Prefix: "{{.Stream}}: "}
+			require.NoError(t, opts.Validate())
+
+			out, err := opts.GetOutput()
+			require.NoError(t, err)
+			errOut, err := opts.GetError()
+			require.NoError(t, err)
+			assert.Equal(t, out, errOut)
+
+			_, err = out.Write([]byte("fo"))
+			require.NoError(t, err)
+			_, err = errOut.Write([]byte("o\n"))
+			require.NoError(t, err)
+			assert.Equal(t, "output: foo\n", stdout.String())
+		},
+		"LineBufferingWithoutPrefixHoldsPartialLines": func(t *testing.T, opts OutputOptions) {
+			stdout := &bytes.Buffer{}
+			opts.Output = stdout
+			opts.LineBuffer.Enabled = true
+
+			out, err := opts.GetOutput()
+			require.NoError(t, err)
+			_, err = out.Write([]byte("foo\nbar"))
+			require.NoError(t, err)
+			assert.Equal(t, "foo\n", stdout.String())
+
+			require.NoError(t, opts.flushOutput())
+			assert.Equal(t, "foo\nbar", stdout.String())
+		},
+		"InvalidOutputLimitFails": func(t *testing.T, opts OutputOptions) {
+			opts.Limits.MaxBytes = -1
+			assert.Error(t, opts.Validate())
+		},
+		"OutputLimitAppliesToEachStream": func(t *testing.T, opts OutputOptions) {
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			opts.Output = stdout
+			opts.Error = stderr
+			opts.Limits.MaxBytes = 3
+
+			out, err := opts.GetOutput()
+			require.NoError(t, err)
+			errOut, err := opts.GetError()
+			require.NoError(t, err)
+			_, err = out.Write([]byte("foobar"))
+			require.NoError(t, err)
+			_, err = errOut.Write([]byte("baz"))
+			require.NoError(t, err)
+
+			assert.Equal(t, "foo", stdout.String())
+			assert.Equal(t, "baz", stderr.String())
+			outputTruncated, errorTruncated := opts.truncated()
+			assert.True(t, outputTruncated)
+			assert.False(t, errorTruncated)
+		},
+		"OutputLimitCountsLinePrefixes": func(t *testing.T, opts OutputOptions) {
+			stdout := &bytes.Buffer{}
+			opts.Output = stdout
+			opts.LineBuffer = LineBufferOptions{Enabled: true, Prefix: "> "}
+			opts.Limits = OutputLimitOptions{MaxBytes: 10, Policy: OutputLimitDrop}
+
+			out, err := opts.GetOutput()
+			require.NoError(t, err)
+			for _, line := range []string{"foo\n", "bar\n", "baz\n"} {
+				_, err = out.Write([]byte(line))
+				require.NoError(t, err)
+			}
+			assert.Equal(t, "> foo\n", stdout.String())
+			outputTruncated, _ := opts.truncated()
+			assert.True(t, outputTruncated)
+		},
 		// "": func(t *testing.T, opts OutputOptions) {}
 	}
 
@@ -310,6 +517,68 @@ func TestLoggers(t *testing.T) {
 			assert.NoError(t, err)
 			assert.NotNil(t, sender)
 		},
+		"RotatingFileFailsValidationWithoutRotationOptions": func(t *testing.T, l Logger) {
+			l.Type = LogRotatingFile
+			l.Options.FileName = "foo.log"
+			assert.Error(t, l.Validate())
+		},
+		"RotatingFileFailsValidationWithInvalidTemplate": func(t *testing.T, l Logger) {
+			l.Type = LogRotatingFile
+			l.Options.FileName = "foo-{{.ID.log"
+			l.Options.RotationOptions.MaxSize = 1024
+			assert.Error(t, l.Validate())
+		},
+		"RotatingFileFailsValidationWithNegativeRotationOptions": func(t *testing.T, l Logger) {
+			l.Type = LogRotatingFile
+			l.Options.FileName = "foo.log"
+			l.Options.RotationOptions.MaxSize = 1024
+			l.Options.RotationOptions.MaxFiles = -1
+			assert.Error(t, l.Validate())
+		},
+		"ConfigureRotatingFilePasses": func(t *testing.T, l Logger) {
+			dir, err := ioutil.TempDir("build", "rotate")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			l.Type = LogRotatingFile
+			l.Options.FileName = filepath.Join(dir, "foo-{{.ID}}.log")
+			l.Options.RotationOptions.MaxSize = 1024
+			l.fileNameData.ID = "bar"
+			assert.NoError(t, l.Validate())
+
+			sender, err := l.Configure()
+			require.NoError(t, err)
+			require.NotNil(t, sender)
+			assert.NoError(t, sender.Close())
+			_, err = os.Stat(filepath.Join(dir, "foo-bar.log"))
+			assert.NoError(t, err)
+		},
+		"ConfigureRotatingFileFailsWithoutFileName": func(t *testing.T, l Logger) {
+			l.Type = LogRotatingFile
+			l.Options.RotationOptions.MaxSize = 1024
+			sender, err := l.Configure()
+			assert.Error(t, err)
+			assert.Nil(t, sender)
+		},
+		"StreamsPassValidation": func(t *testing.T, l Logger) {
+			l.Streams = []LogStreamOptions{
+				{Stream: LogStreamOutput, Prefix: "out: "},
+				{Stream: LogStreamError, Level: level.Error},
+			}
+			assert.NoError(t, l.Validate())
+		},
+		"InvalidStreamFailsValidation": func(t *testing.T, l Logger) {
+			l.Streams = []LogStreamOptions{{Stream: LogStream("foo")}}
+			assert.Error(t, l.Validate())
+		},
+		"DuplicateStreamFailsValidation": func(t *testing.T, l Logger) {
+			l.Streams = []LogStreamOptions{{Stream: LogStreamOutput}, {Stream: LogStreamOutput, Prefix: "foo"}}
+			assert.Error(t, l.Validate())
+		},
+		"InvalidStreamLevelFailsValidation": func(t *testing.T, l Logger) {
+			l.Streams = []LogStreamOptions{{Stream: LogStreamError, Level: level.Priority(1000)}}
+			assert.Error(t, l.Validate())
+		},
 		"ConfigureFailsWithoutCapacity": func(t *testing.T, l Logger) {
 			l.Type = LogInMemory
 			sender, err := l.Configure()
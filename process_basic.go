@@ -98,6 +98,7 @@ func (p *basicProcess) transition(ctx context.Context, cmd *exec.Cmd) {
 			p.info.ExitCode = procWaitStatus.ExitStatus()
 		}
 		p.info.Successful = p.cmd.ProcessState.Success()
+		p.info.OutputTruncated, p.info.ErrorTruncated = p.opts.Output.truncated()
 		p.triggers.Run(p.info)
 	}
 	finish(<-waitFinished)
@@ -114,7 +115,9 @@ func (p *basicProcess) Info(_ context.Context) ProcessInfo {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.info
+	info := p.info
+	info.OutputTruncated, info.ErrorTruncated = p.opts.Output.truncated()
+	return info
 }
 
 func (p *basicProcess) Complete(_ context.Context) bool {
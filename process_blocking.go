@@ -140,6 +140,7 @@ func (p *blockingProcess) reactor(ctx context.Context, cmd *exec.Cmd) {
 				} else {
 					info.Successful = (err == nil)
 				}
+				info.OutputTruncated, info.ErrorTruncated = p.opts.Output.truncated()
 
 				grip.Debug(message.WrapError(err, message.Fields{
 					"id":           p.ID,
@@ -167,6 +168,7 @@ func (p *blockingProcess) reactor(ctx context.Context, cmd *exec.Cmd) {
 				IsRunning:  false,
 				Successful: false,
 			}
+			info.OutputTruncated, info.ErrorTruncated = p.opts.Output.truncated()
 
 			p.setInfo(info)
 			p.triggers.Run(info)
@@ -187,7 +189,7 @@ func (p *blockingProcess) Info(ctx context.Context) ProcessInfo {
 
 	out := make(chan ProcessInfo)
 	operation := func(cmd *exec.Cmd) {
-		out <- ProcessInfo{
+		info := ProcessInfo{
 			ID:        p.id,
 			Options:   p.opts,
 			Host:      p.opts.Hostname,
@@ -196,6 +198,8 @@ func (p *blockingProcess) Info(ctx context.Context) ProcessInfo {
 			IsRunning: cmd.Process.Pid > 0,
 			PID:       cmd.Process.Pid,
 		}
+		info.OutputTruncated, info.ErrorTruncated = p.opts.Output.truncated()
+		out <- info
 		close(out)
 	}
 
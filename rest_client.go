@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/evergreen-ci/gimlet"
 	"github.com/mongodb/grip"
@@ -21,6 +24,21 @@ type restClient struct {
 	client *http.Client
 }
 
+// NewRESTClient constructs a RemoteClient for the jasper REST service at the
+// given URL, which should include the route prefix and version of the
+// service (e.g. "http://localhost:2289/jasper/v1"). If the HTTP client is
+// nil, the default HTTP client is used.
+func NewRESTClient(url string, client *http.Client) RemoteClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &restClient{
+		prefix: strings.TrimSuffix(url, "/"),
+		client: client,
+	}
+}
+
 func (c *restClient) getURL(route string, args ...interface{}) string {
 	if !strings.HasPrefix(route, "/") {
 		route = "/" + route
@@ -271,6 +289,72 @@ func (c *restClient) GetLogs(ctx context.Context, id string) ([]string, error) {
 	return logs, nil
 }
 
+// GetArtifact returns the contents of the artifact with the given name of
+// the process with the given ID.
+func (c *restClient) GetArtifact(ctx context.Context, id, name string) (io.ReadCloser, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/process/%s/artifacts/%s", id, name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// QueryLogs returns the lines logged by the in-memory loggers of the
+// process with the given ID that match the query.
+func (c *restClient) QueryLogs(ctx context.Context, id string, q LogQuery) ([]LogRecord, error) {
+	values := url.Values{}
+	if q.Stream != "" {
+		values.Set("stream", string(q.Stream))
+	}
+	if !q.Since.IsZero() {
+		values.Set("since", q.Since.Format(time.RFC3339Nano))
+	}
+	if q.Pattern != "" {
+		values.Set("pattern", q.Pattern)
+	}
+	for key, value := range map[string]int{"tail": q.Tail, "offset": q.Offset, "limit": q.Limit} {
+		if value != 0 {
+			values.Set(key, strconv.Itoa(value))
+		}
+	}
+
+	route := c.getURL("/process/%s/logs/query", id)
+	if len(values) > 0 {
+		route += "?" + values.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, route, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	records := []LogRecord{}
+	if err = gimlet.GetJSON(resp.Body, &records); err != nil {
+		return nil, errors.Wrap(err, "problem reading log records from response")
+	}
+
+	return records, nil
+}
+
+// GetProcessMetrics returns system metrics for the process with the given ID
+// and all of its children.
+func (c *restClient) GetProcessMetrics(ctx context.Context, id string) ([]message.ProcessInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/process/%s/metrics", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	metrics := []message.ProcessInfo{}
+	if err = gimlet.GetJSON(resp.Body, &metrics); err != nil {
+		return nil, errors.Wrap(err, "problem reading process metrics from response")
+	}
+
+	return metrics, nil
+}
+
 func (c *restClient) DownloadFile(ctx context.Context, info DownloadInfo) error {
 	body, err := makeBody(info)
 	if err != nil {
@@ -300,6 +384,94 @@ func (c *restClient) DownloadMongoDB(ctx context.Context, opts MongoDBDownloadOp
 	return nil
 }
 
+// ResolveMongoDB resolves the desired versions of MongoDB to builds.
+func (c *restClient) ResolveMongoDB(ctx context.Context, opts MongoDBDownloadOptions) ([]MongoDBRelease, error) {
+	body, err := makeBody(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/download/mongodb/resolve"), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	releases := []MongoDBRelease{}
+	if err = gimlet.GetJSON(resp.Body, &releases); err != nil {
+		return nil, errors.Wrap(err, "problem reading MongoDB releases from response")
+	}
+
+	return releases, nil
+}
+
+func (c *restClient) StartDownload(ctx context.Context, info DownloadInfo) (DownloadJobStatus, error) {
+	body, err := makeBody(info)
+	if err != nil {
+		return DownloadJobStatus{}, errors.Wrap(err, "problem building request")
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/download/jobs"), body)
+	if err != nil {
+		return DownloadJobStatus{}, err
+	}
+	defer resp.Body.Close()
+
+	status := DownloadJobStatus{}
+	if err = gimlet.GetJSON(resp.Body, &status); err != nil {
+		return DownloadJobStatus{}, errors.Wrap(err, "problem reading download job status from response")
+	}
+
+	return status, nil
+}
+
+func (c *restClient) ListDownloads(ctx context.Context) ([]DownloadJobStatus, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/download/jobs"), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	statuses := []DownloadJobStatus{}
+	if err = gimlet.GetJSON(resp.Body, &statuses); err != nil {
+		return nil, errors.Wrap(err, "problem reading download job statuses from response")
+	}
+
+	return statuses, nil
+}
+
+func (c *restClient) getDownloadStatus(ctx context.Context, route string, id string) (DownloadJobStatus, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL(route, id), nil)
+	if err != nil {
+		return DownloadJobStatus{}, err
+	}
+	defer resp.Body.Close()
+
+	status := DownloadJobStatus{}
+	if err = gimlet.GetJSON(resp.Body, &status); err != nil {
+		return DownloadJobStatus{}, errors.Wrap(err, "problem reading download job status from response")
+	}
+
+	return status, nil
+}
+
+func (c *restClient) GetDownload(ctx context.Context, id string) (DownloadJobStatus, error) {
+	return c.getDownloadStatus(ctx, "/download/jobs/%s", id)
+}
+
+func (c *restClient) CancelDownload(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, c.getURL("/download/jobs/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *restClient) WaitDownload(ctx context.Context, id string) (DownloadJobStatus, error) {
+	return c.getDownloadStatus(ctx, "/download/jobs/%s/wait", id)
+}
+
 // ConfigureCache changes the cache configurations.
 func (c *restClient) ConfigureCache(ctx context.Context, opts CacheOptions) error {
 	body, err := makeBody(opts)
@@ -315,6 +487,145 @@ func (c *restClient) ConfigureCache(ctx context.Context, opts CacheOptions) erro
 	return nil
 }
 
+func (c *restClient) ListCachedDownloads(ctx context.Context) ([]DownloadCacheEntry, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/download/cache/entries"), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entries := []DownloadCacheEntry{}
+	if err = gimlet.GetJSON(resp.Body, &entries); err != nil {
+		return nil, errors.Wrap(err, "problem reading download cache entries from response")
+	}
+
+	return entries, nil
+}
+
+func (c *restClient) GetCachedDownload(ctx context.Context, digest string) (DownloadCacheEntry, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/download/cache/entries/%s", digest), nil)
+	if err != nil {
+		return DownloadCacheEntry{}, err
+	}
+	defer resp.Body.Close()
+
+	entry := DownloadCacheEntry{}
+	if err = gimlet.GetJSON(resp.Body, &entry); err != nil {
+		return DownloadCacheEntry{}, errors.Wrap(err, "problem reading download cache entry from response")
+	}
+
+	return entry, nil
+}
+
+func (c *restClient) PinCachedDownload(ctx context.Context, digest string, pinned bool) error {
+	method := http.MethodPost
+	if !pinned {
+		method = http.MethodDelete
+	}
+
+	resp, err := c.doRequest(ctx, method, c.getURL("/download/cache/entries/%s/pin", digest), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *restClient) EvictCachedDownload(ctx context.Context, digest string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, c.getURL("/download/cache/entries/%s", digest), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// ConfigureDownloadSources sets the default download sources of the service.
+func (c *restClient) ConfigureDownloadSources(ctx context.Context, sources DownloadSources) error {
+	body, err := makeBody(sources)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/download/sources"), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// ConfigureDownloads changes how the service runs download jobs.
+func (c *restClient) ConfigureDownloads(ctx context.Context, opts DownloadOptions) error {
+	body, err := makeBody(opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/download/options"), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *restClient) GetDownloadStatus(ctx context.Context) (DownloadStatus, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/download/status"), nil)
+	if err != nil {
+		return DownloadStatus{}, err
+	}
+	defer resp.Body.Close()
+
+	status := DownloadStatus{}
+	if err = gimlet.GetJSON(resp.Body, &status); err != nil {
+		return DownloadStatus{}, errors.Wrap(err, "problem reading download status from response")
+	}
+
+	return status, nil
+}
+
+// UploadFile copies the local file or directory to the remote path.
+func (c *restClient) UploadFile(ctx context.Context, localPath, remotePath string) error {
+	content, archive, err := OpenFileTransfer(localPath, 0)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	route := c.getURL("/file") + "?" + url.Values{"path": []string{remotePath}}.Encode()
+	req, err := http.NewRequest(http.MethodPut, route, content)
+	if err != nil {
+		return errors.Wrap(err, "problem building request")
+	}
+	if archive {
+		req.Header.Set("Content-Type", fileTransferArchiveContentType)
+	} else {
+		req.Header.Set("Content-Type", fileTransferContentType)
+	}
+
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "problem making request")
+	}
+	defer resp.Body.Close()
+
+	return errors.WithStack(handleError(resp))
+}
+
+// FetchFile copies the remote file or directory to the local path.
+func (c *restClient) FetchFile(ctx context.Context, remotePath, localPath string) error {
+	route := c.getURL("/file") + "?" + url.Values{"path": []string{remotePath}}.Encode()
+	resp, err := c.doRequest(ctx, http.MethodGet, route, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	archive := resp.Header.Get("Content-Type") == fileTransferArchiveContentType
+	return errors.Wrap(ReceiveFileTransfer(resp.Body, localPath, archive, 0), "problem fetching file")
+}
+
 type restProcess struct {
 	id              string
 	client          *restClient
@@ -3,7 +3,9 @@ package jasper
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"sync"
@@ -13,7 +15,6 @@ import (
 	"github.com/evergreen-ci/gimlet"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
-	"github.com/mongodb/grip/send"
 	"github.com/pkg/errors"
 	"github.com/tychoish/lru"
 )
@@ -21,11 +22,19 @@ import (
 // Service defines a REST service that provides a remote manager, using
 // gimlet to publish routes.
 type Service struct {
-	hostID     string
-	manager    Manager
-	cache      *lru.Cache
-	cacheOpts  CacheOptions
-	cacheMutex sync.RWMutex
+	hostID        string
+	manager       Manager
+	cache         *lru.Cache
+	cacheOpts     CacheOptions
+	cacheMutex    sync.RWMutex
+	metrics       *ServiceMetrics
+	artifacts     *ProcessArtifacts
+	downloads     *DownloadJobs
+	downloadCache *DownloadCache
+	sources       DownloadSources
+	sourcesMutex  sync.RWMutex
+	fileTransfers FileTransferOptions
+	cancel        context.CancelFunc
 }
 
 // NewManagerService creates a service object around an existing
@@ -39,9 +48,35 @@ func NewManagerService(m Manager) *Service {
 
 	return &Service{
 		manager: m,
+		metrics: NewServiceMetrics(),
 	}
 }
 
+// SetArtifacts configures the service to serve the process artifacts in
+// the given directory, which should be the one that the service's
+// manager captures artifacts into with ArtifactMiddleware.
+func (s *Service) SetArtifacts(artifacts *ProcessArtifacts) {
+	s.artifacts = artifacts
+}
+
+// SetDownloadCache configures the service to serve downloads from, and
+// add them to, the given download cache instead of the
+// DefaultDownloadCache.
+func (s *Service) SetDownloadCache(cache *DownloadCache) {
+	s.downloadCache = cache
+}
+
+// SetFileTransfers configures the paths that clients can upload files to
+// and fetch files from. File transfers are disabled until it is called.
+func (s *Service) SetFileTransfers(opts FileTransferOptions) error {
+	if err := opts.Validate(); err != nil {
+		return errors.Wrap(err, "problem validating file transfer options")
+	}
+
+	s.fileTransfers = opts
+	return nil
+}
+
 const (
 	// DefaultCachePruneDelay is the duration between LRU cache prunes.
 	DefaultCachePruneDelay = 10 * time.Second
@@ -50,7 +85,12 @@ const (
 )
 
 // App constructs and returns a gimlet application for this
-// service. It attaches no middleware and does not start the service.
+// service. It attaches no middleware and does not start the service. The
+// application reports metrics about the service, in the Prometheus text
+// format, from the /metrics route. Call Close once the application stops
+// to stop the service's download jobs and background work. If the download
+// cache or the download jobs cannot be set up, the routes that use them
+// respond that the service is unavailable.
 func (s *Service) App() *gimlet.APIApp {
 	s.hostID, _ = os.Hostname()
 	s.cache = lru.NewCache()
@@ -60,46 +100,106 @@ func (s *Service) App() *gimlet.APIApp {
 	s.cacheOpts.MaxSize = DefaultMaxCacheSize
 	s.cacheOpts.Disabled = false
 
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	var err error
+	if s.downloadCache == nil {
+		s.downloadCache, err = DefaultDownloadCache()
+		grip.Critical(errors.Wrap(err, "problem creating download cache"))
+	}
+	s.downloads, err = NewDownloadJobs(ctx, DefaultDownloadJobWorkers)
+	grip.Critical(errors.Wrap(err, "problem starting download jobs"))
+	if s.downloads != nil {
+		s.downloads.SetCache(s.downloadCache)
+	}
+
 	app := gimlet.NewApp()
 
-	app.AddRoute("/").Version(1).Get().Handler(s.rootRoute)
-	app.AddRoute("/create").Version(1).Post().Handler(s.createProcess)
-	app.AddRoute("/download").Version(1).Post().Handler(s.downloadFile)
-	app.AddRoute("/download/cache").Version(1).Post().Handler(s.configureCache)
-	app.AddRoute("/download/mongodb").Version(1).Post().Handler(s.downloadMongoDB)
-	app.AddRoute("/list/{filter}").Version(1).Get().Handler(s.listProcesses)
-	app.AddRoute("/list/group/{name}").Version(1).Get().Handler(s.listGroupMembers)
-	app.AddRoute("/process/{id}").Version(1).Get().Handler(s.getProcess)
-	app.AddRoute("/process/{id}/buildlogger-urls").Version(1).Get().Handler(s.getBuildloggerURLs)
-	app.AddRoute("/process/{id}/tags").Version(1).Get().Handler(s.getProcessTags)
-	app.AddRoute("/process/{id}/tags").Version(1).Delete().Handler(s.deleteProcessTags)
-	app.AddRoute("/process/{id}/tags").Version(1).Post().Handler(s.addProcessTag)
-	app.AddRoute("/process/{id}/wait").Version(1).Get().Handler(s.waitForProcess)
-	app.AddRoute("/process/{id}/respawn").Version(1).Get().Handler(s.respawnProcess)
-	app.AddRoute("/process/{id}/metrics").Version(1).Get().Handler(s.processMetrics)
-	app.AddRoute("/process/{id}/signal/{signal}").Version(1).Patch().Handler(s.signalProcess)
-	app.AddRoute("/process/{id}/logs").Version(1).Get().Handler(s.getLogs)
-	app.AddRoute("/clear").Version(1).Post().Handler(s.clearManager)
-	app.AddRoute("/close").Version(1).Delete().Handler(s.closeManager)
-
-	go s.backgroundPrune()
+	app.AddRoute("/").Version(1).Get().Handler(s.metrics.InstrumentHandler("/", s.rootRoute))
+	app.AddRoute("/create").Version(1).Post().Handler(s.metrics.InstrumentHandler("/create", s.createProcess))
+	app.AddRoute("/download").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download", s.downloadFile))
+	app.AddRoute("/download/cache").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download/cache", s.configureCache))
+	app.AddRoute("/download/cache/entries").Version(1).Get().Handler(s.metrics.InstrumentHandler("/download/cache/entries", s.listCachedDownloads))
+	app.AddRoute("/download/cache/entries/{digest}").Version(1).Get().Handler(s.metrics.InstrumentHandler("/download/cache/entries/{digest}", s.getCachedDownload))
+	app.AddRoute("/download/cache/entries/{digest}").Version(1).Delete().Handler(s.metrics.InstrumentHandler("/download/cache/entries/{digest}", s.evictCachedDownload))
+	app.AddRoute("/download/cache/entries/{digest}/pin").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download/cache/entries/{digest}/pin", s.pinCachedDownload))
+	app.AddRoute("/download/cache/entries/{digest}/pin").Version(1).Delete().Handler(s.metrics.InstrumentHandler("/download/cache/entries/{digest}/pin", s.unpinCachedDownload))
+	app.AddRoute("/download/jobs").Version(1).Get().Handler(s.metrics.InstrumentHandler("/download/jobs", s.listDownloads))
+	app.AddRoute("/download/jobs").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download/jobs", s.startDownload))
+	app.AddRoute("/download/jobs/{id}").Version(1).Get().Handler(s.metrics.InstrumentHandler("/download/jobs/{id}", s.getDownload))
+	app.AddRoute("/download/jobs/{id}").Version(1).Delete().Handler(s.metrics.InstrumentHandler("/download/jobs/{id}", s.cancelDownload))
+	app.AddRoute("/download/jobs/{id}/wait").Version(1).Get().Handler(s.metrics.InstrumentHandler("/download/jobs/{id}/wait", s.waitDownload))
+	app.AddRoute("/download/sources").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download/sources", s.configureDownloadSources))
+	app.AddRoute("/download/options").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download/options", s.configureDownloads))
+	app.AddRoute("/download/status").Version(1).Get().Handler(s.metrics.InstrumentHandler("/download/status", s.getDownloadStatus))
+	app.AddRoute("/download/mongodb").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download/mongodb", s.downloadMongoDB))
+	app.AddRoute("/download/mongodb/resolve").Version(1).Post().Handler(s.metrics.InstrumentHandler("/download/mongodb/resolve", s.resolveMongoDB))
+	app.AddRoute("/file").Version(1).Get().Handler(s.metrics.InstrumentHandler("/file", s.fetchFile))
+	app.AddRoute("/file").Version(1).Put().Handler(s.metrics.InstrumentHandler("/file", s.uploadFile))
+	app.AddRoute("/list/{filter}").Version(1).Get().Handler(s.metrics.InstrumentHandler("/list/{filter}", s.listProcesses))
+	app.AddRoute("/list/group/{name}").Version(1).Get().Handler(s.metrics.InstrumentHandler("/list/group/{name}", s.listGroupMembers))
+	app.AddRoute("/process/{id}").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}", s.getProcess))
+	app.AddRoute("/process/{id}/buildlogger-urls").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/buildlogger-urls", s.getBuildloggerURLs))
+	app.AddRoute("/process/{id}/tags").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/tags", s.getProcessTags))
+	app.AddRoute("/process/{id}/tags").Version(1).Delete().Handler(s.metrics.InstrumentHandler("/process/{id}/tags", s.deleteProcessTags))
+	app.AddRoute("/process/{id}/tags").Version(1).Post().Handler(s.metrics.InstrumentHandler("/process/{id}/tags", s.addProcessTag))
+	app.AddRoute("/process/{id}/wait").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/wait", s.waitForProcess))
+	app.AddRoute("/process/{id}/respawn").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/respawn", s.respawnProcess))
+	app.AddRoute("/process/{id}/metrics").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/metrics", s.processMetrics))
+	app.AddRoute("/process/{id}/signal/{signal}").Version(1).Patch().Handler(s.metrics.InstrumentHandler("/process/{id}/signal/{signal}", s.signalProcess))
+	app.AddRoute("/process/{id}/logs").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/logs", s.getLogs))
+	app.AddRoute("/process/{id}/logs/query").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/logs/query", s.queryLogs))
+	app.AddRoute("/process/{id}/artifacts/{name}").Version(1).Get().Handler(s.metrics.InstrumentHandler("/process/{id}/artifacts/{name}", s.getArtifact))
+	app.AddRoute("/clear").Version(1).Post().Handler(s.metrics.InstrumentHandler("/clear", s.clearManager))
+	app.AddRoute("/close").Version(1).Delete().Handler(s.metrics.InstrumentHandler("/close", s.closeManager))
+	app.AddRoute("/metrics").Version(1).Get().Handler(s.getMetrics)
+
+	go s.backgroundPrune(ctx)
 
 	return app
 }
 
-func (s *Service) backgroundPrune() {
+// Close cancels the service's download jobs that have not finished and
+// stops its background work. The service's application should not be used
+// after it is closed.
+func (s *Service) Close() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.downloads != nil {
+		s.downloads.Close()
+	}
+}
+
+func (s *Service) backgroundPrune(ctx context.Context) {
 	s.cacheMutex.RLock()
 	timer := time.NewTimer(s.cacheOpts.PruneDelay)
 	s.cacheMutex.RUnlock()
+	defer timer.Stop()
 
 	for {
-		<-timer.C
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
 		s.cacheMutex.RLock()
 		if !s.cacheOpts.Disabled {
-			if err := s.cache.Prune(s.cacheOpts.MaxSize, nil, false); err != nil {
+			sizeBefore := s.cache.Size()
+			err := s.cache.Prune(s.cacheOpts.MaxSize, nil, false)
+			if err != nil {
 				grip.Error(errors.Wrap(err, "error during cache pruning"))
 			}
+			s.metrics.ObservePrune(sizeBefore, s.cache.Size(), err)
 		}
+		if s.downloadCache != nil {
+			sizeBefore := s.downloadCache.Size()
+			err := s.downloadCache.Prune(s.downloadCache.MaxSize())
+			grip.Error(errors.Wrap(err, "error during download cache pruning"))
+			s.metrics.ObserveDownloadCachePrune(sizeBefore, s.downloadCache.Size(), len(s.downloadCache.List()), err)
+		}
+		s.metrics.ObserveCache(s.cache.Size(), s.cache.Count())
 		timer.Reset(s.cacheOpts.PruneDelay)
 		s.cacheMutex.RUnlock()
 	}
@@ -108,7 +208,7 @@ func (s *Service) backgroundPrune() {
 func getProcInfoNoHang(ctx context.Context, p Process) ProcessInfo {
 	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
 	defer cancel()
-	return p.Info(ctx)
+	return p.Info(ctx).Redacted()
 }
 
 func writeError(rw http.ResponseWriter, err gimlet.ErrorResponse) {
@@ -147,12 +247,13 @@ func (s *Service) createProcess(rw http.ResponseWriter, r *http.Request) {
 	var ctx context.Context
 	var cancel context.CancelFunc
 	if opts.Timeout > 0 {
-		ctx, cancel = context.WithTimeout(context.Background(), opts.Timeout)
+		ctx, cancel = context.WithTimeout(WithServiceMetrics(context.Background(), s.metrics), opts.Timeout)
 	} else {
-		ctx, cancel = context.WithCancel(context.Background())
+		ctx, cancel = context.WithCancel(WithServiceMetrics(context.Background(), s.metrics))
 	}
 
 	proc, err := s.manager.Create(ctx, opts)
+	s.metrics.ObserveCreate(ctx, proc, err)
 	if err != nil {
 		cancel()
 		writeError(rw, gimlet.ErrorResponse{
@@ -401,8 +502,9 @@ func (s *Service) respawnProcess(rw http.ResponseWriter, r *http.Request) {
 
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how createProcess() does this same thing.
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(WithServiceMetrics(context.Background(), s.metrics))
 	newProc, err := proc.Respawn(ctx)
+	s.metrics.ObserveCreate(ctx, newProc, err)
 	if err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusBadRequest,
@@ -473,6 +575,11 @@ func (s *Service) signalProcess(rw http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
 	var info DownloadInfo
 	if err := gimlet.GetJSON(r.Body, &info); err != nil {
 		writeError(rw, gimlet.ErrorResponse{
@@ -482,6 +589,8 @@ func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	info.Sources = info.Sources.Merge(s.downloadSources())
+
 	if err := info.Validate(); err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusBadRequest,
@@ -490,7 +599,7 @@ func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := info.Download(); err != nil {
+	if err := downloads.Download(r.Context(), info); err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusBadRequest,
 			Message:    errors.Wrapf(err, "problem occurred during file download for URL %s", info.URL).Error(),
@@ -501,6 +610,115 @@ func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, struct{}{})
 }
 
+func (s *Service) startDownload(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
+	var info DownloadInfo
+	if err := gimlet.GetJSON(r.Body, &info); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem reading request").Error(),
+		})
+		return
+	}
+
+	info.Sources = info.Sources.Merge(s.downloadSources())
+
+	status, err := downloads.Start(info)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrapf(err, "problem starting download for URL %s", info.URL).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, status)
+}
+
+func (s *Service) listDownloads(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
+	gimlet.WriteJSON(rw, downloads.List())
+}
+
+func (s *Service) getDownload(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
+	status, err := downloads.Get(gimlet.GetVars(r)["id"])
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, status)
+}
+
+func (s *Service) cancelDownload(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
+	id := gimlet.GetVars(r)["id"]
+	if _, err := downloads.Get(id); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	if err := downloads.Cancel(id); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) waitDownload(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
+	id := gimlet.GetVars(r)["id"]
+	if _, err := downloads.Get(id); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	status, err := downloads.Wait(r.Context(), id)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, status)
+}
+
 func (s *Service) getLogs(rw http.ResponseWriter, r *http.Request) {
 	vars := gimlet.GetVars(r)
 	id := vars["id"]
@@ -515,26 +733,94 @@ func (s *Service) getLogs(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info := getProcInfoNoHang(ctx, proc)
-	// Implicitly assumes that there's at most 1 in-memory logger.
-	var inMemorySender *send.InMemorySender
-	for _, logger := range info.Options.Output.Loggers {
-		sender, ok := logger.sender.(*send.InMemorySender)
-		if ok {
-			inMemorySender = sender
-			break
+	logs, err := GetInMemoryLogs(ctx, proc)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, logs)
+}
+
+// parseLogQuery reads a LogQuery from the query parameters of a request.
+func parseLogQuery(values url.Values) (LogQuery, error) {
+	q := LogQuery{
+		Stream:  LogStream(values.Get("stream")),
+		Pattern: values.Get("pattern"),
+	}
+
+	catcher := grip.NewBasicCatcher()
+	if since := values.Get("since"); since != "" {
+		var err error
+		q.Since, err = time.Parse(time.RFC3339Nano, since)
+		catcher.Add(errors.Wrapf(err, "invalid timestamp '%s'", since))
+	}
+	for key, value := range map[string]*int{"tail": &q.Tail, "offset": &q.Offset, "limit": &q.Limit} {
+		if param := values.Get(key); param != "" {
+			var err error
+			*value, err = strconv.Atoi(param)
+			catcher.Add(errors.Wrapf(err, "invalid %s '%s'", key, param))
 		}
 	}
 
-	if inMemorySender == nil {
+	return q, catcher.Resolve()
+}
+
+func (s *Service) queryLogs(rw http.ResponseWriter, r *http.Request) {
+	vars := gimlet.GetVars(r)
+	id := vars["id"]
+	ctx := r.Context()
+
+	q, err := parseLogQuery(r.URL.Query())
+	if err == nil {
+		err = q.Validate()
+	}
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid log query").Error(),
+		})
+		return
+	}
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	records, err := QueryInMemoryLogs(ctx, proc, q)
+	if err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusInternalServerError,
-			Message:    errors.Errorf("no in-memory logger found for process '%s'", id).Error(),
+			Message:    err.Error(),
 		})
 		return
 	}
 
-	logs, err := inMemorySender.GetString()
+	gimlet.WriteJSON(rw, records)
+}
+
+func (s *Service) getArtifact(rw http.ResponseWriter, r *http.Request) {
+	vars := gimlet.GetVars(r)
+	id := vars["id"]
+	name := vars["name"]
+
+	if s.artifacts == nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    "process artifacts are not enabled",
+		})
+		return
+	}
+
+	file, err := s.artifacts.Open(id, name)
 	if err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusNotFound,
@@ -542,8 +828,25 @@ func (s *Service) getLogs(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	defer file.Close()
 
-	gimlet.WriteJSON(rw, logs)
+	if name == ArtifactInfo {
+		rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	} else {
+		rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	}
+	rw.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(rw, file); err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message":  "problem writing artifact",
+			"id":       id,
+			"artifact": name,
+		}))
+	}
+}
+
+func (s *Service) getMetrics(rw http.ResponseWriter, r *http.Request) {
+	s.metrics.Handler(s.manager)(rw, r)
 }
 
 func (s *Service) clearManager(rw http.ResponseWriter, r *http.Request) {
@@ -564,6 +867,11 @@ func (s *Service) closeManager(rw http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Service) downloadMongoDB(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
 	opts := MongoDBDownloadOptions{}
 	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
 		writeError(rw, gimlet.ErrorResponse{
@@ -573,6 +881,8 @@ func (s *Service) downloadMongoDB(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts.Sources = opts.Sources.Merge(s.downloadSources())
+
 	if err := opts.Validate(); err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusBadRequest,
@@ -581,7 +891,7 @@ func (s *Service) downloadMongoDB(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := SetupDownloadMongoDBReleases(r.Context(), s.cache, opts); err != nil {
+	if _, err := downloads.StartMongoDB(r.Context(), s.cache, opts); err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusInternalServerError,
 			Message:    errors.Wrap(err, "problem in download setup").Error(),
@@ -592,6 +902,38 @@ func (s *Service) downloadMongoDB(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, struct{}{})
 }
 
+func (s *Service) resolveMongoDB(rw http.ResponseWriter, r *http.Request) {
+	opts := MongoDBDownloadOptions{}
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem reading request").Error(),
+		})
+		return
+	}
+
+	opts.Sources = opts.Sources.Merge(s.downloadSources())
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem validating MongoDB download options").Error(),
+		})
+		return
+	}
+
+	releases, err := ResolveMongoDBReleases(r.Context(), opts)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem resolving MongoDB releases").Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, releases)
+}
+
 func (s *Service) configureCache(rw http.ResponseWriter, r *http.Request) {
 	opts := CacheOptions{}
 	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
@@ -622,3 +964,241 @@ func (s *Service) configureCache(rw http.ResponseWriter, r *http.Request) {
 
 	gimlet.WriteJSON(rw, struct{}{})
 }
+
+func (s *Service) downloadSources() DownloadSources {
+	s.sourcesMutex.RLock()
+	defer s.sourcesMutex.RUnlock()
+
+	return s.sources
+}
+
+func (s *Service) configureDownloadSources(rw http.ResponseWriter, r *http.Request) {
+	sources := DownloadSources{}
+	if err := gimlet.GetJSON(r.Body, &sources); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem reading request").Error(),
+		})
+		return
+	}
+
+	if err := sources.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem validating download sources").Error(),
+		})
+		return
+	}
+
+	s.sourcesMutex.Lock()
+	defer s.sourcesMutex.Unlock()
+	s.sources = sources
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) configureDownloads(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
+	opts := DownloadOptions{}
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem reading request").Error(),
+		})
+		return
+	}
+
+	if err := downloads.Configure(opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) getDownloadStatus(rw http.ResponseWriter, r *http.Request) {
+	downloads, ok := s.requireDownloads(rw)
+	if !ok {
+		return
+	}
+
+	gimlet.WriteJSON(rw, downloads.Status())
+}
+
+// fileTransferContentType is the content type of the body of file
+// transfers, unless they are a tar archive of a directory.
+const fileTransferContentType = "application/octet-stream"
+
+// fileTransferArchiveContentType is the content type of the body of file
+// transfers that are a tar archive of a directory.
+const fileTransferArchiveContentType = "application/x-tar"
+
+func (s *Service) uploadFile(rw http.ResponseWriter, r *http.Request) {
+	path, err := s.fileTransfers.resolve(r.URL.Query().Get("path"))
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	archive := r.Header.Get("Content-Type") == fileTransferArchiveContentType
+	if err = ReceiveFileTransfer(r.Body, path, archive, s.fileTransfers.maxSize()); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "problem uploading file").Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) fetchFile(rw http.ResponseWriter, r *http.Request) {
+	path, err := s.fileTransfers.resolve(r.URL.Query().Get("path"))
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	content, archive, err := OpenFileTransfer(path, s.fileTransfers.maxSize())
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrap(err, "problem fetching file").Error(),
+		})
+		return
+	}
+	defer content.Close()
+
+	if archive {
+		rw.Header().Set("Content-Type", fileTransferArchiveContentType)
+	} else {
+		rw.Header().Set("Content-Type", fileTransferContentType)
+	}
+	rw.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(rw, content); err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "problem writing file",
+			"path":    path,
+		}))
+		// Abort the response, so that the client does not mistake a
+		// truncated archive for a complete one.
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// requireDownloadCache returns the service's download cache, or writes an
+// error if the service does not have one.
+func (s *Service) requireDownloadCache(rw http.ResponseWriter) (*DownloadCache, bool) {
+	if s.downloadCache == nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusServiceUnavailable,
+			Message:    "download cache is not available",
+		})
+		return nil, false
+	}
+	return s.downloadCache, true
+}
+
+// requireDownloads returns the service's download jobs, or writes an
+// error if they could not be started.
+func (s *Service) requireDownloads(rw http.ResponseWriter) (*DownloadJobs, bool) {
+	if s.downloads == nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusServiceUnavailable,
+			Message:    "download jobs are not available",
+		})
+		return nil, false
+	}
+	return s.downloads, true
+}
+
+func (s *Service) listCachedDownloads(rw http.ResponseWriter, r *http.Request) {
+	cache, ok := s.requireDownloadCache(rw)
+	if !ok {
+		return
+	}
+
+	gimlet.WriteJSON(rw, cache.List())
+}
+
+func (s *Service) getCachedDownload(rw http.ResponseWriter, r *http.Request) {
+	cache, ok := s.requireDownloadCache(rw)
+	if !ok {
+		return
+	}
+
+	entry, err := cache.Get(gimlet.GetVars(r)["digest"])
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, entry)
+}
+
+func (s *Service) evictCachedDownload(rw http.ResponseWriter, r *http.Request) {
+	cache, ok := s.requireDownloadCache(rw)
+	if !ok {
+		return
+	}
+
+	digest := gimlet.GetVars(r)["digest"]
+	if _, err := cache.Get(digest); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	if err := cache.Evict(digest); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) pinCachedDownload(rw http.ResponseWriter, r *http.Request) {
+	s.setCachedDownloadPinned(rw, r, true)
+}
+
+func (s *Service) unpinCachedDownload(rw http.ResponseWriter, r *http.Request) {
+	s.setCachedDownloadPinned(rw, r, false)
+}
+
+func (s *Service) setCachedDownloadPinned(rw http.ResponseWriter, r *http.Request, pinned bool) {
+	cache, ok := s.requireDownloadCache(rw)
+	if !ok {
+		return
+	}
+
+	if err := cache.Pin(gimlet.GetVars(r)["digest"], pinned); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
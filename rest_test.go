@@ -2,6 +2,7 @@ package jasper
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -61,6 +62,34 @@ func TestRestService(t *testing.T) {
 			assert.NoError(t, err)
 			assert.NotNil(t, proc)
 		},
+		"CreateTimeoutJSONRoundTrips": func(ctx context.Context, t *testing.T, srv *Service, client *restClient) {
+			for payload, expected := range map[string]time.Duration{
+				`{"args": ["ls"], "timeout_secs": 300}`:                      300 * time.Second,
+				`{"args": ["ls"], "timeout": 1500000000}`:                    1500 * time.Millisecond,
+				`{"args": ["ls"], "timeout": 1500000000, "timeout_secs": 1}`: 1500 * time.Millisecond,
+			} {
+				req, err := http.NewRequest(http.MethodPost, client.getURL("/create"), strings.NewReader(payload))
+				require.NoError(t, err)
+				rw := httptest.NewRecorder()
+				srv.createProcess(rw, req)
+				require.Equal(t, http.StatusOK, rw.Code, payload)
+
+				info := ProcessInfo{}
+				require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &info))
+				assert.Equal(t, expected, info.Options.Timeout, payload)
+				assert.Equal(t, int(expected.Seconds()), info.Options.TimeoutSecs, payload)
+			}
+
+			req, err := http.NewRequest(http.MethodPost, client.getURL("/create"), strings.NewReader(`{"args": ["ls"], "timeout": 5000000000, "timeout_secs": 1}`))
+			require.NoError(t, err)
+			rw := httptest.NewRecorder()
+			srv.createProcess(rw, req)
+			assert.Equal(t, http.StatusBadRequest, rw.Code)
+
+			proc, err := client.Create(ctx, &CreateOptions{Args: []string{"ls"}, Timeout: 1500 * time.Millisecond})
+			require.NoError(t, err)
+			assert.Equal(t, 1500*time.Millisecond, proc.Info(ctx).Options.Timeout)
+		},
 		"ListErrorsWithInvalidFilter": func(ctx context.Context, t *testing.T, srv *Service, client *restClient) {
 			list, err := client.List(ctx, "foo")
 			assert.Error(t, err)
@@ -680,6 +709,48 @@ func TestRestService(t *testing.T) {
 			assert.NotZero(t, info.Size())
 
 		},
+		"MetricsRouteReportsServiceActivity": func(ctx context.Context, t *testing.T, srv *Service, client *restClient) {
+			proc, err := client.Create(ctx, sleepCreateOpts(10))
+			require.NoError(t, err)
+
+			resp, err := client.doRequest(ctx, http.MethodGet, client.getURL("/metrics"), nil)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, MetricsContentType, resp.Header.Get("Content-Type"))
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `jasper_processes{state="running"} 1`)
+			assert.Contains(t, string(body), "jasper_processes_created_total 1\n")
+			assert.Contains(t, string(body), `jasper_request_duration_seconds_count{route="/create"} 1`)
+
+			require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+		},
+		"DownloadRoutesAreUnavailableWithoutDownloads": func(ctx context.Context, t *testing.T, srv *Service, client *restClient) {
+			downloads, cache := srv.downloads, srv.downloadCache
+			srv.downloads, srv.downloadCache = nil, nil
+			defer func() { srv.downloads, srv.downloadCache = downloads, cache }()
+
+			for route, handler := range map[string]http.HandlerFunc{
+				"/download":               srv.downloadFile,
+				"/download/jobs":          srv.startDownload,
+				"/download/mongodb":       srv.downloadMongoDB,
+				"/download/options":       srv.configureDownloads,
+				"/download/status":        srv.getDownloadStatus,
+				"/download/cache/entries": srv.listCachedDownloads,
+			} {
+				body, err := makeBody(struct{}{})
+				require.NoError(t, err)
+				req, err := http.NewRequest(http.MethodPost, client.getURL(route), body)
+				require.NoError(t, err)
+				rw := httptest.NewRecorder()
+				handler(rw, req)
+				assert.Equal(t, http.StatusServiceUnavailable, rw.Code, route)
+			}
+
+			_, err := client.ListDownloads(ctx)
+			assert.Error(t, err)
+		},
 		// "": func(ctx context.Context, t *testing.T, srv *Service, client *restClient) {},
 	} {
 		t.Run(name, func(t *testing.T) {
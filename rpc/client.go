@@ -6,6 +6,7 @@ import (
 	"syscall"
 
 	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper"
 	internal "github.com/mongodb/jasper/rpc/internal"
 	"github.com/pkg/errors"
@@ -18,8 +19,10 @@ type rpcManager struct {
 
 // TODO provide some better way of constructing this object
 
-// NewRPCManager is a constructor for a rpcManager.
-func NewRPCManager(cc *grpc.ClientConn) jasper.Manager {
+// NewRPCManager is a constructor for a rpcManager. In addition to the
+// jasper.Manager methods, the returned client exposes the operations of the
+// remote jasper service that are not part of the Manager interface.
+func NewRPCManager(cc *grpc.ClientConn) jasper.RemoteClient {
 	return &rpcManager{
 		client: internal.NewJasperProcessManagerClient(cc),
 	}
@@ -111,6 +114,359 @@ func (m *rpcManager) Close(ctx context.Context) error {
 	return errors.New(resp.Text)
 }
 
+func (m *rpcManager) DownloadFile(ctx context.Context, info jasper.DownloadInfo) error {
+	resp, err := m.client.DownloadFile(ctx, internal.ConvertDownloadInfo(info))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) DownloadMongoDB(ctx context.Context, opts jasper.MongoDBDownloadOptions) error {
+	resp, err := m.client.DownloadMongoDB(ctx, internal.ConvertMongoDBDownloadOptions(opts))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) StartDownload(ctx context.Context, info jasper.DownloadInfo) (jasper.DownloadJobStatus, error) {
+	status, err := m.client.StartDownload(ctx, internal.ConvertDownloadInfo(info))
+	if err != nil {
+		return jasper.DownloadJobStatus{}, errors.WithStack(err)
+	}
+
+	return status.Export(), nil
+}
+
+func (m *rpcManager) ListDownloads(ctx context.Context) ([]jasper.DownloadJobStatus, error) {
+	statuses, err := m.client.ListDownloads(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return statuses.Export(), nil
+}
+
+func (m *rpcManager) GetDownload(ctx context.Context, id string) (jasper.DownloadJobStatus, error) {
+	status, err := m.client.GetDownload(ctx, &internal.DownloadJobID{Value: id})
+	if err != nil {
+		return jasper.DownloadJobStatus{}, errors.WithStack(err)
+	}
+
+	return status.Export(), nil
+}
+
+func (m *rpcManager) CancelDownload(ctx context.Context, id string) error {
+	resp, err := m.client.CancelDownload(ctx, &internal.DownloadJobID{Value: id})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) WaitDownload(ctx context.Context, id string) (jasper.DownloadJobStatus, error) {
+	status, err := m.client.WaitDownload(ctx, &internal.DownloadJobID{Value: id})
+	if err != nil {
+		return jasper.DownloadJobStatus{}, errors.WithStack(err)
+	}
+
+	return status.Export(), nil
+}
+
+func (m *rpcManager) ResolveMongoDB(ctx context.Context, opts jasper.MongoDBDownloadOptions) ([]jasper.MongoDBRelease, error) {
+	releases, err := m.client.ResolveMongoDB(ctx, internal.ConvertMongoDBDownloadOptions(opts))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return releases.Export(), nil
+}
+
+func (m *rpcManager) ConfigureCache(ctx context.Context, opts jasper.CacheOptions) error {
+	resp, err := m.client.ConfigureCache(ctx, internal.ConvertCacheOptions(opts))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) ListCachedDownloads(ctx context.Context) ([]jasper.DownloadCacheEntry, error) {
+	entries, err := m.client.ListCachedDownloads(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return entries.Export(), nil
+}
+
+func (m *rpcManager) GetCachedDownload(ctx context.Context, digest string) (jasper.DownloadCacheEntry, error) {
+	entry, err := m.client.GetCachedDownload(ctx, &internal.DownloadCacheDigest{Value: digest})
+	if err != nil {
+		return jasper.DownloadCacheEntry{}, errors.WithStack(err)
+	}
+
+	return entry.Export(), nil
+}
+
+func (m *rpcManager) PinCachedDownload(ctx context.Context, digest string, pinned bool) error {
+	resp, err := m.client.PinCachedDownload(ctx, &internal.DownloadCachePin{Digest: digest, Pinned: pinned})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) EvictCachedDownload(ctx context.Context, digest string) error {
+	resp, err := m.client.EvictCachedDownload(ctx, &internal.DownloadCacheDigest{Value: digest})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) ConfigureDownloadSources(ctx context.Context, sources jasper.DownloadSources) error {
+	resp, err := m.client.ConfigureDownloadSources(ctx, internal.ConvertDownloadSources(sources))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) ConfigureDownloads(ctx context.Context, opts jasper.DownloadOptions) error {
+	resp, err := m.client.ConfigureDownloads(ctx, internal.ConvertDownloadOptions(opts))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) GetDownloadStatus(ctx context.Context) (jasper.DownloadStatus, error) {
+	status, err := m.client.GetDownloadStatus(ctx, &empty.Empty{})
+	if err != nil {
+		return jasper.DownloadStatus{}, errors.WithStack(err)
+	}
+
+	return status.Export(), nil
+}
+
+func (m *rpcManager) GetBuildloggerURLs(ctx context.Context, name string) ([]string, error) {
+	urls, err := m.client.GetBuildloggerURLs(ctx, &internal.JasperProcessID{Value: name})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return urls.Export(), nil
+}
+
+func (m *rpcManager) GetLogs(ctx context.Context, name string) ([]string, error) {
+	logs, err := m.client.GetLogs(ctx, &internal.JasperProcessID{Value: name})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return logs.Export(), nil
+}
+
+func (m *rpcManager) QueryLogs(ctx context.Context, name string, q jasper.LogQuery) ([]jasper.LogRecord, error) {
+	records, err := m.client.QueryLogs(ctx, internal.ConvertLogQuery(name, q))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return records.Export()
+}
+
+func (m *rpcManager) GetProcessMetrics(ctx context.Context, name string) ([]message.ProcessInfo, error) {
+	metrics, err := m.client.GetProcessMetrics(ctx, &internal.JasperProcessID{Value: name})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return metrics.Export()
+}
+
+func (m *rpcManager) GetArtifact(ctx context.Context, id, name string) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := m.client.GetArtifact(ctx, &internal.ArtifactRequest{Id: id, Name: name})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "problem getting streaming client")
+	}
+
+	r := &chunkReader{
+		next: func() ([]byte, error) {
+			chunk, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return chunk.Data, nil
+		},
+		cancel: cancel,
+	}
+	// Receive the first chunk, so that a missing artifact is reported
+	// here rather than on the first read.
+	if err = r.recv(); err != nil && err != io.EOF {
+		cancel()
+		return nil, errors.WithStack(err)
+	}
+
+	return r, nil
+}
+
+// chunkReader reads the data of the chunks of a stream.
+type chunkReader struct {
+	next   func() ([]byte, error)
+	cancel context.CancelFunc
+	buf    []byte
+	err    error
+}
+
+func (r *chunkReader) recv() error {
+	data, err := r.next()
+	if err != nil {
+		r.err = err
+		return err
+	}
+	r.buf = data
+	return nil
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if err := r.recv(); err != nil && err != io.EOF {
+			return 0, errors.Wrap(err, "problem receiving stream")
+		}
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *chunkReader) Close() error {
+	r.cancel()
+	return nil
+}
+
+// fileChunkSize is the maximum size of the chunks in which files are
+// uploaded.
+const fileChunkSize = 64 * 1024
+
+func (m *rpcManager) UploadFile(ctx context.Context, localPath, remotePath string) error {
+	content, archive, err := jasper.OpenFileTransfer(localPath, 0)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer content.Close()
+
+	// Canceling the stream if the upload cannot be sent tells the service
+	// that it is incomplete.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := m.client.UploadFile(ctx)
+	if err != nil {
+		return errors.Wrap(err, "problem getting streaming client")
+	}
+
+	sendErr := stream.Send(&internal.FileTransferChunk{Header: &internal.FileTransferHeader{Path: remotePath, Archive: archive}})
+	buf := make([]byte, fileChunkSize)
+	for sendErr == nil {
+		n, err := content.Read(buf)
+		if n > 0 {
+			sendErr = stream.Send(&internal.FileTransferChunk{Data: buf[:n]})
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrapf(err, "problem reading '%s'", localPath)
+		}
+	}
+	// The service ends the stream early if the upload fails, in which
+	// case Send returns io.EOF and CloseAndRecv returns the error.
+	if sendErr != nil && sendErr != io.EOF {
+		return errors.Wrap(sendErr, "problem sending file")
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.Success {
+		return nil
+	}
+
+	return errors.New(resp.Text)
+}
+
+func (m *rpcManager) FetchFile(ctx context.Context, remotePath, localPath string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := m.client.FetchFile(ctx, &internal.FilePath{Value: remotePath})
+	if err != nil {
+		return errors.Wrap(err, "problem getting streaming client")
+	}
+
+	chunk, err := stream.Recv()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if chunk.Header == nil {
+		return errors.New("fetched file did not start with a header")
+	}
+
+	r := &chunkReader{
+		next: func() ([]byte, error) {
+			chunk, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return chunk.Data, nil
+		},
+		cancel: cancel,
+		buf:    chunk.Data,
+	}
+	return errors.Wrap(jasper.ReceiveFileTransfer(r, localPath, chunk.Header.Archive, 0), "problem fetching file")
+}
+
 type rpcProcess struct {
 	client internal.JasperProcessManagerClient
 	info   *internal.ProcessInfo
@@ -159,10 +515,7 @@ func (p *rpcProcess) Complete(ctx context.Context) bool {
 }
 
 func (p *rpcProcess) Signal(ctx context.Context, sig syscall.Signal) error {
-	resp, err := p.client.Signal(ctx, &internal.SignalProcess{
-		ProcessID: &internal.JasperProcessID{Value: p.info.Id},
-		Signal:    internal.ConvertSignal(sig),
-	})
+	resp, err := p.client.Signal(ctx, internal.ConvertSignalProcess(p.info.Id, sig))
 
 	if err != nil {
 		return errors.WithStack(err)
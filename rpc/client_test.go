@@ -300,6 +300,58 @@ func TestRPCManager(t *testing.T) {
 	}
 }
 
+func TestRPCManagerMiddleware(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for name, test := range map[string]func(context.Context, *testing.T, jasper.Manager){
+		"DefaultsAreSentToRemoteProcesses": func(ctx context.Context, t *testing.T, base jasper.Manager) {
+			manager := jasper.WrapManager(base,
+				jasper.LockingMiddleware(),
+				jasper.DefaultTagsMiddleware("foo"),
+				jasper.DefaultEnvironmentMiddleware(map[string]string{"foo": "bar"}),
+			)
+
+			proc, err := manager.Create(ctx, trueCreateOpts())
+			require.NoError(t, err)
+			assert.Equal(t, "bar", proc.Info(ctx).Options.Environment["foo"])
+
+			procs, err := manager.Group(ctx, "foo")
+			require.NoError(t, err)
+			require.Len(t, procs, 1)
+			assert.Equal(t, proc.ID(), procs[0].ID())
+		},
+		"CapacityAndMetricsApplyToRemoteManager": func(ctx context.Context, t *testing.T, base jasper.Manager) {
+			metrics := jasper.NewManagerMetrics()
+			manager := jasper.WrapManager(base, jasper.MetricsMiddleware(metrics), jasper.CapacityMiddleware(1))
+
+			proc, err := manager.Create(ctx, sleepCreateOpts(10))
+			require.NoError(t, err)
+			_, err = manager.Create(ctx, sleepCreateOpts(10))
+			assert.Error(t, err)
+
+			stats := metrics.Stats()
+			assert.EqualValues(t, 2, stats["create"].Calls)
+			assert.EqualValues(t, 1, stats["create"].Failures)
+
+			assert.NoError(t, jasper.Terminate(ctx, proc))
+		},
+		// "": func(ctx context.Context, t *testing.T, manager jasper.Manager) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tctx, cancel := context.WithTimeout(ctx, taskTimeout)
+			defer cancel()
+
+			addr, err := startRPC(tctx, jasper.NewLocalManager())
+			require.NoError(t, err)
+			client, err := getClient(tctx, addr)
+			require.NoError(t, err)
+
+			test(tctx, t, client)
+		})
+	}
+}
+
 type processConstructor func(context.Context, *jasper.CreateOptions) (jasper.Process, error)
 
 func TestRPCProcess(t *testing.T) {
@@ -0,0 +1,1068 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tychoish/bond"
+)
+
+// TestConformance runs the same cases against the local manager and against
+// clients of both the REST and GRPC services, so that the transports cannot
+// drift apart from each other or from the local manager.
+func TestConformance(t *testing.T) {
+	for mname, factory := range map[string]func(context.Context, *testing.T) jasper.Manager{
+		"Local": func(ctx context.Context, t *testing.T) jasper.Manager {
+			return jasper.NewLocalManager()
+		},
+		"REST": func(ctx context.Context, t *testing.T) jasper.Manager {
+			url, err := startREST(ctx, jasper.NewLocalManager())
+			require.NoError(t, err)
+
+			return jasper.NewRESTClient(url, nil)
+		},
+		"RPC": func(ctx context.Context, t *testing.T) jasper.Manager {
+			addr, err := startRPC(ctx, jasper.NewLocalManager())
+			require.NoError(t, err)
+
+			client, err := getClient(ctx, addr)
+			require.NoError(t, err)
+
+			return client
+		},
+	} {
+		t.Run(mname, func(t *testing.T) {
+			for name, test := range map[string]func(context.Context, *testing.T, jasper.Manager){
+				"ListErrorsWhenEmpty": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					procs, err := manager.List(ctx, jasper.All)
+					assert.Error(t, err)
+					assert.Empty(t, procs)
+				},
+				"CreateWithInvalidOptionsErrors": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, &jasper.CreateOptions{})
+					assert.Error(t, err)
+					assert.Nil(t, proc)
+				},
+				"WaitReturnsExitCode": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					exitCode, err := proc.Wait(ctx)
+					assert.NoError(t, err)
+					assert.Equal(t, 0, exitCode)
+
+					proc, err = manager.Create(ctx, falseCreateOpts())
+					require.NoError(t, err)
+					exitCode, err = proc.Wait(ctx)
+					assert.Error(t, err)
+					assert.Equal(t, 1, exitCode)
+				},
+				"CompletedProcessInfoIsConsistent": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					info := proc.Info(ctx)
+					assert.Equal(t, proc.ID(), info.ID)
+					assert.True(t, info.Complete)
+					assert.True(t, info.Successful)
+					assert.False(t, info.IsRunning)
+					assert.Equal(t, trueCreateOpts().Args, info.Options.Args)
+					assert.True(t, proc.Complete(ctx))
+					assert.False(t, proc.Running(ctx))
+				},
+				"SubSecondTimeoutIsPreserved": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					opts := sleepCreateOpts(10)
+					opts.Timeout = 1500 * time.Millisecond
+					proc, err := manager.Create(ctx, opts)
+					require.NoError(t, err)
+
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+
+					info := proc.Info(ctx)
+					assert.Equal(t, 1500*time.Millisecond, info.Options.Timeout)
+					assert.Equal(t, 1, info.Options.TimeoutSecs)
+				},
+				"GetReturnsProcessWithSameID": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+
+					getProc, err := manager.Get(ctx, proc.ID())
+					require.NoError(t, err)
+					assert.Equal(t, proc.ID(), getProc.ID())
+				},
+				"GetNonexistentProcessErrors": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Get(ctx, "foo")
+					assert.Error(t, err)
+					assert.Nil(t, proc)
+				},
+				"ListFiltersProcesses": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					sleep, err := manager.Create(ctx, sleepCreateOpts(10))
+					require.NoError(t, err)
+					done, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = done.Wait(ctx)
+					require.NoError(t, err)
+
+					procs, err := manager.List(ctx, jasper.All)
+					require.NoError(t, err)
+					assert.Len(t, procs, 2)
+
+					procs, err = manager.List(ctx, jasper.Running)
+					require.NoError(t, err)
+					require.Len(t, procs, 1)
+					assert.Equal(t, sleep.ID(), procs[0].ID())
+
+					procs, err = manager.List(ctx, jasper.Successful)
+					require.NoError(t, err)
+					require.Len(t, procs, 1)
+					assert.Equal(t, done.ID(), procs[0].ID())
+				},
+				"TagsAreVisibleToGroup": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, sleepCreateOpts(10))
+					require.NoError(t, err)
+					_, err = manager.Create(ctx, sleepCreateOpts(10))
+					require.NoError(t, err)
+
+					proc.Tag("foo")
+					assert.Equal(t, []string{"foo"}, proc.GetTags())
+
+					procs, err := manager.Group(ctx, "foo")
+					require.NoError(t, err)
+					require.Len(t, procs, 1)
+					assert.Equal(t, proc.ID(), procs[0].ID())
+
+					proc.ResetTags()
+					assert.Empty(t, proc.GetTags())
+					procs, err = manager.Group(ctx, "foo")
+					assert.Error(t, err)
+					assert.Empty(t, procs)
+				},
+				"SignalTerminatesProcess": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, sleepCreateOpts(10))
+					require.NoError(t, err)
+					require.NoError(t, proc.Signal(ctx, syscall.SIGTERM))
+
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+					assert.True(t, proc.Complete(ctx))
+					assert.False(t, proc.Info(ctx).Successful)
+				},
+				"NonStandardSignalsAreDelivered": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					for _, sig := range []syscall.Signal{syscall.SIGINT, syscall.SIGQUIT, syscall.SIGALRM} {
+						proc, err := manager.Create(ctx, sleepCreateOpts(10))
+						require.NoError(t, err)
+						require.NoError(t, proc.Signal(ctx, sig))
+
+						exitCode, err := proc.Wait(ctx)
+						assert.Error(t, err)
+						assert.Equal(t, int(sig), exitCode)
+					}
+				},
+				"SuspendAndResume": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, sleepCreateOpts(1))
+					require.NoError(t, err)
+					require.NoError(t, jasper.Suspend(ctx, proc))
+
+					wctx, wcancel := context.WithTimeout(ctx, 2*time.Second)
+					defer wcancel()
+					_, err = proc.Wait(wctx)
+					assert.Error(t, err)
+					assert.True(t, proc.Running(ctx))
+
+					require.NoError(t, jasper.Resume(ctx, proc))
+					_, err = proc.Wait(ctx)
+					assert.NoError(t, err)
+				},
+				"RespawnCreatesNewProcess": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					newProc, err := proc.Respawn(ctx)
+					require.NoError(t, err)
+					assert.NotEqual(t, proc.ID(), newProc.ID())
+					_, err = newProc.Wait(ctx)
+					assert.NoError(t, err)
+				},
+				"CloseTerminatesProcesses": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, sleepCreateOpts(10))
+					require.NoError(t, err)
+					assert.NoError(t, manager.Close(ctx))
+
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+				},
+				"ClearRemovesCompletedProcesses": func(ctx context.Context, t *testing.T, manager jasper.Manager) {
+					proc, err := manager.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					manager.Clear(ctx)
+					_, err = manager.Get(ctx, proc.ID())
+					assert.Error(t, err)
+				},
+				// "": func(ctx context.Context, t *testing.T, manager jasper.Manager) {},
+			} {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+					defer cancel()
+
+					test(ctx, t, factory(ctx, t))
+				})
+			}
+
+			for name, test := range map[string]func(context.Context, *testing.T, jasper.RemoteClient){
+				"GetLogsFromInMemoryLogger": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					opts := &jasper.CreateOptions{Args: []string{"echo", "foo"}}
+					opts.Output.Loggers = []jasper.Logger{
+						{
+							Type: jasper.LogInMemory,
+							Options: jasper.LogOptions{
+								Format:      jasper.LogFormatPlain,
+								InMemoryCap: 100,
+							},
+						},
+					}
+					proc, err := client.Create(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					logs, err := client.GetLogs(ctx, proc.ID())
+					require.NoError(t, err)
+					require.Len(t, logs, 1)
+					assert.Equal(t, "foo", strings.TrimSpace(logs[0]))
+				},
+				"GetLogsFromErrorStreamOnly": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					opts := &jasper.CreateOptions{Args: []string{"sh", "-c", "echo foo; echo bar >&2"}}
+					opts.Output.Loggers = []jasper.Logger{
+						{
+							Type: jasper.LogInMemory,
+							Options: jasper.LogOptions{
+								Format:      jasper.LogFormatPlain,
+								InMemoryCap: 100,
+							},
+							Streams: []jasper.LogStreamOptions{{Stream: jasper.LogStreamError, Prefix: "stderr: "}},
+						},
+					}
+					proc, err := client.Create(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					logs, err := client.GetLogs(ctx, proc.ID())
+					require.NoError(t, err)
+					require.Len(t, logs, 1)
+					assert.Equal(t, "stderr: bar", strings.TrimSpace(logs[0]))
+				},
+				"QueryLogsAcrossLoggers": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					opts := &jasper.CreateOptions{Args: []string{"sh", "-c", "echo foo1; echo bar1 >&2; echo foo2; echo bar2 >&2"}}
+					for _, stream := range []jasper.LogStream{jasper.LogStreamOutput, jasper.LogStreamError} {
+						opts.Output.Loggers = append(opts.Output.Loggers, jasper.Logger{
+							Type:    jasper.LogInMemory,
+							Options: jasper.LogOptions{Format: jasper.LogFormatPlain, InMemoryCap: 100},
+							Streams: []jasper.LogStreamOptions{{Stream: stream}},
+						})
+					}
+					start := time.Now()
+					proc, err := client.Create(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					records, err := client.QueryLogs(ctx, proc.ID(), jasper.LogQuery{})
+					require.NoError(t, err)
+					assert.Len(t, records, 4)
+
+					records, err = client.QueryLogs(ctx, proc.ID(), jasper.LogQuery{
+						Stream:  jasper.LogStreamError,
+						Since:   start,
+						Pattern: "bar",
+						Tail:    1,
+					})
+					require.NoError(t, err)
+					require.Len(t, records, 1)
+					assert.Equal(t, "bar2", records[0].Line)
+					assert.Equal(t, jasper.LogStreamError, records[0].Stream)
+					assert.Equal(t, 1, records[0].Logger)
+					assert.False(t, records[0].Timestamp.Before(start))
+
+					records, err = client.QueryLogs(ctx, proc.ID(), jasper.LogQuery{Stream: jasper.LogStreamOutput, Offset: 1, Limit: 1})
+					require.NoError(t, err)
+					require.Len(t, records, 1)
+					assert.Equal(t, "foo2", records[0].Line)
+
+					_, err = client.QueryLogs(ctx, proc.ID(), jasper.LogQuery{Pattern: "("})
+					assert.Error(t, err)
+				},
+				"QueryLogsReturnsParsedFields": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					opts := &jasper.CreateOptions{Args: []string{"echo", `{"s": "I", "attr": {"port": 27017}}`}}
+					opts.Output.Loggers = []jasper.Logger{
+						{
+							Type: jasper.LogInMemory,
+							Options: jasper.LogOptions{
+								Format:      jasper.LogFormatPlain,
+								InMemoryCap: 100,
+								LineParser:  jasper.LineParserOptions{Type: jasper.LineParserJSON},
+							},
+						},
+					}
+					proc, err := client.Create(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					records, err := client.QueryLogs(ctx, proc.ID(), jasper.LogQuery{})
+					require.NoError(t, err)
+					require.Len(t, records, 1)
+					assert.Equal(t, `{"s": "I", "attr": {"port": 27017}}`, records[0].Line)
+					assert.Equal(t, message.Fields{"s": "I", "attr": map[string]interface{}{"port": 27017.0}}, records[0].Fields)
+				},
+				"RedactsSecretsFromLogsAndInfo": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					opts := &jasper.CreateOptions{
+						Args:        []string{"sh", "-c", "echo token=$TOKEN; echo password=hunter2"},
+						Environment: map[string]string{"TOKEN": "s3cr3t", "OTHER": "has s3cr3t"},
+					}
+					opts.Output.Redaction = jasper.RedactionOptions{
+						Patterns: []string{`hunter\d`},
+						EnvVars:  []string{"TOKEN"},
+					}
+					opts.Output.Loggers = []jasper.Logger{
+						{
+							Type:    jasper.LogInMemory,
+							Options: jasper.LogOptions{Format: jasper.LogFormatPlain, InMemoryCap: 100},
+						},
+					}
+					proc, err := client.Create(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					logs, err := client.GetLogs(ctx, proc.ID())
+					require.NoError(t, err)
+					require.Len(t, logs, 2)
+					assert.Equal(t, "token="+jasper.RedactedValue, strings.TrimSpace(logs[0]))
+					assert.Equal(t, "password="+jasper.RedactedValue, strings.TrimSpace(logs[1]))
+
+					env := proc.Info(ctx).Options.Environment
+					assert.Equal(t, jasper.RedactedValue, env["TOKEN"])
+					assert.Equal(t, "has "+jasper.RedactedValue, env["OTHER"])
+				},
+				"LinePrefixesAreAppliedToLogs": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					opts := &jasper.CreateOptions{Args: []string{"echo", "foo"}, Tags: []string{"bar"}}
+					opts.Output.LineBuffer = jasper.LineBufferOptions{Enabled: true, Prefix: "[{{.Tag}} {{.Stream}}] "}
+					opts.Output.Loggers = []jasper.Logger{
+						{
+							Type:    jasper.LogInMemory,
+							Options: jasper.LogOptions{Format: jasper.LogFormatPlain, InMemoryCap: 100},
+						},
+					}
+					proc, err := client.Create(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					logs, err := client.GetLogs(ctx, proc.ID())
+					require.NoError(t, err)
+					require.Len(t, logs, 1)
+					assert.Equal(t, "[bar output] foo", strings.TrimSpace(logs[0]))
+				},
+				"OutputLimitsTruncateLogsAndAreReportedInInfo": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					opts := &jasper.CreateOptions{Args: []string{"echo", "foobar"}}
+					opts.Output.Limits = jasper.OutputLimitOptions{MaxBytes: 3, Policy: jasper.OutputLimitKeepHead}
+					opts.Output.Loggers = []jasper.Logger{
+						{
+							Type:    jasper.LogInMemory,
+							Options: jasper.LogOptions{Format: jasper.LogFormatPlain, InMemoryCap: 100, InMemoryMaxBytes: 1024},
+						},
+					}
+					proc, err := client.Create(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					info := proc.Info(ctx)
+					assert.True(t, info.OutputTruncated)
+					assert.False(t, info.ErrorTruncated)
+
+					logs, err := client.GetLogs(ctx, proc.ID())
+					require.NoError(t, err)
+					require.Len(t, logs, 1)
+					assert.Equal(t, "foo", strings.TrimSpace(logs[0]))
+				},
+				"QueryLogsFailsForNonexistentProcess": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					records, err := client.QueryLogs(ctx, "foo", jasper.LogQuery{})
+					assert.Error(t, err)
+					assert.Empty(t, records)
+				},
+				"GetLogsFailsWithoutInMemoryLogger": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					proc, err := client.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+
+					logs, err := client.GetLogs(ctx, proc.ID())
+					assert.Error(t, err)
+					assert.Empty(t, logs)
+				},
+				"GetLogsFailsForNonexistentProcess": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					logs, err := client.GetLogs(ctx, "foo")
+					assert.Error(t, err)
+					assert.Empty(t, logs)
+				},
+				"GetProcessMetricsForRunningProcess": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					proc, err := client.Create(ctx, sleepCreateOpts(10))
+					require.NoError(t, err)
+
+					metrics, err := client.GetProcessMetrics(ctx, proc.ID())
+					require.NoError(t, err)
+					require.NotEmpty(t, metrics)
+					assert.EqualValues(t, proc.Info(ctx).PID, metrics[0].Pid)
+				},
+				"GetProcessMetricsFailsForNonexistentProcess": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					metrics, err := client.GetProcessMetrics(ctx, "foo")
+					assert.Error(t, err)
+					assert.Empty(t, metrics)
+				},
+				"GetBuildloggerURLsFailsWithoutBuildlogger": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					proc, err := client.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+
+					urls, err := client.GetBuildloggerURLs(ctx, proc.ID())
+					assert.Error(t, err)
+					assert.Empty(t, urls)
+				},
+				"GetBuildloggerURLsFailsForNonexistentProcess": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					urls, err := client.GetBuildloggerURLs(ctx, "foo")
+					assert.Error(t, err)
+					assert.Empty(t, urls)
+				},
+				"ConfigureCacheValidatesOptions": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					assert.NoError(t, client.ConfigureCache(ctx, jasper.CacheOptions{MaxSize: 1024}))
+					assert.Error(t, client.ConfigureCache(ctx, jasper.CacheOptions{MaxSize: -1}))
+					assert.Error(t, client.ConfigureCache(ctx, jasper.CacheOptions{PruneDelay: -1}))
+				},
+				"DownloadFileWritesFile": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+						fmt.Fprint(rw, "foo")
+					}))
+					defer srv.Close()
+
+					dir, err := ioutil.TempDir("", "jasper-conformance")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					path := filepath.Join(dir, "out")
+					require.NoError(t, client.DownloadFile(ctx, jasper.DownloadInfo{URL: srv.URL, Path: path}))
+
+					contents, err := ioutil.ReadFile(path)
+					require.NoError(t, err)
+					assert.Equal(t, "foo", string(contents))
+				},
+				"DownloadFileVerifiesChecksums": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					const fooSHA256 = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+					srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+						if strings.HasSuffix(r.URL.Path, jasper.ChecksumFileExtension) {
+							fmt.Fprintf(rw, "%s  foo\n", fooSHA256)
+							return
+						}
+						fmt.Fprint(rw, "foo")
+					}))
+					defer srv.Close()
+
+					dir, err := ioutil.TempDir("", "jasper-conformance")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					path := filepath.Join(dir, "out")
+					require.NoError(t, client.DownloadFile(ctx, jasper.DownloadInfo{URL: srv.URL + "/foo", Path: path, SHA256: fooSHA256}))
+					require.NoError(t, os.Remove(path))
+					require.NoError(t, client.DownloadFile(ctx, jasper.DownloadInfo{URL: srv.URL + "/foo", Path: path, FetchChecksum: true}))
+					require.NoError(t, os.Remove(path))
+
+					assert.Error(t, client.DownloadFile(ctx, jasper.DownloadInfo{URL: srv.URL + "/foo", Path: path, MD5: strings.Repeat("0", 32)}))
+					_, err = os.Stat(path)
+					assert.True(t, os.IsNotExist(err), "file with mismatched checksum should be removed")
+				},
+				"DownloadFileIsCanceledWithContext": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					canceled := make(chan struct{})
+					srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+						<-r.Context().Done()
+						close(canceled)
+					}))
+					defer srv.Close()
+
+					dir, err := ioutil.TempDir("", "jasper-conformance")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					tctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+					defer cancel()
+					assert.Error(t, client.DownloadFile(tctx, jasper.DownloadInfo{URL: srv.URL, Path: filepath.Join(dir, "out")}))
+
+					select {
+					case <-canceled:
+					case <-ctx.Done():
+						assert.Fail(t, "download was not canceled on the server")
+					}
+				},
+				"DownloadFileValidatesInfo": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					assert.Error(t, client.DownloadFile(ctx, jasper.DownloadInfo{URL: "http://example.com", Path: "foo"}))
+				},
+				"DownloadMongoDBValidatesOptions": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					assert.Error(t, client.DownloadMongoDB(ctx, jasper.MongoDBDownloadOptions{Path: "foo"}))
+				},
+				"StartDownloadRunsJobAndReportsProgress": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+						fmt.Fprint(rw, "foo")
+					}))
+					defer srv.Close()
+
+					dir, err := ioutil.TempDir("", "jasper-conformance")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					info := jasper.DownloadInfo{URL: srv.URL, Path: filepath.Join(dir, "out")}
+					status, err := client.StartDownload(ctx, info)
+					require.NoError(t, err)
+					require.NotEmpty(t, status.ID)
+
+					status, err = client.WaitDownload(ctx, status.ID)
+					require.NoError(t, err)
+					assert.Equal(t, jasper.DownloadJobCompleted, status.State)
+					assert.EqualValues(t, 3, status.BytesTransferred)
+					assert.EqualValues(t, 3, status.BytesTotal)
+					assert.Equal(t, info.URL, status.Info.URL)
+
+					found, err := client.GetDownload(ctx, status.ID)
+					require.NoError(t, err)
+					assert.Equal(t, status.State, found.State)
+
+					statuses, err := client.ListDownloads(ctx)
+					require.NoError(t, err)
+					ids := []string{}
+					for _, s := range statuses {
+						ids = append(ids, s.ID)
+					}
+					assert.Contains(t, ids, status.ID)
+
+					contents, err := ioutil.ReadFile(info.Path)
+					require.NoError(t, err)
+					assert.Equal(t, "foo", string(contents))
+				},
+				"CancelDownloadStopsJob": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+						<-r.Context().Done()
+					}))
+					defer srv.Close()
+
+					dir, err := ioutil.TempDir("", "jasper-conformance")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					status, err := client.StartDownload(ctx, jasper.DownloadInfo{URL: srv.URL, Path: filepath.Join(dir, "out")})
+					require.NoError(t, err)
+					require.NoError(t, client.CancelDownload(ctx, status.ID))
+
+					status, err = client.WaitDownload(ctx, status.ID)
+					require.NoError(t, err)
+					assert.Equal(t, jasper.DownloadJobCanceled, status.State)
+					assert.Error(t, client.CancelDownload(ctx, status.ID))
+				},
+				"DownloadJobsRejectInvalidRequests": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					_, err := client.StartDownload(ctx, jasper.DownloadInfo{URL: "http://example.com", Path: "foo"})
+					assert.Error(t, err)
+					_, err = client.GetDownload(ctx, "foo")
+					assert.Error(t, err)
+					_, err = client.WaitDownload(ctx, "foo")
+					assert.Error(t, err)
+					assert.Error(t, client.CancelDownload(ctx, "foo"))
+				},
+				"GetArtifactFailsWithoutArtifacts": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					proc, err := client.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					r, err := client.GetArtifact(ctx, proc.ID(), jasper.ArtifactOutput)
+					assert.Error(t, err)
+					assert.Nil(t, r)
+				},
+				// "": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {},
+			} {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+					defer cancel()
+
+					client, ok := factory(ctx, t).(jasper.RemoteClient)
+					if !ok {
+						t.Skip("remote client operations are not supported by local managers")
+					}
+					test(ctx, t, client)
+				})
+			}
+		})
+	}
+}
+
+// TestArtifactConformance checks that the REST and GRPC services serve the
+// artifacts captured by their manager, even after the processes are
+// cleared.
+func TestArtifactConformance(t *testing.T) {
+	for sname, start := range map[string]func(context.Context, *testing.T, jasper.Manager, *jasper.ProcessArtifacts) jasper.RemoteClient{
+		"REST": func(ctx context.Context, t *testing.T, manager jasper.Manager, artifacts *jasper.ProcessArtifacts) jasper.RemoteClient {
+			url, err := startRESTWithArtifacts(ctx, manager, artifacts)
+			require.NoError(t, err)
+
+			return jasper.NewRESTClient(url, nil)
+		},
+		"RPC": func(ctx context.Context, t *testing.T, manager jasper.Manager, artifacts *jasper.ProcessArtifacts) jasper.RemoteClient {
+			addr, err := startRPCWithArtifacts(ctx, manager, artifacts)
+			require.NoError(t, err)
+
+			client, err := getClient(ctx, addr)
+			require.NoError(t, err)
+
+			return client
+		},
+	} {
+		t.Run(sname, func(t *testing.T) {
+			for name, test := range map[string]func(context.Context, *testing.T, jasper.RemoteClient){
+				"GetArtifactsAfterClear": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					proc, err := client.Create(ctx, &jasper.CreateOptions{Args: []string{"sh", "-c", "echo foo; echo bar >&2"}})
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+					client.Clear(ctx)
+					_, err = client.Get(ctx, proc.ID())
+					require.Error(t, err)
+
+					for artifact, expected := range map[string]string{jasper.ArtifactOutput: "foo\n", jasper.ArtifactError: "bar\n"} {
+						r, err := client.GetArtifact(ctx, proc.ID(), artifact)
+						require.NoError(t, err)
+						contents, err := ioutil.ReadAll(r)
+						require.NoError(t, r.Close())
+						require.NoError(t, err)
+						assert.Equal(t, expected, string(contents))
+					}
+
+					r, err := client.GetArtifact(ctx, proc.ID(), jasper.ArtifactInfo)
+					require.NoError(t, err)
+					defer r.Close()
+					info := jasper.ProcessInfo{}
+					require.NoError(t, json.NewDecoder(r).Decode(&info))
+					assert.Equal(t, proc.ID(), info.ID)
+					assert.True(t, info.Complete)
+					assert.True(t, info.Successful)
+				},
+				"GetLargeArtifact": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					proc, err := client.Create(ctx, &jasper.CreateOptions{Args: []string{"sh", "-c", "head -c 200000 /dev/zero"}})
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					r, err := client.GetArtifact(ctx, proc.ID(), jasper.ArtifactOutput)
+					require.NoError(t, err)
+					defer r.Close()
+					contents, err := ioutil.ReadAll(r)
+					require.NoError(t, err)
+					assert.Len(t, contents, 200000)
+				},
+				"GetArtifactFailsForUnknownName": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					proc, err := client.Create(ctx, trueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					_, err = client.GetArtifact(ctx, proc.ID(), "foo")
+					assert.Error(t, err)
+				},
+				"GetArtifactFailsForNonexistentProcess": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {
+					_, err := client.GetArtifact(ctx, "foo", jasper.ArtifactOutput)
+					assert.Error(t, err)
+				},
+				// "": func(ctx context.Context, t *testing.T, client jasper.RemoteClient) {},
+			} {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+					defer cancel()
+
+					dir, err := ioutil.TempDir("", "artifacts")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					artifacts, err := jasper.NewProcessArtifacts(jasper.ArtifactOptions{Directory: dir})
+					require.NoError(t, err)
+					manager := jasper.WrapManager(jasper.NewLocalManager(), jasper.ArtifactMiddleware(artifacts))
+					defer manager.Close(ctx)
+
+					test(ctx, t, start(ctx, t, manager, artifacts))
+				})
+			}
+		})
+	}
+}
+
+// TestDownloadCacheConformance checks that the REST and GRPC services serve
+// repeated downloads from their download cache, and expose the same
+// operations on its entries.
+func TestDownloadCacheConformance(t *testing.T) {
+	content := "foobar"
+	sum := sha256.Sum256([]byte(content))
+	digest := hex.EncodeToString(sum[:])
+
+	for sname, start := range map[string]func(context.Context, *testing.T, *jasper.DownloadCache) jasper.RemoteClient{
+		"REST": func(ctx context.Context, t *testing.T, cache *jasper.DownloadCache) jasper.RemoteClient {
+			url, err := startRESTWithDownloadCache(ctx, jasper.NewLocalManager(), cache)
+			require.NoError(t, err)
+
+			return jasper.NewRESTClient(url, nil)
+		},
+		"RPC": func(ctx context.Context, t *testing.T, cache *jasper.DownloadCache) jasper.RemoteClient {
+			addr, err := startRPCWithDownloadCache(ctx, jasper.NewLocalManager(), cache)
+			require.NoError(t, err)
+
+			client, err := getClient(ctx, addr)
+			require.NoError(t, err)
+
+			return client
+		},
+	} {
+		t.Run(sname, func(t *testing.T) {
+			for name, test := range map[string]func(context.Context, *testing.T, jasper.RemoteClient, *int64, jasper.DownloadInfo){
+				"DownloadFileUsesCache": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, requests *int64, info jasper.DownloadInfo) {
+					require.NoError(t, client.DownloadFile(ctx, info))
+					info.Path += "2"
+					require.NoError(t, client.DownloadFile(ctx, info))
+					assert.EqualValues(t, 1, atomic.LoadInt64(requests))
+
+					contents, err := ioutil.ReadFile(info.Path)
+					require.NoError(t, err)
+					assert.Equal(t, content, string(contents))
+
+					entries, err := client.ListCachedDownloads(ctx)
+					require.NoError(t, err)
+					require.Len(t, entries, 1)
+					assert.Equal(t, digest, entries[0].Digest)
+					assert.Equal(t, []string{info.URL}, entries[0].URLs)
+					assert.EqualValues(t, len(content), entries[0].Size)
+					assert.EqualValues(t, 1, entries[0].Hits)
+				},
+				"PinAndEvictCachedDownload": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, requests *int64, info jasper.DownloadInfo) {
+					require.NoError(t, client.DownloadFile(ctx, info))
+					require.NoError(t, client.PinCachedDownload(ctx, digest, true))
+
+					entry, err := client.GetCachedDownload(ctx, digest)
+					require.NoError(t, err)
+					assert.True(t, entry.Pinned)
+					assert.Error(t, client.EvictCachedDownload(ctx, digest))
+
+					require.NoError(t, client.PinCachedDownload(ctx, digest, false))
+					require.NoError(t, client.EvictCachedDownload(ctx, digest))
+					entries, err := client.ListCachedDownloads(ctx)
+					require.NoError(t, err)
+					assert.Empty(t, entries)
+
+					require.NoError(t, client.DownloadFile(ctx, info))
+					assert.EqualValues(t, 2, atomic.LoadInt64(requests))
+				},
+				"MissingCachedDownloadsAreErrors": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, requests *int64, info jasper.DownloadInfo) {
+					_, err := client.GetCachedDownload(ctx, digest)
+					assert.Error(t, err)
+					assert.Error(t, client.PinCachedDownload(ctx, digest, true))
+					assert.Error(t, client.EvictCachedDownload(ctx, digest))
+				},
+				"DownloadFileUsesConfiguredSources": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, requests *int64, info jasper.DownloadInfo) {
+					assert.Error(t, client.ConfigureDownloadSources(ctx, jasper.DownloadSources{Mirrors: []string{"ftp://example.com"}}))
+
+					require.NoError(t, client.ConfigureDownloadSources(ctx, jasper.DownloadSources{
+						Rewrites: map[string]string{"http://example.invalid/": info.URL + "/"},
+					}))
+					info.URL = "http://example.invalid/foo"
+					require.NoError(t, client.DownloadFile(ctx, info))
+					assert.EqualValues(t, 1, atomic.LoadInt64(requests))
+
+					contents, err := ioutil.ReadFile(info.Path)
+					require.NoError(t, err)
+					assert.Equal(t, content, string(contents))
+				},
+				"ConfigureDownloadsIsReportedInStatus": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, requests *int64, info jasper.DownloadInfo) {
+					status, err := client.GetDownloadStatus(ctx)
+					require.NoError(t, err)
+					assert.Equal(t, jasper.DownloadOptions{Workers: jasper.DefaultDownloadJobWorkers}, status.Options)
+
+					assert.Error(t, client.ConfigureDownloads(ctx, jasper.DownloadOptions{Workers: -1}))
+					opts := jasper.DownloadOptions{Workers: 4, MaxBytesPerSecond: 1024 * 1024}
+					require.NoError(t, client.ConfigureDownloads(ctx, opts))
+
+					info.MaxBytesPerSecond = 1024
+					require.NoError(t, client.DownloadFile(ctx, info))
+
+					status, err = client.GetDownloadStatus(ctx)
+					require.NoError(t, err)
+					assert.Equal(t, opts, status.Options)
+					assert.Zero(t, status.Pending)
+					assert.Zero(t, status.Running)
+					assert.Equal(t, 1, status.CacheEntries)
+					assert.EqualValues(t, len(content), status.CacheSize)
+				},
+				// "": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, requests *int64, info jasper.DownloadInfo) {},
+			} {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+					defer cancel()
+
+					var requests int64
+					srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+						atomic.AddInt64(&requests, 1)
+						fmt.Fprint(rw, content)
+					}))
+					defer srv.Close()
+
+					dir, err := ioutil.TempDir("", "jasper-conformance")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					cache, err := jasper.NewDownloadCache(filepath.Join(dir, "cache"))
+					require.NoError(t, err)
+
+					test(ctx, t, start(ctx, t, cache), &requests, jasper.DownloadInfo{URL: srv.URL, Path: filepath.Join(dir, "out")})
+				})
+			}
+		})
+	}
+
+	t.Run("SharedBetweenServices", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+		defer cancel()
+
+		var requests int64
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(&requests, 1)
+			fmt.Fprint(rw, content)
+		}))
+		defer srv.Close()
+
+		dir, err := ioutil.TempDir("", "jasper-conformance")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		cache, err := jasper.NewDownloadCache(filepath.Join(dir, "cache"))
+		require.NoError(t, err)
+
+		url, err := startRESTWithDownloadCache(ctx, jasper.NewLocalManager(), cache)
+		require.NoError(t, err)
+		restClient := jasper.NewRESTClient(url, nil)
+		addr, err := startRPCWithDownloadCache(ctx, jasper.NewLocalManager(), cache)
+		require.NoError(t, err)
+		rpcClient, err := getClient(ctx, addr)
+		require.NoError(t, err)
+
+		info := jasper.DownloadInfo{URL: srv.URL, Path: filepath.Join(dir, "rest")}
+		require.NoError(t, restClient.DownloadFile(ctx, info))
+		info.Path = filepath.Join(dir, "rpc")
+		require.NoError(t, rpcClient.DownloadFile(ctx, info))
+		assert.EqualValues(t, 1, atomic.LoadInt64(&requests))
+
+		entry, err := rpcClient.GetCachedDownload(ctx, digest)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, entry.Hits)
+	})
+}
+
+// TestFileTransferConformance checks that clients of the REST and GRPC
+// services can upload files and directories to, and fetch them from, the
+// allowed paths on the host, and nowhere else.
+func TestFileTransferConformance(t *testing.T) {
+	for sname, start := range map[string]func(context.Context, *testing.T, jasper.FileTransferOptions) jasper.RemoteClient{
+		"REST": func(ctx context.Context, t *testing.T, opts jasper.FileTransferOptions) jasper.RemoteClient {
+			url, err := startRESTWithFileTransfers(ctx, jasper.NewLocalManager(), opts)
+			require.NoError(t, err)
+
+			return jasper.NewRESTClient(url, nil)
+		},
+		"RPC": func(ctx context.Context, t *testing.T, opts jasper.FileTransferOptions) jasper.RemoteClient {
+			addr, err := startRPCWithFileTransfers(ctx, jasper.NewLocalManager(), opts)
+			require.NoError(t, err)
+
+			client, err := getClient(ctx, addr)
+			require.NoError(t, err)
+
+			return client
+		},
+	} {
+		t.Run(sname, func(t *testing.T) {
+			for name, test := range map[string]func(context.Context, *testing.T, jasper.RemoteClient, string, string){
+				"UploadAndFetchFile": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, local, remote string) {
+					require.NoError(t, ioutil.WriteFile(filepath.Join(local, "foo"), []byte("foobar"), 0644))
+					require.NoError(t, client.UploadFile(ctx, filepath.Join(local, "foo"), filepath.Join(remote, "sub", "foo")))
+
+					contents, err := ioutil.ReadFile(filepath.Join(remote, "sub", "foo"))
+					require.NoError(t, err)
+					assert.Equal(t, "foobar", string(contents))
+
+					require.NoError(t, client.FetchFile(ctx, filepath.Join(remote, "sub", "foo"), filepath.Join(local, "fetched")))
+					contents, err = ioutil.ReadFile(filepath.Join(local, "fetched"))
+					require.NoError(t, err)
+					assert.Equal(t, "foobar", string(contents))
+				},
+				"UploadAndFetchDirectory": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, local, remote string) {
+					src := filepath.Join(local, "src")
+					require.NoError(t, os.MkdirAll(filepath.Join(src, "bin"), 0755))
+					require.NoError(t, ioutil.WriteFile(filepath.Join(src, "README.txt"), []byte("readme"), 0644))
+					content := strings.Repeat("tool", 64*1024)
+					require.NoError(t, ioutil.WriteFile(filepath.Join(src, "bin", "tool"), []byte(content), 0755))
+
+					require.NoError(t, client.UploadFile(ctx, src, filepath.Join(remote, "dir")))
+					require.NoError(t, client.FetchFile(ctx, filepath.Join(remote, "dir"), filepath.Join(local, "fetched")))
+
+					for _, dir := range []string{filepath.Join(remote, "dir"), filepath.Join(local, "fetched")} {
+						contents, err := ioutil.ReadFile(filepath.Join(dir, "bin", "tool"))
+						require.NoError(t, err)
+						assert.Equal(t, content, string(contents))
+						contents, err = ioutil.ReadFile(filepath.Join(dir, "README.txt"))
+						require.NoError(t, err)
+						assert.Equal(t, "readme", string(contents))
+					}
+				},
+				"RejectsPathsOutsideOfAllowedPaths": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, local, remote string) {
+					require.NoError(t, ioutil.WriteFile(filepath.Join(local, "foo"), []byte("foobar"), 0644))
+					assert.Error(t, client.UploadFile(ctx, filepath.Join(local, "foo"), filepath.Join(local, "uploaded")))
+					assert.Error(t, client.FetchFile(ctx, filepath.Join(local, "foo"), filepath.Join(local, "fetched")))
+
+					_, err := os.Stat(filepath.Join(local, "uploaded"))
+					assert.True(t, os.IsNotExist(err))
+					_, err = os.Stat(filepath.Join(local, "fetched"))
+					assert.True(t, os.IsNotExist(err))
+				},
+				"RejectsFilesLargerThanMaxSize": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, local, remote string) {
+					require.NoError(t, ioutil.WriteFile(filepath.Join(local, "big"), make([]byte, 2*1024*1024), 0644))
+					assert.Error(t, client.UploadFile(ctx, filepath.Join(local, "big"), filepath.Join(remote, "big")))
+					_, err := os.Stat(filepath.Join(remote, "big"))
+					assert.True(t, os.IsNotExist(err))
+
+					require.NoError(t, ioutil.WriteFile(filepath.Join(remote, "big"), make([]byte, 2*1024*1024), 0644))
+					assert.Error(t, client.FetchFile(ctx, filepath.Join(remote, "big"), filepath.Join(local, "fetched")))
+				},
+				"MissingFilesAreErrors": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, local, remote string) {
+					assert.Error(t, client.UploadFile(ctx, filepath.Join(local, "missing"), filepath.Join(remote, "missing")))
+					assert.Error(t, client.FetchFile(ctx, filepath.Join(remote, "missing"), filepath.Join(local, "missing")))
+				},
+				// "": func(ctx context.Context, t *testing.T, client jasper.RemoteClient, local, remote string) {},
+			} {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+					defer cancel()
+
+					dir, err := ioutil.TempDir("", "jasper-conformance")
+					require.NoError(t, err)
+					defer os.RemoveAll(dir)
+
+					local := filepath.Join(dir, "local")
+					remote := filepath.Join(dir, "remote")
+					require.NoError(t, os.Mkdir(local, 0755))
+					require.NoError(t, os.Mkdir(remote, 0755))
+
+					client := start(ctx, t, jasper.FileTransferOptions{AllowedPaths: []string{remote}, MaxSize: 1024 * 1024})
+					test(ctx, t, client, local, remote)
+				})
+			}
+		})
+	}
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+		defer cancel()
+
+		dir, err := ioutil.TempDir("", "jasper-conformance")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "foo"), []byte("foobar"), 0644))
+
+		url, err := startREST(ctx, jasper.NewLocalManager())
+		require.NoError(t, err)
+		addr, err := startRPC(ctx, jasper.NewLocalManager())
+		require.NoError(t, err)
+		rpcClient, err := getClient(ctx, addr)
+		require.NoError(t, err)
+
+		for _, client := range []jasper.RemoteClient{jasper.NewRESTClient(url, nil), rpcClient} {
+			assert.Error(t, client.UploadFile(ctx, filepath.Join(dir, "foo"), filepath.Join(dir, "bar")))
+			assert.Error(t, client.FetchFile(ctx, filepath.Join(dir, "foo"), filepath.Join(dir, "bar")))
+		}
+	})
+}
+
+// TestResolveMongoDBConformance checks that the REST and GRPC services
+// resolve MongoDB version specs with the feed on the host in the same way.
+func TestResolveMongoDBConformance(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "jasper-conformance")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	feed := `{"versions": [
+		{"version": "4.0.3", "production_release": true, "current": true, "downloads": [
+			{"target": "linux_x86_64", "arch": "x86_64", "edition": "base", "archive": {"url": "http://example.com/mongodb-linux-x86_64-4.0.3.tgz", "sha256": "sum"}}
+		]},
+		{"version": "4.0.1", "production_release": true, "downloads": [
+			{"target": "linux_x86_64", "arch": "x86_64", "edition": "base", "archive": {"url": "http://example.com/mongodb-linux-x86_64-4.0.1.tgz"}}
+		]}
+	]}`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "full.json"), []byte(feed), 0644))
+
+	url, err := startREST(ctx, jasper.NewLocalManager())
+	require.NoError(t, err)
+	addr, err := startRPC(ctx, jasper.NewLocalManager())
+	require.NoError(t, err)
+	rpcClient, err := getClient(ctx, addr)
+	require.NoError(t, err)
+
+	opts := jasper.MongoDBDownloadOptions{
+		BuildOpts: bond.BuildOptions{Target: "linux", Arch: bond.AMD64, Edition: bond.Base},
+		Path:      dir,
+		Releases:  []string{"4.0.x", "4.0.1"},
+	}
+	for cname, client := range map[string]jasper.RemoteClient{"REST": jasper.NewRESTClient(url, nil), "RPC": rpcClient} {
+		t.Run(cname, func(t *testing.T) {
+			releases, err := client.ResolveMongoDB(ctx, opts)
+			require.NoError(t, err)
+			require.Len(t, releases, 2)
+			assert.Equal(t, jasper.MongoDBRelease{
+				Spec:    "4.0.x",
+				Version: "4.0.3",
+				URL:     "http://example.com/mongodb-linux-x86_64-4.0.3.tgz",
+				SHA256:  "sum",
+				Path:    filepath.Join(dir, "mongodb-linux-x86_64-4.0.3"),
+				BinPath: filepath.Join(dir, "mongodb-linux-x86_64-4.0.3", "bin"),
+			}, releases[0])
+			assert.Equal(t, "4.0.1", releases[1].Version)
+
+			badOpts := opts
+			badOpts.Releases = []string{"5.0.x"}
+			_, err = client.ResolveMongoDB(ctx, badOpts)
+			assert.Error(t, err)
+		})
+	}
+}
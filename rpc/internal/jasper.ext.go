@@ -1,27 +1,38 @@
 package internal
 
 import (
+	"encoding/json"
+	"reflect"
 	"syscall"
 	"time"
 
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
 	"github.com/tychoish/bond"
 )
 
 // Export takes a protobuf RPC CreateOptions struct and returns the analogous
-// Jasper CreateOptions struct.
+// Jasper CreateOptions struct. Clients that predate the nanosecond timeout
+// only set the timeout in seconds, which is used as the timeout in that case.
 func (opts *CreateOptions) Export() *jasper.CreateOptions {
 	out := &jasper.CreateOptions{
 		Args:             opts.Args,
 		Environment:      opts.Environment,
+		Hostname:         opts.Hostname,
 		WorkingDirectory: opts.WorkingDirectory,
-		Timeout:          time.Duration(opts.TimeoutSeconds) * time.Second,
+		Timeout:          time.Duration(opts.Timeout),
 		TimeoutSecs:      int(opts.TimeoutSeconds),
 		OverrideEnviron:  opts.OverrideEnviron,
 		Tags:             opts.Tags,
 	}
 
+	if out.Timeout == 0 && out.TimeoutSecs > 0 {
+		out.Timeout = time.Duration(out.TimeoutSecs) * time.Second
+	}
+
 	if opts.Output != nil {
 		out.Output = opts.Output.Export()
 	}
@@ -41,23 +52,30 @@ func (opts *CreateOptions) Export() *jasper.CreateOptions {
 
 // ConvertCreateOptions takes a Jasper CreateOptions struct and returns an
 // equivalent protobuf RPC *CreateOptions struct. ConvertCreateOptions is the
-// inverse of (*CreateOptions) Export().
+// inverse of (*CreateOptions) Export(). The output and error writers of the
+// OutputOptions are local to the process that set them and are not converted,
+// just as they are not encoded in the REST API. The timeout in seconds is
+// always set when there is a timeout, for servers that predate the
+// nanosecond timeout.
 func ConvertCreateOptions(opts *jasper.CreateOptions) *CreateOptions {
-	if opts.TimeoutSecs == 0 && opts.Timeout != 0 {
-		opts.TimeoutSecs = int(opts.Timeout.Seconds())
-	}
 	output := ConvertOutputOptions(opts.Output)
 
 	co := &CreateOptions{
 		Args:             opts.Args,
 		Environment:      opts.Environment,
+		Hostname:         opts.Hostname,
 		WorkingDirectory: opts.WorkingDirectory,
+		Timeout:          int64(opts.Timeout),
 		TimeoutSeconds:   int64(opts.TimeoutSecs),
 		OverrideEnviron:  opts.OverrideEnviron,
 		Tags:             opts.Tags,
 		Output:           &output,
 	}
 
+	if co.TimeoutSeconds == 0 && co.Timeout != 0 {
+		co.TimeoutSeconds = int64(opts.Timeout.Seconds())
+	}
+
 	for _, opt := range opts.OnSuccess {
 		co.OnSuccess = append(co.OnSuccess, ConvertCreateOptions(opt))
 	}
@@ -76,6 +94,7 @@ func ConvertCreateOptions(opts *jasper.CreateOptions) *CreateOptions {
 func (info *ProcessInfo) Export() jasper.ProcessInfo {
 	return jasper.ProcessInfo{
 		ID:         info.Id,
+		Host:       info.HostId,
 		PID:        int(info.Pid),
 		IsRunning:  info.Running,
 		Successful: info.Successful,
@@ -83,6 +102,9 @@ func (info *ProcessInfo) Export() jasper.ProcessInfo {
 		ExitCode:   int(info.ExitCode),
 		Timeout:    info.Timedout,
 		Options:    *info.Options.Export(),
+
+		OutputTruncated: info.OutputTruncated,
+		ErrorTruncated:  info.ErrorTruncated,
 	}
 }
 
@@ -92,6 +114,7 @@ func (info *ProcessInfo) Export() jasper.ProcessInfo {
 func ConvertProcessInfo(info jasper.ProcessInfo) *ProcessInfo {
 	return &ProcessInfo{
 		Id:         info.ID,
+		HostId:     info.Host,
 		Pid:        int64(info.PID),
 		ExitCode:   int32(info.ExitCode),
 		Running:    info.IsRunning,
@@ -99,41 +122,74 @@ func ConvertProcessInfo(info jasper.ProcessInfo) *ProcessInfo {
 		Complete:   info.Complete,
 		Timedout:   info.Timeout,
 		Options:    ConvertCreateOptions(&info.Options),
+
+		OutputTruncated: info.OutputTruncated,
+		ErrorTruncated:  info.ErrorTruncated,
 	}
 }
 
+// signalNames maps the protobuf RPC Signals to the names of the signals that
+// they represent.
+var signalNames = map[Signals]string{
+	Signals_HANGUP:    "SIGHUP",
+	Signals_INIT:      "SIGINT",
+	Signals_TERMINATE: "SIGTERM",
+	Signals_KILL:      "SIGKILL",
+	Signals_USER1:     "SIGUSR1",
+	Signals_USER2:     "SIGUSR2",
+}
+
 // Export takes a protobuf RPC Signals struct and returns the analogous
 // syscall.Signal.
 func (s Signals) Export() syscall.Signal {
-	switch s {
-	case Signals_HANGUP:
-		return syscall.SIGHUP
-	case Signals_INIT:
-		return syscall.SIGINT
-	case Signals_TERMINATE:
-		return syscall.SIGTERM
-	case Signals_KILL:
-		return syscall.SIGKILL
-	default:
+	sig, err := jasper.ParseSignal(signalNames[s])
+	if err != nil {
 		return syscall.Signal(0)
 	}
+	return sig
 }
 
 // ConvertSignal takes a syscall.Signal and returns an
 // equivalent protobuf RPC Signals struct. ConvertSignals is the
 // inverse of (Signals) Export().
 func ConvertSignal(s syscall.Signal) Signals {
-	switch s {
-	case syscall.SIGHUP:
-		return Signals_HANGUP
-	case syscall.SIGINT:
-		return Signals_INIT
-	case syscall.SIGTERM:
-		return Signals_TERMINATE
-	case syscall.SIGKILL:
-		return Signals_KILL
+	name := jasper.SignalName(s)
+	for sig, sigName := range signalNames {
+		if name == sigName {
+			return sig
+		}
+	}
+	return Signals_UNKNOWN
+}
+
+// Export takes a protobuf RPC SignalProcess struct and returns the
+// syscall.Signal that it describes, after validating it. The signal name takes
+// precedence over the signal number, which takes precedence over the Signals
+// value, so that signals are delivered correctly between platforms that number
+// them differently.
+func (sig *SignalProcess) Export() (syscall.Signal, error) {
+	switch {
+	case sig.SignalName != "":
+		return jasper.ParseSignal(sig.SignalName)
+	case sig.SignalNumber != 0:
+		s := syscall.Signal(sig.SignalNumber)
+		return s, jasper.ValidateSignal(s)
+	case sig.Signal != Signals_UNKNOWN:
+		return sig.Signal.Export(), nil
 	default:
-		return Signals_UNKNOWN
+		return syscall.Signal(0), errors.New("no signal specified")
+	}
+}
+
+// ConvertSignalProcess takes the ID of a process and a syscall.Signal and
+// returns an equivalent protobuf RPC SignalProcess struct.
+// ConvertSignalProcess is the inverse of (*SignalProcess) Export().
+func ConvertSignalProcess(id string, sig syscall.Signal) *SignalProcess {
+	return &SignalProcess{
+		ProcessID:    &JasperProcessID{Value: id},
+		Signal:       ConvertSignal(sig),
+		SignalNumber: int32(sig),
+		SignalName:   jasper.SignalName(sig),
 	}
 }
 
@@ -176,6 +232,14 @@ func (lt LogType) Export() jasper.LogType {
 		return jasper.LogSumologic
 	case LogType_LOGINMEMORY:
 		return jasper.LogInMemory
+	case LogType_LOGROTATINGFILE:
+		return jasper.LogRotatingFile
+	case LogType_LOGSYSLOG:
+		return jasper.LogSyslog
+	case LogType_LOGJOURNALD:
+		return jasper.LogJournald
+	case LogType_LOGHTTP:
+		return jasper.LogHTTP
 	default:
 		return jasper.LogType("")
 	}
@@ -202,6 +266,14 @@ func ConvertLogType(lt jasper.LogType) LogType {
 		return LogType_LOGSUMOLOGIC
 	case jasper.LogInMemory:
 		return LogType_LOGINMEMORY
+	case jasper.LogRotatingFile:
+		return LogType_LOGROTATINGFILE
+	case jasper.LogSyslog:
+		return LogType_LOGSYSLOG
+	case jasper.LogJournald:
+		return LogType_LOGJOURNALD
+	case jasper.LogHTTP:
+		return LogType_LOGHTTP
 	default:
 		return LogType_LOGUNKNOWN
 	}
@@ -214,13 +286,23 @@ func (opts OutputOptions) Export() jasper.OutputOptions {
 	for _, logger := range opts.Loggers {
 		loggers = append(loggers, logger.Export())
 	}
-	return jasper.OutputOptions{
+	out := jasper.OutputOptions{
 		SuppressOutput:    opts.SuppressOutput,
 		SuppressError:     opts.SuppressError,
 		SendOutputToError: opts.RedirectOutputToError,
 		SendErrorToOutput: opts.RedirectErrorToOutput,
 		Loggers:           loggers,
 	}
+	if opts.Redaction != nil {
+		out.Redaction = opts.Redaction.Export()
+	}
+	if opts.LineBuffer != nil {
+		out.LineBuffer = opts.LineBuffer.Export()
+	}
+	if opts.Limits != nil {
+		out.Limits = opts.Limits.Export()
+	}
+	return out
 }
 
 // ConvertOutputOptions takes a Jasper OutputOptions struct and returns an
@@ -237,26 +319,181 @@ func ConvertOutputOptions(opts jasper.OutputOptions) OutputOptions {
 		RedirectOutputToError: opts.SendOutputToError,
 		RedirectErrorToOutput: opts.SendErrorToOutput,
 		Loggers:               loggers,
+		Redaction:             ConvertRedactionOptions(opts.Redaction),
+		LineBuffer:            ConvertLineBufferOptions(opts.LineBuffer),
+		Limits:                ConvertOutputLimitOptions(opts.Limits),
+	}
+}
+
+// Export takes a protobuf RPC OutputLimitPolicy and returns the analogous
+// Jasper OutputLimitPolicy.
+func (p OutputLimitPolicy) Export() jasper.OutputLimitPolicy {
+	switch p {
+	case OutputLimitPolicy_OUTPUTLIMITKEEPHEAD:
+		return jasper.OutputLimitKeepHead
+	case OutputLimitPolicy_OUTPUTLIMITKEEPTAIL:
+		return jasper.OutputLimitKeepTail
+	case OutputLimitPolicy_OUTPUTLIMITDROP:
+		return jasper.OutputLimitDrop
+	case OutputLimitPolicy_OUTPUTLIMITKILL:
+		return jasper.OutputLimitKill
+	default:
+		return ""
+	}
+}
+
+// ConvertOutputLimitPolicy takes a Jasper OutputLimitPolicy and returns an
+// equivalent protobuf RPC OutputLimitPolicy. ConvertOutputLimitPolicy is
+// the inverse of (OutputLimitPolicy) Export().
+func ConvertOutputLimitPolicy(p jasper.OutputLimitPolicy) OutputLimitPolicy {
+	switch p {
+	case jasper.OutputLimitKeepHead:
+		return OutputLimitPolicy_OUTPUTLIMITKEEPHEAD
+	case jasper.OutputLimitKeepTail:
+		return OutputLimitPolicy_OUTPUTLIMITKEEPTAIL
+	case jasper.OutputLimitDrop:
+		return OutputLimitPolicy_OUTPUTLIMITDROP
+	case jasper.OutputLimitKill:
+		return OutputLimitPolicy_OUTPUTLIMITKILL
+	default:
+		return OutputLimitPolicy_OUTPUTLIMITUNKNOWN
+	}
+}
+
+// Export takes a protobuf RPC OutputLimitOptions struct and returns the
+// analogous Jasper OutputLimitOptions struct.
+func (opts *OutputLimitOptions) Export() jasper.OutputLimitOptions {
+	return jasper.OutputLimitOptions{
+		MaxBytes: opts.MaxBytes,
+		Policy:   opts.Policy.Export(),
+	}
+}
+
+// ConvertOutputLimitOptions takes a Jasper OutputLimitOptions struct and
+// returns an equivalent protobuf RPC OutputLimitOptions struct.
+// ConvertOutputLimitOptions is the inverse of (*OutputLimitOptions) Export().
+func ConvertOutputLimitOptions(opts jasper.OutputLimitOptions) *OutputLimitOptions {
+	return &OutputLimitOptions{
+		MaxBytes: opts.MaxBytes,
+		Policy:   ConvertOutputLimitPolicy(opts.Policy),
+	}
+}
+
+// Export takes a protobuf RPC LineBufferOptions struct and returns the
+// analogous Jasper LineBufferOptions struct.
+func (opts *LineBufferOptions) Export() jasper.LineBufferOptions {
+	return jasper.LineBufferOptions{
+		Enabled:         opts.Enabled,
+		Prefix:          opts.Prefix,
+		TimestampFormat: opts.TimestampFormat,
+	}
+}
+
+// ConvertLineBufferOptions takes a Jasper LineBufferOptions struct and
+// returns an equivalent protobuf RPC LineBufferOptions struct.
+// ConvertLineBufferOptions is the inverse of (*LineBufferOptions) Export().
+func ConvertLineBufferOptions(opts jasper.LineBufferOptions) *LineBufferOptions {
+	return &LineBufferOptions{
+		Enabled:         opts.Enabled,
+		Prefix:          opts.Prefix,
+		TimestampFormat: opts.TimestampFormat,
+	}
+}
+
+// Export takes a protobuf RPC RedactionOptions struct and returns the
+// analogous Jasper RedactionOptions struct.
+func (opts *RedactionOptions) Export() jasper.RedactionOptions {
+	return jasper.RedactionOptions{
+		Literals: opts.Literals,
+		Patterns: opts.Patterns,
+		EnvVars:  opts.EnvVars,
+	}
+}
+
+// ConvertRedactionOptions takes a Jasper RedactionOptions struct and
+// returns an equivalent protobuf RPC RedactionOptions struct.
+// ConvertRedactionOptions is the inverse of (*RedactionOptions) Export().
+func ConvertRedactionOptions(opts jasper.RedactionOptions) *RedactionOptions {
+	return &RedactionOptions{
+		Literals: opts.Literals,
+		Patterns: opts.Patterns,
+		EnvVars:  opts.EnvVars,
 	}
 }
 
 // Export takes a protobuf RPC Logger struct and returns the analogous
 // Jasper Logger struct.
 func (logger Logger) Export() jasper.Logger {
-	return jasper.Logger{
+	out := jasper.Logger{
 		Type:    logger.LogType.Export(),
 		Options: logger.LogOptions.Export(),
 	}
+	for _, stream := range logger.Streams {
+		out.Streams = append(out.Streams, stream.Export())
+	}
+	return out
 }
 
 // ConvertLogger takes a Jasper Logger struct and returns an
 // equivalent protobuf RPC Logger struct. ConvertLogger is the
 // inverse of (Logger) Export().
 func ConvertLogger(logger jasper.Logger) *Logger {
-	return &Logger{
+	out := &Logger{
 		LogType:    ConvertLogType(logger.Type),
 		LogOptions: ConvertLogOptions(logger.Options),
 	}
+	for _, stream := range logger.Streams {
+		out.Streams = append(out.Streams, ConvertLogStreamOptions(stream))
+	}
+	return out
+}
+
+// Export takes a protobuf RPC LogStream struct and returns the analogous
+// Jasper LogStream struct.
+func (s LogStream) Export() jasper.LogStream {
+	switch s {
+	case LogStream_LOGSTREAMOUTPUT:
+		return jasper.LogStreamOutput
+	case LogStream_LOGSTREAMERROR:
+		return jasper.LogStreamError
+	default:
+		return jasper.LogStream("")
+	}
+}
+
+// ConvertLogStream takes a Jasper LogStream struct and returns an
+// equivalent protobuf RPC LogStream struct. ConvertLogStream is the
+// inverse of (LogStream) Export().
+func ConvertLogStream(s jasper.LogStream) LogStream {
+	switch s {
+	case jasper.LogStreamOutput:
+		return LogStream_LOGSTREAMOUTPUT
+	case jasper.LogStreamError:
+		return LogStream_LOGSTREAMERROR
+	default:
+		return LogStream_LOGSTREAMUNKNOWN
+	}
+}
+
+// Export takes a protobuf RPC LogStreamOptions struct and returns the
+// analogous Jasper LogStreamOptions struct.
+func (opts *LogStreamOptions) Export() jasper.LogStreamOptions {
+	return jasper.LogStreamOptions{
+		Stream: opts.Stream.Export(),
+		Prefix: opts.Prefix,
+		Level:  level.Priority(opts.Level),
+	}
+}
+
+// ConvertLogStreamOptions takes a Jasper LogStreamOptions struct and
+// returns an equivalent protobuf RPC LogStreamOptions struct.
+// ConvertLogStreamOptions is the inverse of (*LogStreamOptions) Export().
+func ConvertLogStreamOptions(opts jasper.LogStreamOptions) *LogStreamOptions {
+	return &LogStreamOptions{
+		Stream: ConvertLogStream(opts.Stream),
+		Prefix: opts.Prefix,
+		Level:  int32(opts.Level),
+	}
 }
 
 // Export takes a protobuf RPC LogOptions struct and returns the analogous
@@ -268,6 +505,8 @@ func (opts LogOptions) Export() jasper.LogOptions {
 		Format:        opts.Format.Export(),
 		InMemoryCap:   int(opts.InMemoryCap),
 		SumoEndpoint:  opts.SumoEndpoint,
+
+		InMemoryMaxBytes: int(opts.InMemoryMaxBytes),
 	}
 
 	if opts.SplunkOptions != nil {
@@ -279,6 +518,18 @@ func (opts LogOptions) Export() jasper.LogOptions {
 	if opts.BuildloggerOptions != nil {
 		out.BuildloggerOptions = opts.BuildloggerOptions.Export()
 	}
+	if opts.RotationOptions != nil {
+		out.RotationOptions = opts.RotationOptions.Export()
+	}
+	if opts.LineParser != nil {
+		out.LineParser = opts.LineParser.Export()
+	}
+	if opts.SyslogOptions != nil {
+		out.SyslogOptions = opts.SyslogOptions.Export()
+	}
+	if opts.HttpOptions != nil {
+		out.HTTPOptions = opts.HttpOptions.Export()
+	}
 
 	return out
 }
@@ -294,8 +545,13 @@ func ConvertLogOptions(opts jasper.LogOptions) *LogOptions {
 		FileName:           opts.FileName,
 		Format:             ConvertLogFormat(opts.Format),
 		InMemoryCap:        int64(opts.InMemoryCap),
+		RotationOptions:    ConvertRotationOptions(opts.RotationOptions),
+		LineParser:         ConvertLineParserOptions(opts.LineParser),
+		SyslogOptions:      ConvertSyslogOptions(opts.SyslogOptions),
+		HttpOptions:        ConvertHTTPLogOptions(opts.HTTPOptions),
 		SplunkOptions:      ConvertSplunkOptions(opts.SplunkOptions),
 		SumoEndpoint:       opts.SumoEndpoint,
+		InMemoryMaxBytes:   int64(opts.InMemoryMaxBytes),
 	}
 }
 
@@ -320,6 +576,135 @@ func ConvertBufferOptions(opts jasper.BufferOptions) *BufferOptions {
 	}
 }
 
+// Export takes a protobuf RPC RotationOptions struct and returns the
+// analogous Jasper RotationOptions struct.
+func (opts *RotationOptions) Export() jasper.RotationOptions {
+	return jasper.RotationOptions{
+		MaxSize:  int(opts.MaxSize),
+		Interval: time.Duration(opts.Interval),
+		MaxFiles: int(opts.MaxFiles),
+		Compress: opts.Compress,
+	}
+}
+
+// ConvertRotationOptions takes a Jasper RotationOptions struct and returns
+// an equivalent protobuf RPC RotationOptions struct.
+// ConvertRotationOptions is the inverse of (*RotationOptions) Export().
+func ConvertRotationOptions(opts jasper.RotationOptions) *RotationOptions {
+	return &RotationOptions{
+		MaxSize:  int64(opts.MaxSize),
+		Interval: int64(opts.Interval),
+		MaxFiles: int64(opts.MaxFiles),
+		Compress: opts.Compress,
+	}
+}
+
+// Export takes a protobuf RPC SyslogOptions struct and returns the
+// analogous Jasper SyslogOptions struct.
+func (opts *SyslogOptions) Export() jasper.SyslogOptions {
+	return jasper.SyslogOptions{
+		Network:  opts.Network,
+		Address:  opts.Address,
+		Facility: opts.Facility,
+		AppName:  opts.AppName,
+	}
+}
+
+// ConvertSyslogOptions takes a Jasper SyslogOptions struct and returns an
+// equivalent protobuf RPC SyslogOptions struct. ConvertSyslogOptions is the
+// inverse of (*SyslogOptions) Export().
+func ConvertSyslogOptions(opts jasper.SyslogOptions) *SyslogOptions {
+	return &SyslogOptions{
+		Network:  opts.Network,
+		Address:  opts.Address,
+		Facility: opts.Facility,
+		AppName:  opts.AppName,
+	}
+}
+
+// Export takes a protobuf RPC HTTPLogOptions struct and returns the
+// analogous Jasper HTTPLogOptions struct.
+func (opts *HTTPLogOptions) Export() jasper.HTTPLogOptions {
+	return jasper.HTTPLogOptions{
+		URL:          opts.Url,
+		Headers:      opts.Headers,
+		Username:     opts.Username,
+		Password:     opts.Password,
+		BatchKey:     opts.BatchKey,
+		Fields:       opts.Fields,
+		MaxRetries:   int(opts.MaxRetries),
+		RetryBackoff: time.Duration(opts.RetryBackoff),
+		Timeout:      time.Duration(opts.Timeout),
+	}
+}
+
+// ConvertHTTPLogOptions takes a Jasper HTTPLogOptions struct and returns an
+// equivalent protobuf RPC HTTPLogOptions struct. ConvertHTTPLogOptions is
+// the inverse of (*HTTPLogOptions) Export().
+func ConvertHTTPLogOptions(opts jasper.HTTPLogOptions) *HTTPLogOptions {
+	return &HTTPLogOptions{
+		Url:          opts.URL,
+		Headers:      opts.Headers,
+		Username:     opts.Username,
+		Password:     opts.Password,
+		BatchKey:     opts.BatchKey,
+		Fields:       opts.Fields,
+		MaxRetries:   int64(opts.MaxRetries),
+		RetryBackoff: int64(opts.RetryBackoff),
+		Timeout:      int64(opts.Timeout),
+	}
+}
+
+// Export takes a protobuf RPC LineParserType struct and returns the
+// analogous Jasper LineParserType struct.
+func (t LineParserType) Export() jasper.LineParserType {
+	switch t {
+	case LineParserType_LINEPARSERJSON:
+		return jasper.LineParserJSON
+	case LineParserType_LINEPARSERLOGFMT:
+		return jasper.LineParserLogfmt
+	case LineParserType_LINEPARSERREGEX:
+		return jasper.LineParserRegex
+	default:
+		return jasper.LineParserNone
+	}
+}
+
+// ConvertLineParserType takes a Jasper LineParserType struct and returns an
+// equivalent protobuf RPC LineParserType struct. ConvertLineParserType is
+// the inverse of (LineParserType) Export().
+func ConvertLineParserType(t jasper.LineParserType) LineParserType {
+	switch t {
+	case jasper.LineParserJSON:
+		return LineParserType_LINEPARSERJSON
+	case jasper.LineParserLogfmt:
+		return LineParserType_LINEPARSERLOGFMT
+	case jasper.LineParserRegex:
+		return LineParserType_LINEPARSERREGEX
+	default:
+		return LineParserType_LINEPARSERNONE
+	}
+}
+
+// Export takes a protobuf RPC LineParserOptions struct and returns the
+// analogous Jasper LineParserOptions struct.
+func (opts *LineParserOptions) Export() jasper.LineParserOptions {
+	return jasper.LineParserOptions{
+		Type:    opts.Type.Export(),
+		Pattern: opts.Pattern,
+	}
+}
+
+// ConvertLineParserOptions takes a Jasper LineParserOptions struct and
+// returns an equivalent protobuf RPC LineParserOptions struct.
+// ConvertLineParserOptions is the inverse of (*LineParserOptions) Export().
+func ConvertLineParserOptions(opts jasper.LineParserOptions) *LineParserOptions {
+	return &LineParserOptions{
+		Type:    ConvertLineParserType(opts.Type),
+		Pattern: opts.Pattern,
+	}
+}
+
 // Export takes a protobuf RPC BuildloggerOptions struct and returns the
 // analogous grip/send.BuildloggerConfig struct.
 func (opts BuildloggerOptions) Export() send.BuildloggerConfig {
@@ -401,8 +786,10 @@ func (f LogFormat) Export() jasper.LogFormat {
 		return jasper.LogFormatJSON
 	case LogFormat_LOGFORMATPLAIN:
 		return jasper.LogFormatPlain
-	default:
+	case LogFormat_LOGFORMATINVALID:
 		return jasper.LogFormatInvalid
+	default:
+		return jasper.LogFormat("")
 	}
 }
 
@@ -417,6 +804,8 @@ func ConvertLogFormat(f jasper.LogFormat) LogFormat {
 		return LogFormat_LOGFORMATJSON
 	case jasper.LogFormatPlain:
 		return LogFormat_LOGFORMATPLAIN
+	case jasper.LogFormatInvalid:
+		return LogFormat_LOGFORMATINVALID
 	default:
 		return LogFormat_LOGFORMATUNKNOWN
 	}
@@ -437,17 +826,104 @@ func (opts *BuildOptions) Export() bond.BuildOptions {
 // analogous Jasper MongoDBDownloadOptions struct.
 func (opts *MongoDBDownloadOptions) Export() jasper.MongoDBDownloadOptions {
 	jopts := jasper.MongoDBDownloadOptions{
-		BuildOpts: opts.BuildOptions.Export(),
-		Path:      opts.Path,
+		BuildOpts:         opts.BuildOptions.Export(),
+		Path:              opts.Path,
+		Workers:           int(opts.Workers),
+		MaxBytesPerSecond: opts.MaxBytesPerSecond,
 	}
 
 	jopts.Releases = make([]string, 0, len(opts.Releases))
 	for _, release := range opts.Releases {
 		jopts.Releases = append(jopts.Releases, release)
 	}
+	if opts.Sources != nil {
+		jopts.Sources = opts.Sources.Export()
+	}
 	return jopts
 }
 
+// ConvertBuildOptions takes a bond.BuildOptions struct and returns an
+// equivalent protobuf RPC BuildOptions struct. ConvertBuildOptions is the
+// inverse of (*BuildOptions) Export().
+func ConvertBuildOptions(opts bond.BuildOptions) *BuildOptions {
+	return &BuildOptions{
+		Target:  opts.Target,
+		Arch:    string(opts.Arch),
+		Edition: string(opts.Edition),
+		Debug:   opts.Debug,
+	}
+}
+
+// ConvertMongoDBDownloadOptions takes a Jasper MongoDBDownloadOptions struct
+// and returns an equivalent protobuf RPC MongoDBDownloadOptions struct.
+// ConvertMongoDBDownloadOptions is the inverse of
+// (*MongoDBDownloadOptions) Export().
+func ConvertMongoDBDownloadOptions(jopts jasper.MongoDBDownloadOptions) *MongoDBDownloadOptions {
+	opts := &MongoDBDownloadOptions{
+		BuildOptions:      ConvertBuildOptions(jopts.BuildOpts),
+		Path:              jopts.Path,
+		Sources:           ConvertDownloadSources(jopts.Sources),
+		Workers:           int64(jopts.Workers),
+		MaxBytesPerSecond: jopts.MaxBytesPerSecond,
+	}
+
+	opts.Releases = make([]string, 0, len(jopts.Releases))
+	for _, release := range jopts.Releases {
+		opts.Releases = append(opts.Releases, release)
+	}
+	return opts
+}
+
+// Export takes a protobuf RPC MongoDBRelease struct and returns the
+// analogous Jasper MongoDBRelease struct.
+func (r *MongoDBRelease) Export() jasper.MongoDBRelease {
+	return jasper.MongoDBRelease{
+		Spec:    r.Spec,
+		Version: r.Version,
+		URL:     r.Url,
+		SHA256:  r.Sha256,
+		Path:    r.Path,
+		BinPath: r.BinPath,
+		Present: r.Present,
+	}
+}
+
+// ConvertMongoDBRelease takes a Jasper MongoDBRelease struct and returns
+// an equivalent protobuf RPC MongoDBRelease struct. ConvertMongoDBRelease
+// is the inverse of (*MongoDBRelease) Export().
+func ConvertMongoDBRelease(r jasper.MongoDBRelease) *MongoDBRelease {
+	return &MongoDBRelease{
+		Spec:    r.Spec,
+		Version: r.Version,
+		Url:     r.URL,
+		Sha256:  r.SHA256,
+		Path:    r.Path,
+		BinPath: r.BinPath,
+		Present: r.Present,
+	}
+}
+
+// Export takes a protobuf RPC MongoDBReleases struct and returns the
+// analogous []jasper.MongoDBRelease.
+func (r *MongoDBReleases) Export() []jasper.MongoDBRelease {
+	releases := make([]jasper.MongoDBRelease, 0, len(r.Releases))
+	for _, release := range r.Releases {
+		releases = append(releases, release.Export())
+	}
+	return releases
+}
+
+// ConvertMongoDBReleases takes a []jasper.MongoDBRelease and returns the
+// analogous protobuf RPC MongoDBReleases struct. ConvertMongoDBReleases is
+// the inverse of (*MongoDBReleases) Export().
+func ConvertMongoDBReleases(releases []jasper.MongoDBRelease) *MongoDBReleases {
+	r := &MongoDBReleases{Releases: make([]*MongoDBRelease, 0, len(releases))}
+	for _, release := range releases {
+		r.Releases = append(r.Releases, ConvertMongoDBRelease(release))
+	}
+	return r
+}
+
 // Export takes a protobuf RPC CacheOptions struct and returns the analogous
 // Jasper CacheOptions struct.
 func (opts *CacheOptions) Export() jasper.CacheOptions {
@@ -458,14 +934,37 @@ func (opts *CacheOptions) Export() jasper.CacheOptions {
 	}
 }
 
+// ConvertCacheOptions takes a Jasper CacheOptions struct and returns an
+// equivalent protobuf RPC CacheOptions struct. ConvertCacheOptions is the
+// inverse of (*CacheOptions) Export().
+func ConvertCacheOptions(jopts jasper.CacheOptions) *CacheOptions {
+	return &CacheOptions{
+		Disabled:   jopts.Disabled,
+		PruneDelay: int64(jopts.PruneDelay),
+		MaxSize:    int64(jopts.MaxSize),
+	}
+}
+
 // Export takes a protobuf RPC DownloadInfo struct and returns the analogous
 // Jasper DownloadInfo struct.
 func (info *DownloadInfo) Export() jasper.DownloadInfo {
-	return jasper.DownloadInfo{
-		Path:        info.Path,
-		URL:         info.Url,
-		ArchiveOpts: info.ArchiveOpts.Export(),
+	jinfo := jasper.DownloadInfo{
+		Path:              info.Path,
+		URL:               info.Url,
+		ArchiveOpts:       info.ArchiveOpts.Export(),
+		SHA256:            info.Sha256,
+		SHA1:              info.Sha1,
+		MD5:               info.Md5,
+		FetchChecksum:     info.FetchChecksum,
+		MaxRetries:        int(info.MaxRetries),
+		RetryBackoff:      time.Duration(info.RetryBackoff),
+		Timeout:           time.Duration(info.Timeout),
+		MaxBytesPerSecond: info.MaxBytesPerSecond,
+	}
+	if info.Sources != nil {
+		jinfo.Sources = info.Sources.Export()
 	}
+	return jinfo
 }
 
 // ConvertDownloadInfo takes a Jasper DownloadInfo struct and returns an
@@ -473,12 +972,240 @@ func (info *DownloadInfo) Export() jasper.DownloadInfo {
 // inverse of (*DownloadInfo) Export().
 func ConvertDownloadInfo(info jasper.DownloadInfo) *DownloadInfo {
 	return &DownloadInfo{
-		Path:        info.Path,
-		Url:         info.URL,
-		ArchiveOpts: ConvertArchiveOptions(info.ArchiveOpts),
+		Path:              info.Path,
+		Url:               info.URL,
+		ArchiveOpts:       ConvertArchiveOptions(info.ArchiveOpts),
+		Sha256:            info.SHA256,
+		Sha1:              info.SHA1,
+		Md5:               info.MD5,
+		FetchChecksum:     info.FetchChecksum,
+		MaxRetries:        int64(info.MaxRetries),
+		RetryBackoff:      int64(info.RetryBackoff),
+		Timeout:           int64(info.Timeout),
+		Sources:           ConvertDownloadSources(info.Sources),
+		MaxBytesPerSecond: info.MaxBytesPerSecond,
+	}
+}
+
+// Export takes a protobuf RPC DownloadSources struct and returns the
+// analogous Jasper DownloadSources struct.
+func (s *DownloadSources) Export() jasper.DownloadSources {
+	return jasper.DownloadSources{
+		Rewrites: s.Rewrites,
+		Mirrors:  s.Mirrors,
+	}
+}
+
+// ConvertDownloadSources takes a Jasper DownloadSources struct and returns
+// an equivalent protobuf RPC DownloadSources struct. ConvertDownloadSources
+// is the inverse of (*DownloadSources) Export().
+func ConvertDownloadSources(s jasper.DownloadSources) *DownloadSources {
+	return &DownloadSources{
+		Rewrites: s.Rewrites,
+		Mirrors:  s.Mirrors,
 	}
 }
 
+// Export takes a protobuf RPC DownloadOptions struct and returns the
+// analogous Jasper DownloadOptions struct.
+func (opts *DownloadOptions) Export() jasper.DownloadOptions {
+	return jasper.DownloadOptions{
+		Workers:           int(opts.Workers),
+		MaxBytesPerSecond: opts.MaxBytesPerSecond,
+	}
+}
+
+// ConvertDownloadOptions takes a Jasper DownloadOptions struct and returns
+// an equivalent protobuf RPC DownloadOptions struct. ConvertDownloadOptions
+// is the inverse of (*DownloadOptions) Export().
+func ConvertDownloadOptions(opts jasper.DownloadOptions) *DownloadOptions {
+	return &DownloadOptions{
+		Workers:           int64(opts.Workers),
+		MaxBytesPerSecond: opts.MaxBytesPerSecond,
+	}
+}
+
+// Export takes a protobuf RPC DownloadStatus struct and returns the
+// analogous Jasper DownloadStatus struct.
+func (s *DownloadStatus) Export() jasper.DownloadStatus {
+	status := jasper.DownloadStatus{
+		Pending:      int(s.Pending),
+		Running:      int(s.Running),
+		CacheEntries: int(s.CacheEntries),
+		CacheSize:    s.CacheSize,
+	}
+	if s.Options != nil {
+		status.Options = s.Options.Export()
+	}
+	return status
+}
+
+// ConvertDownloadStatus takes a Jasper DownloadStatus struct and returns an
+// equivalent protobuf RPC DownloadStatus struct. ConvertDownloadStatus is
+// the inverse of (*DownloadStatus) Export().
+func ConvertDownloadStatus(s jasper.DownloadStatus) *DownloadStatus {
+	return &DownloadStatus{
+		Options:      ConvertDownloadOptions(s.Options),
+		Pending:      int64(s.Pending),
+		Running:      int64(s.Running),
+		CacheEntries: int64(s.CacheEntries),
+		CacheSize:    s.CacheSize,
+	}
+}
+
+// Export takes a protobuf RPC DownloadJobState and returns the analogous
+// Jasper DownloadJobState.
+func (s DownloadJobState) Export() jasper.DownloadJobState {
+	switch s {
+	case DownloadJobState_DOWNLOADJOBPENDING:
+		return jasper.DownloadJobPending
+	case DownloadJobState_DOWNLOADJOBRUNNING:
+		return jasper.DownloadJobRunning
+	case DownloadJobState_DOWNLOADJOBCOMPLETED:
+		return jasper.DownloadJobCompleted
+	case DownloadJobState_DOWNLOADJOBFAILED:
+		return jasper.DownloadJobFailed
+	case DownloadJobState_DOWNLOADJOBCANCELED:
+		return jasper.DownloadJobCanceled
+	default:
+		return ""
+	}
+}
+
+// ConvertDownloadJobState takes a Jasper DownloadJobState and returns an
+// equivalent protobuf RPC DownloadJobState. ConvertDownloadJobState is the
+// inverse of (DownloadJobState) Export().
+func ConvertDownloadJobState(s jasper.DownloadJobState) DownloadJobState {
+	switch s {
+	case jasper.DownloadJobPending:
+		return DownloadJobState_DOWNLOADJOBPENDING
+	case jasper.DownloadJobRunning:
+		return DownloadJobState_DOWNLOADJOBRUNNING
+	case jasper.DownloadJobCompleted:
+		return DownloadJobState_DOWNLOADJOBCOMPLETED
+	case jasper.DownloadJobFailed:
+		return DownloadJobState_DOWNLOADJOBFAILED
+	case jasper.DownloadJobCanceled:
+		return DownloadJobState_DOWNLOADJOBCANCELED
+	default:
+		return DownloadJobState_DOWNLOADJOBUNKNOWN
+	}
+}
+
+// Export takes a protobuf RPC DownloadJobStatus struct and returns the
+// analogous Jasper DownloadJobStatus struct.
+func (s *DownloadJobStatus) Export() jasper.DownloadJobStatus {
+	status := jasper.DownloadJobStatus{
+		ID:               s.Id,
+		State:            s.State.Export(),
+		BytesTransferred: s.BytesTransferred,
+		BytesTotal:       s.BytesTotal,
+		Rate:             s.Rate,
+		Error:            s.Error,
+		StartTime:        exportTimestamp(s.StartTime),
+		EndTime:          exportTimestamp(s.EndTime),
+	}
+	if s.Info != nil {
+		status.Info = s.Info.Export()
+	}
+	if s.Extracted != nil {
+		status.Extracted = s.Extracted.Export()
+	}
+	return status
+}
+
+// ConvertDownloadJobStatus takes a Jasper DownloadJobStatus struct and
+// returns an equivalent protobuf RPC DownloadJobStatus struct.
+// ConvertDownloadJobStatus is the inverse of (*DownloadJobStatus) Export().
+func ConvertDownloadJobStatus(s jasper.DownloadJobStatus) *DownloadJobStatus {
+	return &DownloadJobStatus{
+		Id:               s.ID,
+		Info:             ConvertDownloadInfo(s.Info),
+		State:            ConvertDownloadJobState(s.State),
+		BytesTransferred: s.BytesTransferred,
+		BytesTotal:       s.BytesTotal,
+		Rate:             s.Rate,
+		Error:            s.Error,
+		StartTime:        convertTimestamp(s.StartTime),
+		EndTime:          convertTimestamp(s.EndTime),
+		Extracted:        ConvertArchiveReport(s.Extracted),
+	}
+}
+
+// Export takes a protobuf RPC DownloadJobStatuses struct and returns the
+// analogous []jasper.DownloadJobStatus.
+func (s *DownloadJobStatuses) Export() []jasper.DownloadJobStatus {
+	statuses := make([]jasper.DownloadJobStatus, 0, len(s.Statuses))
+	for _, status := range s.Statuses {
+		statuses = append(statuses, status.Export())
+	}
+	return statuses
+}
+
+// ConvertDownloadJobStatuses takes a []jasper.DownloadJobStatus and returns
+// the analogous protobuf RPC DownloadJobStatuses struct.
+// ConvertDownloadJobStatuses is the inverse of (*DownloadJobStatuses)
+// Export().
+func ConvertDownloadJobStatuses(statuses []jasper.DownloadJobStatus) *DownloadJobStatuses {
+	s := &DownloadJobStatuses{Statuses: make([]*DownloadJobStatus, 0, len(statuses))}
+	for _, status := range statuses {
+		s.Statuses = append(s.Statuses, ConvertDownloadJobStatus(status))
+	}
+	return s
+}
+
+// Export takes a protobuf RPC DownloadCacheEntry struct and returns the
+// analogous Jasper DownloadCacheEntry struct.
+func (e *DownloadCacheEntry) Export() jasper.DownloadCacheEntry {
+	return jasper.DownloadCacheEntry{
+		Digest:     e.Digest,
+		URLs:       e.Urls,
+		Size:       e.Size,
+		Pinned:     e.Pinned,
+		Hits:       e.Hits,
+		AddTime:    exportTimestamp(e.AddTime),
+		AccessTime: exportTimestamp(e.AccessTime),
+	}
+}
+
+// ConvertDownloadCacheEntry takes a Jasper DownloadCacheEntry struct and
+// returns an equivalent protobuf RPC DownloadCacheEntry struct.
+// ConvertDownloadCacheEntry is the inverse of (*DownloadCacheEntry)
+// Export().
+func ConvertDownloadCacheEntry(e jasper.DownloadCacheEntry) *DownloadCacheEntry {
+	return &DownloadCacheEntry{
+		Digest:     e.Digest,
+		Urls:       e.URLs,
+		Size:       e.Size,
+		Pinned:     e.Pinned,
+		Hits:       e.Hits,
+		AddTime:    convertTimestamp(e.AddTime),
+		AccessTime: convertTimestamp(e.AccessTime),
+	}
+}
+
+// Export takes a protobuf RPC DownloadCacheEntries struct and returns the
+// analogous []jasper.DownloadCacheEntry.
+func (e *DownloadCacheEntries) Export() []jasper.DownloadCacheEntry {
+	entries := make([]jasper.DownloadCacheEntry, 0, len(e.Entries))
+	for _, entry := range e.Entries {
+		entries = append(entries, entry.Export())
+	}
+	return entries
+}
+
+// ConvertDownloadCacheEntries takes a []jasper.DownloadCacheEntry and
+// returns the analogous protobuf RPC DownloadCacheEntries struct.
+// ConvertDownloadCacheEntries is the inverse of (*DownloadCacheEntries)
+// Export().
+func ConvertDownloadCacheEntries(entries []jasper.DownloadCacheEntry) *DownloadCacheEntries {
+	e := &DownloadCacheEntries{Entries: make([]*DownloadCacheEntry, 0, len(entries))}
+	for _, entry := range entries {
+		e.Entries = append(e.Entries, ConvertDownloadCacheEntry(entry))
+	}
+	return e
+}
+
 // Export takes a protobuf RPC ArchiveFormat struct and returns the analogous
 // Jasper ArchiveFormat struct.
 func (format ArchiveFormat) Export() jasper.ArchiveFormat {
@@ -489,6 +1216,14 @@ func (format ArchiveFormat) Export() jasper.ArchiveFormat {
 		return jasper.ArchiveTarGz
 	case ArchiveFormat_ARCHIVEZIP:
 		return jasper.ArchiveZip
+	case ArchiveFormat_ARCHIVETAR:
+		return jasper.ArchiveTar
+	case ArchiveFormat_ARCHIVETARBZ2:
+		return jasper.ArchiveTarBz2
+	case ArchiveFormat_ARCHIVETARXZ:
+		return jasper.ArchiveTarXz
+	case ArchiveFormat_ARCHIVETARZST:
+		return jasper.ArchiveTarZst
 	default:
 		return jasper.ArchiveFormat("")
 	}
@@ -505,6 +1240,14 @@ func ConvertArchiveFormat(format jasper.ArchiveFormat) ArchiveFormat {
 		return ArchiveFormat_ARCHIVETARGZ
 	case jasper.ArchiveZip:
 		return ArchiveFormat_ARCHIVEZIP
+	case jasper.ArchiveTar:
+		return ArchiveFormat_ARCHIVETAR
+	case jasper.ArchiveTarBz2:
+		return ArchiveFormat_ARCHIVETARBZ2
+	case jasper.ArchiveTarXz:
+		return ArchiveFormat_ARCHIVETARXZ
+	case jasper.ArchiveTarZst:
+		return ArchiveFormat_ARCHIVETARZST
 	default:
 		return ArchiveFormat_ARCHIVEUNKNOWN
 	}
@@ -514,9 +1257,14 @@ func ConvertArchiveFormat(format jasper.ArchiveFormat) ArchiveFormat {
 // Jasper ArchiveOptions struct.
 func (opts ArchiveOptions) Export() jasper.ArchiveOptions {
 	return jasper.ArchiveOptions{
-		ShouldExtract: opts.ShouldExtract,
-		Format:        opts.Format.Export(),
-		TargetPath:    opts.TargetPath,
+		ShouldExtract:   opts.ShouldExtract,
+		Format:          opts.Format.Export(),
+		TargetPath:      opts.TargetPath,
+		StripComponents: int(opts.StripComponents),
+		Include:         opts.Include,
+		Exclude:         opts.Exclude,
+		MaxSize:         opts.MaxSize,
+		MaxFiles:        int(opts.MaxFiles),
 	}
 }
 
@@ -525,8 +1273,330 @@ func (opts ArchiveOptions) Export() jasper.ArchiveOptions {
 // inverse of (ArchiveOptions) Export().
 func ConvertArchiveOptions(opts jasper.ArchiveOptions) *ArchiveOptions {
 	return &ArchiveOptions{
-		ShouldExtract: opts.ShouldExtract,
-		Format:        ConvertArchiveFormat(opts.Format),
-		TargetPath:    opts.TargetPath,
+		ShouldExtract:   opts.ShouldExtract,
+		Format:          ConvertArchiveFormat(opts.Format),
+		TargetPath:      opts.TargetPath,
+		StripComponents: int64(opts.StripComponents),
+		Include:         opts.Include,
+		Exclude:         opts.Exclude,
+		MaxSize:         opts.MaxSize,
+		MaxFiles:        int64(opts.MaxFiles),
+	}
+}
+
+// Export takes a protobuf RPC ArchiveReport struct and returns the analogous
+// Jasper ArchiveReport struct.
+func (r *ArchiveReport) Export() jasper.ArchiveReport {
+	return jasper.ArchiveReport{
+		Files: r.Files,
+		Size:  r.Size,
+	}
+}
+
+// ConvertArchiveReport takes a Jasper ArchiveReport struct and returns an
+// equivalent protobuf RPC ArchiveReport struct. ConvertArchiveReport is the
+// inverse of (*ArchiveReport) Export().
+func ConvertArchiveReport(r jasper.ArchiveReport) *ArchiveReport {
+	return &ArchiveReport{
+		Files: r.Files,
+		Size:  r.Size,
+	}
+}
+
+// Export takes a protobuf RPC LogLines struct and returns the analogous
+// []string.
+func (l *LogLines) Export() []string {
+	lines := make([]string, 0, len(l.Lines))
+	for _, line := range l.Lines {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// ConvertLogLines takes a []string and returns the analogous protobuf RPC
+// LogLines struct. ConvertLogLines is the inverse of (*LogLines) Export().
+func ConvertLogLines(lines []string) *LogLines {
+	l := &LogLines{Lines: make([]string, 0, len(lines))}
+	for _, line := range lines {
+		l.Lines = append(l.Lines, line)
+	}
+	return l
+}
+
+// exportTimestamp converts a number of nanoseconds since the Unix epoch
+// into a time.Time, where 0 is the zero time.
+func exportTimestamp(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ts)
+}
+
+// convertTimestamp is the inverse of exportTimestamp.
+func convertTimestamp(ts time.Time) int64 {
+	if ts.IsZero() {
+		return 0
+	}
+	return ts.UnixNano()
+}
+
+// Export takes a protobuf RPC LogQuery struct and returns the process ID
+// and the analogous Jasper LogQuery struct.
+func (q *LogQuery) Export() (string, jasper.LogQuery) {
+	return q.Id, jasper.LogQuery{
+		Stream:  q.Stream.Export(),
+		Since:   exportTimestamp(q.Since),
+		Pattern: q.Pattern,
+		Tail:    int(q.Tail),
+		Offset:  int(q.Offset),
+		Limit:   int(q.Limit),
+	}
+}
+
+// ConvertLogQuery takes a process ID and a Jasper LogQuery struct and
+// returns an equivalent protobuf RPC LogQuery struct. ConvertLogQuery is
+// the inverse of (*LogQuery) Export().
+func ConvertLogQuery(id string, q jasper.LogQuery) *LogQuery {
+	return &LogQuery{
+		Id:      id,
+		Stream:  ConvertLogStream(q.Stream),
+		Since:   convertTimestamp(q.Since),
+		Pattern: q.Pattern,
+		Tail:    int64(q.Tail),
+		Offset:  int64(q.Offset),
+		Limit:   int64(q.Limit),
+	}
+}
+
+// Export takes a protobuf RPC LogRecords struct and returns the analogous
+// []jasper.LogRecord. The fields of each record are carried as the same
+// JSON document that the REST service reports.
+func (r *LogRecords) Export() ([]jasper.LogRecord, error) {
+	records := make([]jasper.LogRecord, 0, len(r.Records))
+	for _, record := range r.Records {
+		out := jasper.LogRecord{
+			Timestamp: exportTimestamp(record.Timestamp),
+			Stream:    record.Stream.Export(),
+			Logger:    int(record.Logger),
+			Line:      record.Line,
+		}
+		if len(record.Fields) > 0 {
+			if err := json.Unmarshal(record.Fields, &out.Fields); err != nil {
+				return nil, errors.Wrap(err, "problem reading log record fields")
+			}
+		}
+		records = append(records, out)
 	}
+	return records, nil
+}
+
+// ConvertLogRecords takes a []jasper.LogRecord and returns the analogous
+// protobuf RPC LogRecords struct. ConvertLogRecords is the inverse of
+// (*LogRecords) Export().
+func ConvertLogRecords(records []jasper.LogRecord) (*LogRecords, error) {
+	r := &LogRecords{Records: make([]*LogRecord, 0, len(records))}
+	for _, record := range records {
+		out := &LogRecord{
+			Timestamp: convertTimestamp(record.Timestamp),
+			Stream:    ConvertLogStream(record.Stream),
+			Logger:    int64(record.Logger),
+			Line:      record.Line,
+		}
+		if len(record.Fields) > 0 {
+			var err error
+			if out.Fields, err = json.Marshal(record.Fields); err != nil {
+				return nil, errors.Wrap(err, "problem writing log record fields")
+			}
+		}
+		r.Records = append(r.Records, out)
+	}
+	return r, nil
+}
+
+// Export takes a protobuf RPC ProcessMetrics struct and returns the analogous
+// []message.ProcessInfo.
+func (m *ProcessMetrics) Export() ([]message.ProcessInfo, error) {
+	infos := make([]message.ProcessInfo, 0, len(m.Processes))
+	for _, proc := range m.Processes {
+		info, err := proc.Export()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// ConvertProcessMetrics takes a slice of grip/message.ProcessInfo composers,
+// as produced by message.CollectProcessInfoWithChildren, and returns the
+// analogous protobuf RPC ProcessMetrics struct. ConvertProcessMetrics is the
+// inverse of (*ProcessMetrics) Export().
+func ConvertProcessMetrics(msgs []message.Composer) (*ProcessMetrics, error) {
+	m := &ProcessMetrics{Processes: make([]*ProcessMetricsInfo, 0, len(msgs))}
+	for _, msg := range msgs {
+		info, ok := msg.(*message.ProcessInfo)
+		if !ok {
+			return nil, errors.Errorf("cannot convert message of type %T to process metrics", msg)
+		}
+		proc, err := ConvertProcessMetricsInfo(*info)
+		if err != nil {
+			return nil, err
+		}
+		m.Processes = append(m.Processes, proc)
+	}
+	return m, nil
+}
+
+// Export takes a protobuf RPC ProcessMetricsInfo struct and returns the
+// analogous grip/message.ProcessInfo struct. Of the message's metadata,
+// only the level, hostname, and time are carried.
+func (p *ProcessMetricsInfo) Export() (message.ProcessInfo, error) {
+	info := message.ProcessInfo{
+		Message: p.Message,
+		Pid:     p.Pid,
+		Parent:  p.Parent,
+		Threads: int(p.Threads),
+		Command: p.Command,
+		Errors:  p.Errors,
+	}
+	info.Level = level.Priority(p.Level)
+	info.Hostname = p.Hostname
+	info.Time = exportTimestamp(p.Time)
+
+	if cpu := p.Cpu; cpu != nil {
+		info.CPU.CPU = cpu.Cpu
+		info.CPU.User = cpu.User
+		info.CPU.System = cpu.System
+		info.CPU.Idle = cpu.Idle
+		info.CPU.Nice = cpu.Nice
+		info.CPU.Iowait = cpu.Iowait
+		info.CPU.Irq = cpu.Irq
+		info.CPU.Softirq = cpu.Softirq
+		info.CPU.Steal = cpu.Steal
+		info.CPU.Guest = cpu.Guest
+		info.CPU.GuestNice = cpu.GuestNice
+		info.CPU.Stolen = cpu.Stolen
+	}
+	if io := p.Io; io != nil {
+		info.IoStat.ReadCount = io.ReadCount
+		info.IoStat.WriteCount = io.WriteCount
+		info.IoStat.ReadBytes = io.ReadBytes
+		info.IoStat.WriteBytes = io.WriteBytes
+	}
+	if mem := p.Memory; mem != nil {
+		info.Memory.RSS = mem.Rss
+		info.Memory.VMS = mem.Vms
+		info.Memory.Data = mem.Data
+		info.Memory.Stack = mem.Stack
+		info.Memory.Locked = mem.Locked
+		info.Memory.Swap = mem.Swap
+	}
+
+	if len(p.Net) > 0 {
+		// The network counters' type is vendored by grip, so it cannot be
+		// named here; allocate the slice by reflection and fill it in.
+		netStat := reflect.ValueOf(&info.NetStat).Elem()
+		netStat.Set(reflect.MakeSlice(netStat.Type(), len(p.Net), len(p.Net)))
+		for i, net := range p.Net {
+			info.NetStat[i].Name = net.Name
+			info.NetStat[i].BytesSent = net.BytesSent
+			info.NetStat[i].BytesRecv = net.BytesRecv
+			info.NetStat[i].PacketsSent = net.PacketsSent
+			info.NetStat[i].PacketsRecv = net.PacketsRecv
+			info.NetStat[i].Errin = net.Errin
+			info.NetStat[i].Errout = net.Errout
+			info.NetStat[i].Dropin = net.Dropin
+			info.NetStat[i].Dropout = net.Dropout
+			info.NetStat[i].Fifoin = net.Fifoin
+			info.NetStat[i].Fifoout = net.Fifoout
+		}
+	}
+
+	// The extended memory statistics differ between platforms, so they
+	// are carried by their JSON field names.
+	if len(p.MemoryPlatform) > 0 {
+		payload, err := json.Marshal(p.MemoryPlatform)
+		if err != nil {
+			return message.ProcessInfo{}, errors.Wrap(err, "problem encoding platform memory metrics")
+		}
+		if err = json.Unmarshal(payload, &info.MemoryPlatform); err != nil {
+			return message.ProcessInfo{}, errors.Wrap(err, "problem reading platform memory metrics")
+		}
+	}
+
+	return info, nil
+}
+
+// ConvertProcessMetricsInfo takes a grip/message.ProcessInfo struct and
+// returns an equivalent protobuf RPC ProcessMetricsInfo struct.
+// ConvertProcessMetricsInfo is the inverse of (*ProcessMetricsInfo) Export().
+func ConvertProcessMetricsInfo(info message.ProcessInfo) (*ProcessMetricsInfo, error) {
+	p := &ProcessMetricsInfo{
+		Message:  info.Message,
+		Pid:      info.Pid,
+		Parent:   info.Parent,
+		Threads:  int64(info.Threads),
+		Command:  info.Command,
+		Errors:   info.Errors,
+		Level:    int64(info.Level),
+		Hostname: info.Hostname,
+		Time:     convertTimestamp(info.Time),
+		Cpu: &ProcessCPUTimes{
+			Cpu:       info.CPU.CPU,
+			User:      info.CPU.User,
+			System:    info.CPU.System,
+			Idle:      info.CPU.Idle,
+			Nice:      info.CPU.Nice,
+			Iowait:    info.CPU.Iowait,
+			Irq:       info.CPU.Irq,
+			Softirq:   info.CPU.Softirq,
+			Steal:     info.CPU.Steal,
+			Guest:     info.CPU.Guest,
+			GuestNice: info.CPU.GuestNice,
+			Stolen:    info.CPU.Stolen,
+		},
+		Io: &ProcessIOCounters{
+			ReadCount:  info.IoStat.ReadCount,
+			WriteCount: info.IoStat.WriteCount,
+			ReadBytes:  info.IoStat.ReadBytes,
+			WriteBytes: info.IoStat.WriteBytes,
+		},
+		Memory: &ProcessMemory{
+			Rss:    info.Memory.RSS,
+			Vms:    info.Memory.VMS,
+			Data:   info.Memory.Data,
+			Stack:  info.Memory.Stack,
+			Locked: info.Memory.Locked,
+			Swap:   info.Memory.Swap,
+		},
+	}
+
+	for _, net := range info.NetStat {
+		p.Net = append(p.Net, &ProcessNetCounters{
+			Name:        net.Name,
+			BytesSent:   net.BytesSent,
+			BytesRecv:   net.BytesRecv,
+			PacketsSent: net.PacketsSent,
+			PacketsRecv: net.PacketsRecv,
+			Errin:       net.Errin,
+			Errout:      net.Errout,
+			Dropin:      net.Dropin,
+			Dropout:     net.Dropout,
+			Fifoin:      net.Fifoin,
+			Fifoout:     net.Fifoout,
+		})
+	}
+
+	payload, err := json.Marshal(info.MemoryPlatform)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem encoding platform memory metrics")
+	}
+	if err = json.Unmarshal(payload, &p.MemoryPlatform); err != nil {
+		return nil, errors.Wrap(err, "problem reading platform memory metrics")
+	}
+	if len(p.MemoryPlatform) == 0 {
+		p.MemoryPlatform = nil
+	}
+
+	return p, nil
 }
@@ -0,0 +1,561 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The generators below must populate every field of the Jasper types that is
+// sent over the wire; TestGeneratorsCoverAllFields fails when a field is
+// added without being generated.
+
+var (
+	logTypes = []jasper.LogType{
+		jasper.LogBuildloggerV2, jasper.LogBuildloggerV3, jasper.LogDefault, jasper.LogFile,
+		jasper.LogInherit, jasper.LogSplunk, jasper.LogSumologic, jasper.LogInMemory, jasper.LogRotatingFile,
+		jasper.LogSyslog, jasper.LogJournald, jasper.LogHTTP,
+	}
+	lineParsers   = []jasper.LineParserType{jasper.LineParserNone, jasper.LineParserJSON, jasper.LineParserLogfmt, jasper.LineParserRegex}
+	logFormats    = []jasper.LogFormat{"", jasper.LogFormatDefault, jasper.LogFormatJSON, jasper.LogFormatPlain, jasper.LogFormatInvalid}
+	limitPolicies = []jasper.OutputLimitPolicy{
+		"", jasper.OutputLimitKeepHead, jasper.OutputLimitKeepTail, jasper.OutputLimitDrop, jasper.OutputLimitKill,
+	}
+)
+
+func randomString(r *rand.Rand) string {
+	if r.Intn(4) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("s%d", r.Int63())
+}
+
+func randomStringMap(r *rand.Rand) map[string]string {
+	out := map[string]string{}
+	for i := r.Intn(4); i > 0; i-- {
+		out[randomString(r)] = randomString(r)
+	}
+	return out
+}
+
+func randomStrings(r *rand.Rand) []string {
+	out := make([]string, r.Intn(4))
+	for i := range out {
+		out[i] = randomString(r)
+	}
+	return out
+}
+
+func randomLogOptions(r *rand.Rand) jasper.LogOptions {
+	return jasper.LogOptions{
+		BufferOptions: jasper.BufferOptions{
+			Buffered: r.Intn(2) == 0,
+			Duration: time.Duration(r.Int63()),
+			MaxSize:  r.Int(),
+		},
+		BuildloggerOptions: send.BuildloggerConfig{
+			CreateTest: r.Intn(2) == 0,
+			URL:        randomString(r),
+			Number:     r.Int(),
+			Phase:      randomString(r),
+			Builder:    randomString(r),
+			Test:       randomString(r),
+			Command:    randomString(r),
+		},
+		DefaultPrefix: randomString(r),
+		FileName:      randomString(r),
+		Format:        logFormats[r.Intn(len(logFormats))],
+		HTTPOptions: jasper.HTTPLogOptions{
+			URL:          randomString(r),
+			Headers:      randomStringMap(r),
+			Username:     randomString(r),
+			Password:     randomString(r),
+			BatchKey:     randomString(r),
+			Fields:       randomStringMap(r),
+			MaxRetries:   r.Int(),
+			RetryBackoff: time.Duration(r.Int63()),
+			Timeout:      time.Duration(r.Int63()),
+		},
+		InMemoryCap:      r.Int(),
+		InMemoryMaxBytes: r.Int(),
+		LineParser: jasper.LineParserOptions{
+			Type:    lineParsers[r.Intn(len(lineParsers))],
+			Pattern: randomString(r),
+		},
+		RotationOptions: jasper.RotationOptions{
+			MaxSize:  r.Int(),
+			Interval: time.Duration(r.Int63()),
+			MaxFiles: r.Int(),
+			Compress: r.Intn(2) == 0,
+		},
+		SplunkOptions: send.SplunkConnectionInfo{
+			ServerURL: randomString(r),
+			Token:     randomString(r),
+			Channel:   randomString(r),
+		},
+		SumoEndpoint: randomString(r),
+		SyslogOptions: jasper.SyslogOptions{
+			Network:  randomString(r),
+			Address:  randomString(r),
+			Facility: randomString(r),
+			AppName:  randomString(r),
+		},
+	}
+}
+
+func randomOutputOptions(r *rand.Rand) jasper.OutputOptions {
+	opts := jasper.OutputOptions{
+		SuppressOutput:    r.Intn(2) == 0,
+		SuppressError:     r.Intn(2) == 0,
+		SendOutputToError: r.Intn(2) == 0,
+		SendErrorToOutput: r.Intn(2) == 0,
+		Redaction: jasper.RedactionOptions{
+			Literals: randomStrings(r),
+			Patterns: randomStrings(r),
+			EnvVars:  randomStrings(r),
+		},
+		LineBuffer: jasper.LineBufferOptions{
+			Enabled:         r.Intn(2) == 0,
+			Prefix:          randomString(r),
+			TimestampFormat: randomString(r),
+		},
+		Limits: jasper.OutputLimitOptions{
+			MaxBytes: r.Int63(),
+			Policy:   limitPolicies[r.Intn(len(limitPolicies))],
+		},
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		logger := jasper.Logger{
+			Type:    logTypes[r.Intn(len(logTypes))],
+			Options: randomLogOptions(r),
+		}
+		for _, stream := range []jasper.LogStream{jasper.LogStreamOutput, jasper.LogStreamError} {
+			if r.Intn(2) == 0 {
+				logger.Streams = append(logger.Streams, jasper.LogStreamOptions{
+					Stream: stream,
+					Prefix: randomString(r),
+					Level:  level.Priority(r.Intn(int(level.Emergency) + 1)),
+				})
+			}
+		}
+		opts.Loggers = append(opts.Loggers, logger)
+	}
+	return opts
+}
+
+func randomCreateOptions(r *rand.Rand, depth int) *jasper.CreateOptions {
+	opts := &jasper.CreateOptions{
+		Args:             randomStrings(r),
+		Hostname:         randomString(r),
+		WorkingDirectory: randomString(r),
+		Output:           randomOutputOptions(r),
+		OverrideEnviron:  r.Intn(2) == 0,
+		Timeout:          time.Duration(r.Int63n(int64(1000 * time.Second))),
+		Tags:             randomStrings(r),
+	}
+	opts.TimeoutSecs = int(opts.Timeout.Seconds())
+
+	opts.Environment = randomStringMap(r)
+
+	if depth > 0 {
+		for i := r.Intn(3); i > 0; i-- {
+			opts.OnSuccess = append(opts.OnSuccess, randomCreateOptions(r, depth-1))
+		}
+		for i := r.Intn(3); i > 0; i-- {
+			opts.OnFailure = append(opts.OnFailure, randomCreateOptions(r, depth-1))
+		}
+		for i := r.Intn(3); i > 0; i-- {
+			opts.OnTimeout = append(opts.OnTimeout, randomCreateOptions(r, depth-1))
+		}
+	}
+
+	return opts
+}
+
+// normalize makes empty slices and maps nil, recursively, since neither
+// encoding distinguishes between the two.
+func normalize(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			normalize(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				normalize(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if v.Len() == 0 {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			normalize(v.Index(i))
+		}
+	case reflect.Map:
+		if v.Len() == 0 {
+			v.Set(reflect.Zero(v.Type()))
+		}
+	}
+}
+
+func viaProto(t *testing.T, opts *jasper.CreateOptions) *jasper.CreateOptions {
+	payload, err := proto.Marshal(ConvertCreateOptions(opts))
+	require.NoError(t, err)
+
+	out := &CreateOptions{}
+	require.NoError(t, proto.Unmarshal(payload, out))
+
+	return out.Export()
+}
+
+func viaJSON(t *testing.T, opts *jasper.CreateOptions) *jasper.CreateOptions {
+	payload, err := json.Marshal(opts)
+	require.NoError(t, err)
+
+	out := &jasper.CreateOptions{}
+	require.NoError(t, json.Unmarshal(payload, out))
+
+	return out
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	config := &quick.Config{MaxCount: 200}
+
+	for name, generate := range map[string]func(*rand.Rand) *jasper.CreateOptions{
+		"CreateOptions": func(r *rand.Rand) *jasper.CreateOptions {
+			return randomCreateOptions(r, 2)
+		},
+		"OutputOptions": func(r *rand.Rand) *jasper.CreateOptions {
+			return &jasper.CreateOptions{Output: randomOutputOptions(r)}
+		},
+		"LogOptions": func(r *rand.Rand) *jasper.CreateOptions {
+			return &jasper.CreateOptions{Output: jasper.OutputOptions{
+				Loggers: []jasper.Logger{{Type: logTypes[r.Intn(len(logTypes))], Options: randomLogOptions(r)}},
+			}}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.NoError(t, quick.Check(func(seed int64) bool {
+				opts := generate(rand.New(rand.NewSource(seed)))
+				normalize(reflect.ValueOf(opts))
+
+				fromProto := viaProto(t, opts)
+				normalize(reflect.ValueOf(fromProto))
+				fromJSON := viaJSON(t, opts)
+				normalize(reflect.ValueOf(fromJSON))
+
+				return assert.Equal(t, opts, fromProto, "proto encoding is lossy") &&
+					assert.Equal(t, opts, fromJSON, "REST JSON encoding is lossy") &&
+					assert.Equal(t, fromJSON, fromProto, "REST JSON and proto encodings differ")
+			}, config))
+		})
+	}
+}
+
+// TestGeneratorsCoverAllFields ensures that the round trip tests exercise
+// every field that is sent over the wire.
+func TestGeneratorsCoverAllFields(t *testing.T) {
+	// These fields are local to the process that sets them, and are
+	// not sent by either the REST or the GRPC API.
+	localOnly := map[string]bool{
+		"OutputOptions.Output":    true,
+		"OutputOptions.Error":     true,
+		"BuildloggerConfig.Local": true,
+	}
+
+	populated := map[string]bool{}
+	var visit func(reflect.Value)
+	visit = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Ptr:
+			if !v.IsNil() {
+				visit(v.Elem())
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				visit(v.Index(i))
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				field := v.Type().Field(i)
+				if field.PkgPath != "" {
+					continue
+				}
+				key := v.Type().Name() + "." + field.Name
+				if _, ok := populated[key]; !ok {
+					populated[key] = false
+				}
+				if !reflect.DeepEqual(v.Field(i).Interface(), reflect.Zero(field.Type).Interface()) {
+					populated[key] = true
+				}
+				visit(v.Field(i))
+			}
+		}
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 100; i++ {
+		visit(reflect.ValueOf(randomCreateOptions(r, 2)))
+	}
+
+	for _, typ := range []interface{}{jasper.CreateOptions{}, jasper.OutputOptions{}, jasper.Logger{}, jasper.LogStreamOptions{}, jasper.LogOptions{}, jasper.LineParserOptions{}, jasper.RedactionOptions{}, jasper.LineBufferOptions{}, jasper.OutputLimitOptions{}, jasper.SyslogOptions{}, jasper.HTTPLogOptions{}} {
+		require.Contains(t, populated, reflect.TypeOf(typ).Name()+"."+reflect.TypeOf(typ).Field(0).Name)
+	}
+	for key, ok := range populated {
+		if localOnly[key] {
+			continue
+		}
+		assert.True(t, ok, "field %s is never generated", key)
+	}
+}
+
+func TestLogQueryRoundTrip(t *testing.T) {
+	for name, q := range map[string]jasper.LogQuery{
+		"Empty": {},
+		"Full": {
+			Stream:  jasper.LogStreamError,
+			Since:   time.Unix(0, time.Now().UnixNano()),
+			Pattern: "foo.*",
+			Tail:    10,
+			Offset:  2,
+			Limit:   3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			id, out := ConvertLogQuery("proc", q).Export()
+			assert.Equal(t, "proc", id)
+			assert.Equal(t, q, out)
+		})
+	}
+}
+
+func TestLogRecordsRoundTrip(t *testing.T) {
+	records := []jasper.LogRecord{
+		{Timestamp: time.Unix(0, time.Now().UnixNano()), Stream: jasper.LogStreamOutput, Logger: 0, Line: "foo"},
+		{Timestamp: time.Unix(0, time.Now().UnixNano()), Stream: jasper.LogStreamError, Logger: 1, Line: "bar"},
+		{Line: `{"a": "b", "c": {"d": 1}}`, Fields: message.Fields{"a": "b", "c": map[string]interface{}{"d": 1.0}}},
+		{Line: "baz"},
+	}
+
+	converted, err := ConvertLogRecords(records)
+	require.NoError(t, err)
+	out, err := converted.Export()
+	require.NoError(t, err)
+	assert.Equal(t, records, out)
+
+	converted, err = ConvertLogRecords(nil)
+	require.NoError(t, err)
+	out, err = converted.Export()
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestProcessInfoRoundTrip(t *testing.T) {
+	info := jasper.ProcessInfo{
+		ID:              "proc",
+		Host:            "host",
+		PID:             42,
+		ExitCode:        -1,
+		Complete:        true,
+		Timeout:         true,
+		OutputTruncated: true,
+		ErrorTruncated:  true,
+		Options:         jasper.CreateOptions{Args: []string{"foo"}},
+	}
+	out := ConvertProcessInfo(info).Export()
+	assert.Equal(t, info.ID, out.ID)
+	assert.Equal(t, info.ExitCode, out.ExitCode)
+	assert.Equal(t, info.Timeout, out.Timeout)
+	assert.True(t, out.OutputTruncated)
+	assert.True(t, out.ErrorTruncated)
+	assert.Equal(t, info.Options.Args, out.Options.Args)
+}
+
+func TestDownloadInfoRoundTrip(t *testing.T) {
+	info := jasper.DownloadInfo{
+		URL:  "http://example.com/foo",
+		Path: "/foo",
+		ArchiveOpts: jasper.ArchiveOptions{
+			ShouldExtract:   true,
+			Format:          jasper.ArchiveTarXz,
+			TargetPath:      "/bar",
+			StripComponents: 1,
+			Include:         []string{"bin/*"},
+			Exclude:         []string{"*.txt"},
+			MaxSize:         1024,
+			MaxFiles:        10,
+		},
+		SHA256:        "sha256",
+		SHA1:          "sha1",
+		MD5:           "md5",
+		FetchChecksum: true,
+		MaxRetries:    3,
+		RetryBackoff:  time.Second,
+		Timeout:       time.Minute,
+		Sources: jasper.DownloadSources{
+			Rewrites: map[string]string{"http://example.com/": "file:///mirror/"},
+			Mirrors:  []string{"http://mirror.example.com"},
+		},
+		MaxBytesPerSecond: 1024,
+	}
+	assert.Equal(t, info, ConvertDownloadInfo(info).Export())
+}
+
+func TestDownloadStatusRoundTrip(t *testing.T) {
+	status := jasper.DownloadStatus{
+		Options:      jasper.DownloadOptions{Workers: 4, MaxBytesPerSecond: 1024},
+		Pending:      2,
+		Running:      4,
+		CacheEntries: 3,
+		CacheSize:    4096,
+	}
+	assert.Equal(t, status, ConvertDownloadStatus(status).Export())
+	assert.Equal(t, jasper.DownloadStatus{}, (&DownloadStatus{}).Export())
+}
+
+func TestDownloadJobStatusesRoundTrip(t *testing.T) {
+	statuses := []jasper.DownloadJobStatus{
+		{
+			ID:               "job",
+			Info:             jasper.DownloadInfo{URL: "http://example.com/foo", Path: "/foo", ArchiveOpts: jasper.ArchiveOptions{Format: jasper.ArchiveAuto}},
+			State:            jasper.DownloadJobRunning,
+			BytesTransferred: 512,
+			BytesTotal:       1024,
+			Rate:             128.5,
+			StartTime:        time.Unix(0, time.Now().UnixNano()),
+		},
+		{
+			ID:         "failed",
+			Info:       jasper.DownloadInfo{ArchiveOpts: jasper.ArchiveOptions{}},
+			State:      jasper.DownloadJobFailed,
+			BytesTotal: -1,
+			Error:      "error",
+			StartTime:  time.Unix(0, time.Now().UnixNano()),
+			EndTime:    time.Unix(0, time.Now().UnixNano()),
+		},
+		{
+			ID:        "extracted",
+			State:     jasper.DownloadJobCompleted,
+			Extracted: jasper.ArchiveReport{Files: []string{"bin/mongod", "bin/mongo"}, Size: 2048},
+		},
+	}
+	for _, state := range []jasper.DownloadJobState{jasper.DownloadJobPending, jasper.DownloadJobCompleted, jasper.DownloadJobCanceled} {
+		statuses = append(statuses, jasper.DownloadJobStatus{ID: string(state), State: state})
+	}
+
+	assert.Equal(t, statuses, ConvertDownloadJobStatuses(statuses).Export())
+	assert.Empty(t, ConvertDownloadJobStatuses(nil).Export())
+}
+
+func TestDownloadCacheEntriesRoundTrip(t *testing.T) {
+	entries := []jasper.DownloadCacheEntry{
+		{
+			Digest:     "digest",
+			URLs:       []string{"http://example.com/foo", "http://mirror.example.com/foo"},
+			Size:       1024,
+			Pinned:     true,
+			Hits:       3,
+			AddTime:    time.Unix(0, time.Now().UnixNano()),
+			AccessTime: time.Unix(0, time.Now().UnixNano()),
+		},
+		{Digest: "empty"},
+	}
+
+	assert.Equal(t, entries, ConvertDownloadCacheEntries(entries).Export())
+	assert.Empty(t, ConvertDownloadCacheEntries(nil).Export())
+}
+
+func TestMongoDBReleasesRoundTrip(t *testing.T) {
+	releases := []jasper.MongoDBRelease{
+		{
+			Spec:    "4.0.x",
+			Version: "4.0.3",
+			URL:     "http://example.com/mongodb-linux-x86_64-4.0.3.tgz",
+			SHA256:  "sha256",
+			Path:    "/mongodb/mongodb-linux-x86_64-4.0.3",
+			BinPath: "/mongodb/mongodb-linux-x86_64-4.0.3/bin",
+			Present: true,
+		},
+		{Spec: "4.0-latest", Version: "4.0-latest"},
+	}
+
+	assert.Equal(t, releases, ConvertMongoDBReleases(releases).Export())
+	assert.Empty(t, ConvertMongoDBReleases(nil).Export())
+}
+
+func TestProcessMetricsRoundTrip(t *testing.T) {
+	msg := message.CollectProcessInfoSelf()
+	info, ok := msg.(*message.ProcessInfo)
+	require.True(t, ok)
+	info.Message = "self"
+	info.Errors = []string{"err"}
+	require.NoError(t, info.Collect())
+
+	metrics, err := ConvertProcessMetrics([]message.Composer{msg})
+	require.NoError(t, err)
+	payload, err := proto.Marshal(metrics)
+	require.NoError(t, err)
+	decoded := &ProcessMetrics{}
+	require.NoError(t, proto.Unmarshal(payload, decoded))
+
+	out, err := decoded.Export()
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, info.Message, out[0].Message)
+	assert.Equal(t, info.Pid, out[0].Pid)
+	assert.Equal(t, info.Parent, out[0].Parent)
+	assert.Equal(t, info.Threads, out[0].Threads)
+	assert.Equal(t, info.Command, out[0].Command)
+	assert.Equal(t, info.CPU, out[0].CPU)
+	assert.Equal(t, info.IoStat, out[0].IoStat)
+	assert.Equal(t, info.NetStat, out[0].NetStat)
+	assert.Equal(t, info.Memory, out[0].Memory)
+	assert.Equal(t, info.MemoryPlatform, out[0].MemoryPlatform)
+	assert.Equal(t, info.Errors, out[0].Errors)
+	assert.Equal(t, info.Level, out[0].Level)
+	assert.Equal(t, info.Hostname, out[0].Hostname)
+	assert.True(t, info.Time.Equal(out[0].Time))
+
+	_, err = ConvertProcessMetrics([]message.Composer{message.NewString("foo")})
+	assert.Error(t, err)
+}
+
+func TestCreateOptionsTimeoutCompatibility(t *testing.T) {
+	for name, test := range map[string]func(*testing.T){
+		"ConvertSetsTimeoutSeconds": func(t *testing.T) {
+			opts := &jasper.CreateOptions{Timeout: 90 * time.Second}
+			out := ConvertCreateOptions(opts)
+			assert.EqualValues(t, 90*time.Second, out.Timeout)
+			assert.EqualValues(t, 90, out.TimeoutSeconds)
+			assert.Zero(t, opts.TimeoutSecs)
+		},
+		"ExportUsesTimeoutSecondsWithoutTimeout": func(t *testing.T) {
+			out := (&CreateOptions{TimeoutSeconds: 90}).Export()
+			assert.Equal(t, 90*time.Second, out.Timeout)
+			assert.Equal(t, 90, out.TimeoutSecs)
+		},
+		"ExportPrefersTimeout": func(t *testing.T) {
+			out := (&CreateOptions{Timeout: int64(90*time.Second + time.Millisecond), TimeoutSeconds: 90}).Export()
+			assert.Equal(t, 90*time.Second+time.Millisecond, out.Timeout)
+			assert.Equal(t, 90, out.TimeoutSecs)
+		},
+		// "": func(t *testing.T) {},
+	} {
+		t.Run(name, test)
+	}
+}
@@ -24,6 +24,64 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type LogStream int32
+
+const (
+	LogStream_LOGSTREAMUNKNOWN LogStream = 0
+	LogStream_LOGSTREAMOUTPUT  LogStream = 1
+	LogStream_LOGSTREAMERROR   LogStream = 2
+)
+
+var LogStream_name = map[int32]string{
+	0: "LOGSTREAMUNKNOWN",
+	1: "LOGSTREAMOUTPUT",
+	2: "LOGSTREAMERROR",
+}
+var LogStream_value = map[string]int32{
+	"LOGSTREAMUNKNOWN": 0,
+	"LOGSTREAMOUTPUT":  1,
+	"LOGSTREAMERROR":   2,
+}
+
+func (x LogStream) String() string {
+	return proto.EnumName(LogStream_name, int32(x))
+}
+func (LogStream) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{0}
+}
+
+type OutputLimitPolicy int32
+
+const (
+	OutputLimitPolicy_OUTPUTLIMITUNKNOWN  OutputLimitPolicy = 0
+	OutputLimitPolicy_OUTPUTLIMITKEEPHEAD OutputLimitPolicy = 1
+	OutputLimitPolicy_OUTPUTLIMITKEEPTAIL OutputLimitPolicy = 2
+	OutputLimitPolicy_OUTPUTLIMITDROP     OutputLimitPolicy = 3
+	OutputLimitPolicy_OUTPUTLIMITKILL     OutputLimitPolicy = 4
+)
+
+var OutputLimitPolicy_name = map[int32]string{
+	0: "OUTPUTLIMITUNKNOWN",
+	1: "OUTPUTLIMITKEEPHEAD",
+	2: "OUTPUTLIMITKEEPTAIL",
+	3: "OUTPUTLIMITDROP",
+	4: "OUTPUTLIMITKILL",
+}
+var OutputLimitPolicy_value = map[string]int32{
+	"OUTPUTLIMITUNKNOWN":  0,
+	"OUTPUTLIMITKEEPHEAD": 1,
+	"OUTPUTLIMITKEEPTAIL": 2,
+	"OUTPUTLIMITDROP":     3,
+	"OUTPUTLIMITKILL":     4,
+}
+
+func (x OutputLimitPolicy) String() string {
+	return proto.EnumName(OutputLimitPolicy_name, int32(x))
+}
+func (OutputLimitPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{1}
+}
+
 type LogType int32
 
 const (
@@ -36,18 +94,26 @@ const (
 	LogType_LOGSPLUNK        LogType = 6
 	LogType_LOGSUMOLOGIC     LogType = 7
 	LogType_LOGINMEMORY      LogType = 8
+	LogType_LOGROTATINGFILE  LogType = 9
+	LogType_LOGSYSLOG        LogType = 10
+	LogType_LOGJOURNALD      LogType = 11
+	LogType_LOGHTTP          LogType = 12
 )
 
 var LogType_name = map[int32]string{
-	0: "LOGUNKNOWN",
-	1: "LOGBUILDLOGGERV2",
-	2: "LOGBUILDLOGGERV3",
-	3: "LOGDEFAULT",
-	4: "LOGFILE",
-	5: "LOGINHERIT",
-	6: "LOGSPLUNK",
-	7: "LOGSUMOLOGIC",
-	8: "LOGINMEMORY",
+	0:  "LOGUNKNOWN",
+	1:  "LOGBUILDLOGGERV2",
+	2:  "LOGBUILDLOGGERV3",
+	3:  "LOGDEFAULT",
+	4:  "LOGFILE",
+	5:  "LOGINHERIT",
+	6:  "LOGSPLUNK",
+	7:  "LOGSUMOLOGIC",
+	8:  "LOGINMEMORY",
+	9:  "LOGROTATINGFILE",
+	10: "LOGSYSLOG",
+	11: "LOGJOURNALD",
+	12: "LOGHTTP",
 }
 var LogType_value = map[string]int32{
 	"LOGUNKNOWN":       0,
@@ -59,13 +125,17 @@ var LogType_value = map[string]int32{
 	"LOGSPLUNK":        6,
 	"LOGSUMOLOGIC":     7,
 	"LOGINMEMORY":      8,
+	"LOGROTATINGFILE":  9,
+	"LOGSYSLOG":        10,
+	"LOGJOURNALD":      11,
+	"LOGHTTP":          12,
 }
 
 func (x LogType) String() string {
 	return proto.EnumName(LogType_name, int32(x))
 }
 func (LogType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{0}
+	return fileDescriptor_jasper_358a25047a98df41, []int{2}
 }
 
 type LogFormat int32
@@ -75,6 +145,7 @@ const (
 	LogFormat_LOGFORMATDEFAULT LogFormat = 1
 	LogFormat_LOGFORMATJSON    LogFormat = 2
 	LogFormat_LOGFORMATPLAIN   LogFormat = 3
+	LogFormat_LOGFORMATINVALID LogFormat = 4
 )
 
 var LogFormat_name = map[int32]string{
@@ -82,19 +153,50 @@ var LogFormat_name = map[int32]string{
 	1: "LOGFORMATDEFAULT",
 	2: "LOGFORMATJSON",
 	3: "LOGFORMATPLAIN",
+	4: "LOGFORMATINVALID",
 }
 var LogFormat_value = map[string]int32{
 	"LOGFORMATUNKNOWN": 0,
 	"LOGFORMATDEFAULT": 1,
 	"LOGFORMATJSON":    2,
 	"LOGFORMATPLAIN":   3,
+	"LOGFORMATINVALID": 4,
 }
 
 func (x LogFormat) String() string {
 	return proto.EnumName(LogFormat_name, int32(x))
 }
 func (LogFormat) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{1}
+	return fileDescriptor_jasper_358a25047a98df41, []int{3}
+}
+
+type LineParserType int32
+
+const (
+	LineParserType_LINEPARSERNONE   LineParserType = 0
+	LineParserType_LINEPARSERJSON   LineParserType = 1
+	LineParserType_LINEPARSERLOGFMT LineParserType = 2
+	LineParserType_LINEPARSERREGEX  LineParserType = 3
+)
+
+var LineParserType_name = map[int32]string{
+	0: "LINEPARSERNONE",
+	1: "LINEPARSERJSON",
+	2: "LINEPARSERLOGFMT",
+	3: "LINEPARSERREGEX",
+}
+var LineParserType_value = map[string]int32{
+	"LINEPARSERNONE":   0,
+	"LINEPARSERJSON":   1,
+	"LINEPARSERLOGFMT": 2,
+	"LINEPARSERREGEX":  3,
+}
+
+func (x LineParserType) String() string {
+	return proto.EnumName(LineParserType_name, int32(x))
+}
+func (LineParserType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{4}
 }
 
 type FilterSpecifications int32
@@ -126,7 +228,7 @@ func (x FilterSpecifications) String() string {
 	return proto.EnumName(FilterSpecifications_name, int32(x))
 }
 func (FilterSpecifications) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{2}
+	return fileDescriptor_jasper_358a25047a98df41, []int{5}
 }
 
 type Signals int32
@@ -164,7 +266,7 @@ func (x Signals) String() string {
 	return proto.EnumName(Signals_name, int32(x))
 }
 func (Signals) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{3}
+	return fileDescriptor_jasper_358a25047a98df41, []int{6}
 }
 
 type ArchiveFormat int32
@@ -174,6 +276,10 @@ const (
 	ArchiveFormat_ARCHIVEAUTO    ArchiveFormat = 1
 	ArchiveFormat_ARCHIVETARGZ   ArchiveFormat = 2
 	ArchiveFormat_ARCHIVEZIP     ArchiveFormat = 3
+	ArchiveFormat_ARCHIVETAR     ArchiveFormat = 4
+	ArchiveFormat_ARCHIVETARBZ2  ArchiveFormat = 5
+	ArchiveFormat_ARCHIVETARXZ   ArchiveFormat = 6
+	ArchiveFormat_ARCHIVETARZST  ArchiveFormat = 7
 )
 
 var ArchiveFormat_name = map[int32]string{
@@ -181,34 +287,78 @@ var ArchiveFormat_name = map[int32]string{
 	1: "ARCHIVEAUTO",
 	2: "ARCHIVETARGZ",
 	3: "ARCHIVEZIP",
+	4: "ARCHIVETAR",
+	5: "ARCHIVETARBZ2",
+	6: "ARCHIVETARXZ",
+	7: "ARCHIVETARZST",
 }
 var ArchiveFormat_value = map[string]int32{
 	"ARCHIVEUNKNOWN": 0,
 	"ARCHIVEAUTO":    1,
 	"ARCHIVETARGZ":   2,
 	"ARCHIVEZIP":     3,
+	"ARCHIVETAR":     4,
+	"ARCHIVETARBZ2":  5,
+	"ARCHIVETARXZ":   6,
+	"ARCHIVETARZST":  7,
 }
 
 func (x ArchiveFormat) String() string {
 	return proto.EnumName(ArchiveFormat_name, int32(x))
 }
 func (ArchiveFormat) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{4}
+	return fileDescriptor_jasper_358a25047a98df41, []int{7}
+}
+
+type DownloadJobState int32
+
+const (
+	DownloadJobState_DOWNLOADJOBUNKNOWN   DownloadJobState = 0
+	DownloadJobState_DOWNLOADJOBPENDING   DownloadJobState = 1
+	DownloadJobState_DOWNLOADJOBRUNNING   DownloadJobState = 2
+	DownloadJobState_DOWNLOADJOBCOMPLETED DownloadJobState = 3
+	DownloadJobState_DOWNLOADJOBFAILED    DownloadJobState = 4
+	DownloadJobState_DOWNLOADJOBCANCELED  DownloadJobState = 5
+)
+
+var DownloadJobState_name = map[int32]string{
+	0: "DOWNLOADJOBUNKNOWN",
+	1: "DOWNLOADJOBPENDING",
+	2: "DOWNLOADJOBRUNNING",
+	3: "DOWNLOADJOBCOMPLETED",
+	4: "DOWNLOADJOBFAILED",
+	5: "DOWNLOADJOBCANCELED",
+}
+var DownloadJobState_value = map[string]int32{
+	"DOWNLOADJOBUNKNOWN":   0,
+	"DOWNLOADJOBPENDING":   1,
+	"DOWNLOADJOBRUNNING":   2,
+	"DOWNLOADJOBCOMPLETED": 3,
+	"DOWNLOADJOBFAILED":    4,
+	"DOWNLOADJOBCANCELED":  5,
+}
+
+func (x DownloadJobState) String() string {
+	return proto.EnumName(DownloadJobState_name, int32(x))
+}
+func (DownloadJobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{8}
 }
 
 type Logger struct {
-	LogType              LogType     `protobuf:"varint,1,opt,name=log_type,json=logType,proto3,enum=jasper.LogType" json:"log_type,omitempty"`
-	LogOptions           *LogOptions `protobuf:"bytes,2,opt,name=log_options,json=logOptions,proto3" json:"log_options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	LogType              LogType             `protobuf:"varint,1,opt,name=log_type,json=logType,proto3,enum=jasper.LogType" json:"log_type,omitempty"`
+	LogOptions           *LogOptions         `protobuf:"bytes,2,opt,name=log_options,json=logOptions,proto3" json:"log_options,omitempty"`
+	Streams              []*LogStreamOptions `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Logger) Reset()         { *m = Logger{} }
 func (m *Logger) String() string { return proto.CompactTextString(m) }
 func (*Logger) ProtoMessage()    {}
 func (*Logger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{0}
+	return fileDescriptor_jasper_358a25047a98df41, []int{0}
 }
 func (m *Logger) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Logger.Unmarshal(m, b)
@@ -242,22 +392,86 @@ func (m *Logger) GetLogOptions() *LogOptions {
 	return nil
 }
 
+func (m *Logger) GetStreams() []*LogStreamOptions {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+type LogStreamOptions struct {
+	Stream               LogStream `protobuf:"varint,1,opt,name=stream,proto3,enum=jasper.LogStream" json:"stream,omitempty"`
+	Prefix               string    `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Level                int32     `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LogStreamOptions) Reset()         { *m = LogStreamOptions{} }
+func (m *LogStreamOptions) String() string { return proto.CompactTextString(m) }
+func (*LogStreamOptions) ProtoMessage()    {}
+func (*LogStreamOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{1}
+}
+func (m *LogStreamOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogStreamOptions.Unmarshal(m, b)
+}
+func (m *LogStreamOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogStreamOptions.Marshal(b, m, deterministic)
+}
+func (dst *LogStreamOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogStreamOptions.Merge(dst, src)
+}
+func (m *LogStreamOptions) XXX_Size() int {
+	return xxx_messageInfo_LogStreamOptions.Size(m)
+}
+func (m *LogStreamOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogStreamOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogStreamOptions proto.InternalMessageInfo
+
+func (m *LogStreamOptions) GetStream() LogStream {
+	if m != nil {
+		return m.Stream
+	}
+	return LogStream_LOGSTREAMUNKNOWN
+}
+
+func (m *LogStreamOptions) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *LogStreamOptions) GetLevel() int32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
 type OutputOptions struct {
-	Loggers               []*Logger `protobuf:"bytes,1,rep,name=loggers,proto3" json:"loggers,omitempty"`
-	SuppressOutput        bool      `protobuf:"varint,2,opt,name=suppress_output,json=suppressOutput,proto3" json:"suppress_output,omitempty"`
-	SuppressError         bool      `protobuf:"varint,3,opt,name=suppress_error,json=suppressError,proto3" json:"suppress_error,omitempty"`
-	RedirectOutputToError bool      `protobuf:"varint,4,opt,name=redirect_output_to_error,json=redirectOutputToError,proto3" json:"redirect_output_to_error,omitempty"`
-	RedirectErrorToOutput bool      `protobuf:"varint,5,opt,name=redirect_error_to_output,json=redirectErrorToOutput,proto3" json:"redirect_error_to_output,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}  `json:"-"`
-	XXX_unrecognized      []byte    `json:"-"`
-	XXX_sizecache         int32     `json:"-"`
+	Loggers               []*Logger           `protobuf:"bytes,1,rep,name=loggers,proto3" json:"loggers,omitempty"`
+	SuppressOutput        bool                `protobuf:"varint,2,opt,name=suppress_output,json=suppressOutput,proto3" json:"suppress_output,omitempty"`
+	SuppressError         bool                `protobuf:"varint,3,opt,name=suppress_error,json=suppressError,proto3" json:"suppress_error,omitempty"`
+	RedirectOutputToError bool                `protobuf:"varint,4,opt,name=redirect_output_to_error,json=redirectOutputToError,proto3" json:"redirect_output_to_error,omitempty"`
+	RedirectErrorToOutput bool                `protobuf:"varint,5,opt,name=redirect_error_to_output,json=redirectErrorToOutput,proto3" json:"redirect_error_to_output,omitempty"`
+	Redaction             *RedactionOptions   `protobuf:"bytes,6,opt,name=redaction,proto3" json:"redaction,omitempty"`
+	LineBuffer            *LineBufferOptions  `protobuf:"bytes,7,opt,name=line_buffer,json=lineBuffer,proto3" json:"line_buffer,omitempty"`
+	Limits                *OutputLimitOptions `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}            `json:"-"`
+	XXX_unrecognized      []byte              `json:"-"`
+	XXX_sizecache         int32               `json:"-"`
 }
 
 func (m *OutputOptions) Reset()         { *m = OutputOptions{} }
 func (m *OutputOptions) String() string { return proto.CompactTextString(m) }
 func (*OutputOptions) ProtoMessage()    {}
 func (*OutputOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{1}
+	return fileDescriptor_jasper_358a25047a98df41, []int{2}
 }
 func (m *OutputOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OutputOptions.Unmarshal(m, b)
@@ -312,6 +526,181 @@ func (m *OutputOptions) GetRedirectErrorToOutput() bool {
 	return false
 }
 
+func (m *OutputOptions) GetRedaction() *RedactionOptions {
+	if m != nil {
+		return m.Redaction
+	}
+	return nil
+}
+
+func (m *OutputOptions) GetLineBuffer() *LineBufferOptions {
+	if m != nil {
+		return m.LineBuffer
+	}
+	return nil
+}
+
+func (m *OutputOptions) GetLimits() *OutputLimitOptions {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type OutputLimitOptions struct {
+	MaxBytes             int64             `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	Policy               OutputLimitPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=jasper.OutputLimitPolicy" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OutputLimitOptions) Reset()         { *m = OutputLimitOptions{} }
+func (m *OutputLimitOptions) String() string { return proto.CompactTextString(m) }
+func (*OutputLimitOptions) ProtoMessage()    {}
+func (*OutputLimitOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{3}
+}
+func (m *OutputLimitOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutputLimitOptions.Unmarshal(m, b)
+}
+func (m *OutputLimitOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutputLimitOptions.Marshal(b, m, deterministic)
+}
+func (dst *OutputLimitOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputLimitOptions.Merge(dst, src)
+}
+func (m *OutputLimitOptions) XXX_Size() int {
+	return xxx_messageInfo_OutputLimitOptions.Size(m)
+}
+func (m *OutputLimitOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputLimitOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputLimitOptions proto.InternalMessageInfo
+
+func (m *OutputLimitOptions) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *OutputLimitOptions) GetPolicy() OutputLimitPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return OutputLimitPolicy_OUTPUTLIMITUNKNOWN
+}
+
+type LineBufferOptions struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	TimestampFormat      string   `protobuf:"bytes,3,opt,name=timestamp_format,json=timestampFormat,proto3" json:"timestamp_format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LineBufferOptions) Reset()         { *m = LineBufferOptions{} }
+func (m *LineBufferOptions) String() string { return proto.CompactTextString(m) }
+func (*LineBufferOptions) ProtoMessage()    {}
+func (*LineBufferOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{4}
+}
+func (m *LineBufferOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LineBufferOptions.Unmarshal(m, b)
+}
+func (m *LineBufferOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LineBufferOptions.Marshal(b, m, deterministic)
+}
+func (dst *LineBufferOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineBufferOptions.Merge(dst, src)
+}
+func (m *LineBufferOptions) XXX_Size() int {
+	return xxx_messageInfo_LineBufferOptions.Size(m)
+}
+func (m *LineBufferOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineBufferOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineBufferOptions proto.InternalMessageInfo
+
+func (m *LineBufferOptions) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *LineBufferOptions) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *LineBufferOptions) GetTimestampFormat() string {
+	if m != nil {
+		return m.TimestampFormat
+	}
+	return ""
+}
+
+type RedactionOptions struct {
+	Literals             []string `protobuf:"bytes,1,rep,name=literals,proto3" json:"literals,omitempty"`
+	Patterns             []string `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	EnvVars              []string `protobuf:"bytes,3,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedactionOptions) Reset()         { *m = RedactionOptions{} }
+func (m *RedactionOptions) String() string { return proto.CompactTextString(m) }
+func (*RedactionOptions) ProtoMessage()    {}
+func (*RedactionOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{5}
+}
+func (m *RedactionOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedactionOptions.Unmarshal(m, b)
+}
+func (m *RedactionOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedactionOptions.Marshal(b, m, deterministic)
+}
+func (dst *RedactionOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedactionOptions.Merge(dst, src)
+}
+func (m *RedactionOptions) XXX_Size() int {
+	return xxx_messageInfo_RedactionOptions.Size(m)
+}
+func (m *RedactionOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedactionOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedactionOptions proto.InternalMessageInfo
+
+func (m *RedactionOptions) GetLiterals() []string {
+	if m != nil {
+		return m.Literals
+	}
+	return nil
+}
+
+func (m *RedactionOptions) GetPatterns() []string {
+	if m != nil {
+		return m.Patterns
+	}
+	return nil
+}
+
+func (m *RedactionOptions) GetEnvVars() []string {
+	if m != nil {
+		return m.EnvVars
+	}
+	return nil
+}
+
 type LogOptions struct {
 	BufferOptions        *BufferOptions      `protobuf:"bytes,1,opt,name=buffer_options,json=bufferOptions,proto3" json:"buffer_options,omitempty"`
 	BuildloggerOptions   *BuildloggerOptions `protobuf:"bytes,2,opt,name=buildlogger_options,json=buildloggerOptions,proto3" json:"buildlogger_options,omitempty"`
@@ -321,6 +710,11 @@ type LogOptions struct {
 	InMemoryCap          int64               `protobuf:"varint,6,opt,name=in_memory_cap,json=inMemoryCap,proto3" json:"in_memory_cap,omitempty"`
 	SplunkOptions        *SplunkOptions      `protobuf:"bytes,7,opt,name=splunk_options,json=splunkOptions,proto3" json:"splunk_options,omitempty"`
 	SumoEndpoint         string              `protobuf:"bytes,8,opt,name=sumo_endpoint,json=sumoEndpoint,proto3" json:"sumo_endpoint,omitempty"`
+	RotationOptions      *RotationOptions    `protobuf:"bytes,9,opt,name=rotation_options,json=rotationOptions,proto3" json:"rotation_options,omitempty"`
+	LineParser           *LineParserOptions  `protobuf:"bytes,10,opt,name=line_parser,json=lineParser,proto3" json:"line_parser,omitempty"`
+	InMemoryMaxBytes     int64               `protobuf:"varint,11,opt,name=in_memory_max_bytes,json=inMemoryMaxBytes,proto3" json:"in_memory_max_bytes,omitempty"`
+	SyslogOptions        *SyslogOptions      `protobuf:"bytes,12,opt,name=syslog_options,json=syslogOptions,proto3" json:"syslog_options,omitempty"`
+	HttpOptions          *HTTPLogOptions     `protobuf:"bytes,13,opt,name=http_options,json=httpOptions,proto3" json:"http_options,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -330,7 +724,7 @@ func (m *LogOptions) Reset()         { *m = LogOptions{} }
 func (m *LogOptions) String() string { return proto.CompactTextString(m) }
 func (*LogOptions) ProtoMessage()    {}
 func (*LogOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{2}
+	return fileDescriptor_jasper_358a25047a98df41, []int{6}
 }
 func (m *LogOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogOptions.Unmarshal(m, b)
@@ -406,128 +800,435 @@ func (m *LogOptions) GetSumoEndpoint() string {
 	return ""
 }
 
-type BufferOptions struct {
-	Buffered             bool     `protobuf:"varint,1,opt,name=buffered,proto3" json:"buffered,omitempty"`
-	Duration             int64    `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
-	MaxSize              int64    `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *LogOptions) GetRotationOptions() *RotationOptions {
+	if m != nil {
+		return m.RotationOptions
+	}
+	return nil
 }
 
-func (m *BufferOptions) Reset()         { *m = BufferOptions{} }
-func (m *BufferOptions) String() string { return proto.CompactTextString(m) }
-func (*BufferOptions) ProtoMessage()    {}
-func (*BufferOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{3}
-}
-func (m *BufferOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BufferOptions.Unmarshal(m, b)
-}
-func (m *BufferOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BufferOptions.Marshal(b, m, deterministic)
-}
-func (dst *BufferOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BufferOptions.Merge(dst, src)
-}
-func (m *BufferOptions) XXX_Size() int {
-	return xxx_messageInfo_BufferOptions.Size(m)
-}
-func (m *BufferOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_BufferOptions.DiscardUnknown(m)
+func (m *LogOptions) GetLineParser() *LineParserOptions {
+	if m != nil {
+		return m.LineParser
+	}
+	return nil
 }
 
-var xxx_messageInfo_BufferOptions proto.InternalMessageInfo
-
-func (m *BufferOptions) GetBuffered() bool {
+func (m *LogOptions) GetInMemoryMaxBytes() int64 {
 	if m != nil {
-		return m.Buffered
+		return m.InMemoryMaxBytes
 	}
-	return false
+	return 0
 }
 
-func (m *BufferOptions) GetDuration() int64 {
+func (m *LogOptions) GetSyslogOptions() *SyslogOptions {
 	if m != nil {
-		return m.Duration
+		return m.SyslogOptions
 	}
-	return 0
+	return nil
 }
 
-func (m *BufferOptions) GetMaxSize() int64 {
+func (m *LogOptions) GetHttpOptions() *HTTPLogOptions {
 	if m != nil {
-		return m.MaxSize
+		return m.HttpOptions
 	}
-	return 0
+	return nil
 }
 
-type BuildloggerOptions struct {
-	CreateTest           bool     `protobuf:"varint,1,opt,name=create_test,json=createTest,proto3" json:"create_test,omitempty"`
-	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Number               int64    `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
-	Phase                string   `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
-	Builder              string   `protobuf:"bytes,5,opt,name=builder,proto3" json:"builder,omitempty"`
-	Test                 string   `protobuf:"bytes,6,opt,name=test,proto3" json:"test,omitempty"`
-	Command              string   `protobuf:"bytes,7,opt,name=command,proto3" json:"command,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type HTTPLogOptions struct {
+	Url                  string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Headers              map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Username             string            `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string            `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	BatchKey             string            `protobuf:"bytes,5,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	Fields               map[string]string `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxRetries           int64             `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	RetryBackoff         int64             `protobuf:"varint,8,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	Timeout              int64             `protobuf:"varint,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *BuildloggerOptions) Reset()         { *m = BuildloggerOptions{} }
-func (m *BuildloggerOptions) String() string { return proto.CompactTextString(m) }
-func (*BuildloggerOptions) ProtoMessage()    {}
-func (*BuildloggerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{4}
+func (m *HTTPLogOptions) Reset()         { *m = HTTPLogOptions{} }
+func (m *HTTPLogOptions) String() string { return proto.CompactTextString(m) }
+func (*HTTPLogOptions) ProtoMessage()    {}
+func (*HTTPLogOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{7}
 }
-func (m *BuildloggerOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuildloggerOptions.Unmarshal(m, b)
+func (m *HTTPLogOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTTPLogOptions.Unmarshal(m, b)
 }
-func (m *BuildloggerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuildloggerOptions.Marshal(b, m, deterministic)
+func (m *HTTPLogOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTTPLogOptions.Marshal(b, m, deterministic)
 }
-func (dst *BuildloggerOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildloggerOptions.Merge(dst, src)
+func (dst *HTTPLogOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPLogOptions.Merge(dst, src)
 }
-func (m *BuildloggerOptions) XXX_Size() int {
-	return xxx_messageInfo_BuildloggerOptions.Size(m)
+func (m *HTTPLogOptions) XXX_Size() int {
+	return xxx_messageInfo_HTTPLogOptions.Size(m)
 }
-func (m *BuildloggerOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildloggerOptions.DiscardUnknown(m)
+func (m *HTTPLogOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPLogOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildloggerOptions proto.InternalMessageInfo
-
-func (m *BuildloggerOptions) GetCreateTest() bool {
-	if m != nil {
-		return m.CreateTest
-	}
-	return false
-}
+var xxx_messageInfo_HTTPLogOptions proto.InternalMessageInfo
 
-func (m *BuildloggerOptions) GetUrl() string {
+func (m *HTTPLogOptions) GetUrl() string {
 	if m != nil {
 		return m.Url
 	}
 	return ""
 }
 
-func (m *BuildloggerOptions) GetNumber() int64 {
+func (m *HTTPLogOptions) GetHeaders() map[string]string {
 	if m != nil {
-		return m.Number
+		return m.Headers
 	}
-	return 0
+	return nil
 }
 
-func (m *BuildloggerOptions) GetPhase() string {
+func (m *HTTPLogOptions) GetUsername() string {
 	if m != nil {
-		return m.Phase
+		return m.Username
 	}
 	return ""
 }
 
-func (m *BuildloggerOptions) GetBuilder() string {
+func (m *HTTPLogOptions) GetPassword() string {
 	if m != nil {
-		return m.Builder
+		return m.Password
+	}
+	return ""
+}
+
+func (m *HTTPLogOptions) GetBatchKey() string {
+	if m != nil {
+		return m.BatchKey
+	}
+	return ""
+}
+
+func (m *HTTPLogOptions) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *HTTPLogOptions) GetMaxRetries() int64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *HTTPLogOptions) GetRetryBackoff() int64 {
+	if m != nil {
+		return m.RetryBackoff
+	}
+	return 0
+}
+
+func (m *HTTPLogOptions) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type SyslogOptions struct {
+	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Facility             string   `protobuf:"bytes,3,opt,name=facility,proto3" json:"facility,omitempty"`
+	AppName              string   `protobuf:"bytes,4,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyslogOptions) Reset()         { *m = SyslogOptions{} }
+func (m *SyslogOptions) String() string { return proto.CompactTextString(m) }
+func (*SyslogOptions) ProtoMessage()    {}
+func (*SyslogOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{8}
+}
+func (m *SyslogOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyslogOptions.Unmarshal(m, b)
+}
+func (m *SyslogOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyslogOptions.Marshal(b, m, deterministic)
+}
+func (dst *SyslogOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyslogOptions.Merge(dst, src)
+}
+func (m *SyslogOptions) XXX_Size() int {
+	return xxx_messageInfo_SyslogOptions.Size(m)
+}
+func (m *SyslogOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyslogOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyslogOptions proto.InternalMessageInfo
+
+func (m *SyslogOptions) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *SyslogOptions) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SyslogOptions) GetFacility() string {
+	if m != nil {
+		return m.Facility
+	}
+	return ""
+}
+
+func (m *SyslogOptions) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
+type LineParserOptions struct {
+	Type                 LineParserType `protobuf:"varint,1,opt,name=type,proto3,enum=jasper.LineParserType" json:"type,omitempty"`
+	Pattern              string         `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LineParserOptions) Reset()         { *m = LineParserOptions{} }
+func (m *LineParserOptions) String() string { return proto.CompactTextString(m) }
+func (*LineParserOptions) ProtoMessage()    {}
+func (*LineParserOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{9}
+}
+func (m *LineParserOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LineParserOptions.Unmarshal(m, b)
+}
+func (m *LineParserOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LineParserOptions.Marshal(b, m, deterministic)
+}
+func (dst *LineParserOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineParserOptions.Merge(dst, src)
+}
+func (m *LineParserOptions) XXX_Size() int {
+	return xxx_messageInfo_LineParserOptions.Size(m)
+}
+func (m *LineParserOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineParserOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineParserOptions proto.InternalMessageInfo
+
+func (m *LineParserOptions) GetType() LineParserType {
+	if m != nil {
+		return m.Type
+	}
+	return LineParserType_LINEPARSERNONE
+}
+
+func (m *LineParserOptions) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+type BufferOptions struct {
+	Buffered             bool     `protobuf:"varint,1,opt,name=buffered,proto3" json:"buffered,omitempty"`
+	Duration             int64    `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	MaxSize              int64    `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BufferOptions) Reset()         { *m = BufferOptions{} }
+func (m *BufferOptions) String() string { return proto.CompactTextString(m) }
+func (*BufferOptions) ProtoMessage()    {}
+func (*BufferOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{10}
+}
+func (m *BufferOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BufferOptions.Unmarshal(m, b)
+}
+func (m *BufferOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BufferOptions.Marshal(b, m, deterministic)
+}
+func (dst *BufferOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferOptions.Merge(dst, src)
+}
+func (m *BufferOptions) XXX_Size() int {
+	return xxx_messageInfo_BufferOptions.Size(m)
+}
+func (m *BufferOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BufferOptions proto.InternalMessageInfo
+
+func (m *BufferOptions) GetBuffered() bool {
+	if m != nil {
+		return m.Buffered
+	}
+	return false
+}
+
+func (m *BufferOptions) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *BufferOptions) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+type RotationOptions struct {
+	MaxSize              int64    `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	Interval             int64    `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	MaxFiles             int64    `protobuf:"varint,3,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	Compress             bool     `protobuf:"varint,4,opt,name=compress,proto3" json:"compress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotationOptions) Reset()         { *m = RotationOptions{} }
+func (m *RotationOptions) String() string { return proto.CompactTextString(m) }
+func (*RotationOptions) ProtoMessage()    {}
+func (*RotationOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{11}
+}
+func (m *RotationOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotationOptions.Unmarshal(m, b)
+}
+func (m *RotationOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotationOptions.Marshal(b, m, deterministic)
+}
+func (dst *RotationOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotationOptions.Merge(dst, src)
+}
+func (m *RotationOptions) XXX_Size() int {
+	return xxx_messageInfo_RotationOptions.Size(m)
+}
+func (m *RotationOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotationOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotationOptions proto.InternalMessageInfo
+
+func (m *RotationOptions) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *RotationOptions) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RotationOptions) GetMaxFiles() int64 {
+	if m != nil {
+		return m.MaxFiles
+	}
+	return 0
+}
+
+func (m *RotationOptions) GetCompress() bool {
+	if m != nil {
+		return m.Compress
+	}
+	return false
+}
+
+type BuildloggerOptions struct {
+	CreateTest           bool     `protobuf:"varint,1,opt,name=create_test,json=createTest,proto3" json:"create_test,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Number               int64    `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	Phase                string   `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Builder              string   `protobuf:"bytes,5,opt,name=builder,proto3" json:"builder,omitempty"`
+	Test                 string   `protobuf:"bytes,6,opt,name=test,proto3" json:"test,omitempty"`
+	Command              string   `protobuf:"bytes,7,opt,name=command,proto3" json:"command,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildloggerOptions) Reset()         { *m = BuildloggerOptions{} }
+func (m *BuildloggerOptions) String() string { return proto.CompactTextString(m) }
+func (*BuildloggerOptions) ProtoMessage()    {}
+func (*BuildloggerOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{12}
+}
+func (m *BuildloggerOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildloggerOptions.Unmarshal(m, b)
+}
+func (m *BuildloggerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildloggerOptions.Marshal(b, m, deterministic)
+}
+func (dst *BuildloggerOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildloggerOptions.Merge(dst, src)
+}
+func (m *BuildloggerOptions) XXX_Size() int {
+	return xxx_messageInfo_BuildloggerOptions.Size(m)
+}
+func (m *BuildloggerOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildloggerOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildloggerOptions proto.InternalMessageInfo
+
+func (m *BuildloggerOptions) GetCreateTest() bool {
+	if m != nil {
+		return m.CreateTest
+	}
+	return false
+}
+
+func (m *BuildloggerOptions) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *BuildloggerOptions) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *BuildloggerOptions) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *BuildloggerOptions) GetBuilder() string {
+	if m != nil {
+		return m.Builder
 	}
 	return ""
 }
@@ -559,7 +1260,7 @@ func (m *SplunkOptions) Reset()         { *m = SplunkOptions{} }
 func (m *SplunkOptions) String() string { return proto.CompactTextString(m) }
 func (*SplunkOptions) ProtoMessage()    {}
 func (*SplunkOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{5}
+	return fileDescriptor_jasper_358a25047a98df41, []int{13}
 }
 func (m *SplunkOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SplunkOptions.Unmarshal(m, b)
@@ -611,6 +1312,8 @@ type CreateOptions struct {
 	OnFailure            []*CreateOptions  `protobuf:"bytes,8,rep,name=on_failure,json=onFailure,proto3" json:"on_failure,omitempty"`
 	OnTimeout            []*CreateOptions  `protobuf:"bytes,9,rep,name=on_timeout,json=onTimeout,proto3" json:"on_timeout,omitempty"`
 	Output               *OutputOptions    `protobuf:"bytes,10,opt,name=output,proto3" json:"output,omitempty"`
+	Hostname             string            `protobuf:"bytes,11,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Timeout              int64             `protobuf:"varint,12,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -620,7 +1323,7 @@ func (m *CreateOptions) Reset()         { *m = CreateOptions{} }
 func (m *CreateOptions) String() string { return proto.CompactTextString(m) }
 func (*CreateOptions) ProtoMessage()    {}
 func (*CreateOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{6}
+	return fileDescriptor_jasper_358a25047a98df41, []int{14}
 }
 func (m *CreateOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateOptions.Unmarshal(m, b)
@@ -710,6 +1413,20 @@ func (m *CreateOptions) GetOutput() *OutputOptions {
 	return nil
 }
 
+func (m *CreateOptions) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *CreateOptions) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 type ProcessInfo struct {
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Pid                  int64          `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
@@ -720,6 +1437,8 @@ type ProcessInfo struct {
 	Timedout             bool           `protobuf:"varint,7,opt,name=timedout,proto3" json:"timedout,omitempty"`
 	Options              *CreateOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
 	ExitCode             int32          `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	OutputTruncated      bool           `protobuf:"varint,10,opt,name=output_truncated,json=outputTruncated,proto3" json:"output_truncated,omitempty"`
+	ErrorTruncated       bool           `protobuf:"varint,11,opt,name=error_truncated,json=errorTruncated,proto3" json:"error_truncated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -729,7 +1448,7 @@ func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
 func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
 func (*ProcessInfo) ProtoMessage()    {}
 func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{7}
+	return fileDescriptor_jasper_358a25047a98df41, []int{15}
 }
 func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessInfo.Unmarshal(m, b)
@@ -812,19 +1531,33 @@ func (m *ProcessInfo) GetExitCode() int32 {
 	return 0
 }
 
-type StatusResponse struct {
-	HostId               string   `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
+func (m *ProcessInfo) GetOutputTruncated() bool {
+	if m != nil {
+		return m.OutputTruncated
+	}
+	return false
+}
+
+func (m *ProcessInfo) GetErrorTruncated() bool {
+	if m != nil {
+		return m.ErrorTruncated
+	}
+	return false
+}
+
+type StatusResponse struct {
+	HostId               string   `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
 func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{8}
+	return fileDescriptor_jasper_358a25047a98df41, []int{16}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusResponse.Unmarshal(m, b)
@@ -869,7 +1602,7 @@ func (m *Filter) Reset()         { *m = Filter{} }
 func (m *Filter) String() string { return proto.CompactTextString(m) }
 func (*Filter) ProtoMessage()    {}
 func (*Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{9}
+	return fileDescriptor_jasper_358a25047a98df41, []int{17}
 }
 func (m *Filter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Filter.Unmarshal(m, b)
@@ -899,6 +1632,8 @@ func (m *Filter) GetName() FilterSpecifications {
 type SignalProcess struct {
 	ProcessID            *JasperProcessID `protobuf:"bytes,1,opt,name=ProcessID,proto3" json:"ProcessID,omitempty"`
 	Signal               Signals          `protobuf:"varint,2,opt,name=signal,proto3,enum=jasper.Signals" json:"signal,omitempty"`
+	SignalNumber         int32            `protobuf:"varint,3,opt,name=signal_number,json=signalNumber,proto3" json:"signal_number,omitempty"`
+	SignalName           string           `protobuf:"bytes,4,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -908,7 +1643,7 @@ func (m *SignalProcess) Reset()         { *m = SignalProcess{} }
 func (m *SignalProcess) String() string { return proto.CompactTextString(m) }
 func (*SignalProcess) ProtoMessage()    {}
 func (*SignalProcess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{10}
+	return fileDescriptor_jasper_358a25047a98df41, []int{18}
 }
 func (m *SignalProcess) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalProcess.Unmarshal(m, b)
@@ -942,6 +1677,20 @@ func (m *SignalProcess) GetSignal() Signals {
 	return Signals_UNKNOWN
 }
 
+func (m *SignalProcess) GetSignalNumber() int32 {
+	if m != nil {
+		return m.SignalNumber
+	}
+	return 0
+}
+
+func (m *SignalProcess) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
 type TagName struct {
 	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -953,7 +1702,7 @@ func (m *TagName) Reset()         { *m = TagName{} }
 func (m *TagName) String() string { return proto.CompactTextString(m) }
 func (*TagName) ProtoMessage()    {}
 func (*TagName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{11}
+	return fileDescriptor_jasper_358a25047a98df41, []int{19}
 }
 func (m *TagName) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TagName.Unmarshal(m, b)
@@ -992,7 +1741,7 @@ func (m *ProcessTags) Reset()         { *m = ProcessTags{} }
 func (m *ProcessTags) String() string { return proto.CompactTextString(m) }
 func (*ProcessTags) ProtoMessage()    {}
 func (*ProcessTags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{12}
+	return fileDescriptor_jasper_358a25047a98df41, []int{20}
 }
 func (m *ProcessTags) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessTags.Unmarshal(m, b)
@@ -1037,7 +1786,7 @@ func (m *JasperProcessID) Reset()         { *m = JasperProcessID{} }
 func (m *JasperProcessID) String() string { return proto.CompactTextString(m) }
 func (*JasperProcessID) ProtoMessage()    {}
 func (*JasperProcessID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{13}
+	return fileDescriptor_jasper_358a25047a98df41, []int{21}
 }
 func (m *JasperProcessID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JasperProcessID.Unmarshal(m, b)
@@ -1077,7 +1826,7 @@ func (m *OperationOutcome) Reset()         { *m = OperationOutcome{} }
 func (m *OperationOutcome) String() string { return proto.CompactTextString(m) }
 func (*OperationOutcome) ProtoMessage()    {}
 func (*OperationOutcome) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{14}
+	return fileDescriptor_jasper_358a25047a98df41, []int{22}
 }
 func (m *OperationOutcome) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OperationOutcome.Unmarshal(m, b)
@@ -1132,7 +1881,7 @@ func (m *BuildOptions) Reset()         { *m = BuildOptions{} }
 func (m *BuildOptions) String() string { return proto.CompactTextString(m) }
 func (*BuildOptions) ProtoMessage()    {}
 func (*BuildOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{15}
+	return fileDescriptor_jasper_358a25047a98df41, []int{23}
 }
 func (m *BuildOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BuildOptions.Unmarshal(m, b)
@@ -1181,19 +1930,22 @@ func (m *BuildOptions) GetDebug() bool {
 }
 
 type MongoDBDownloadOptions struct {
-	BuildOptions         *BuildOptions `protobuf:"bytes,1,opt,name=build_options,json=buildOptions,proto3" json:"build_options,omitempty"`
-	Path                 string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Releases             []string      `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	BuildOptions         *BuildOptions    `protobuf:"bytes,1,opt,name=build_options,json=buildOptions,proto3" json:"build_options,omitempty"`
+	Path                 string           `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Releases             []string         `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases,omitempty"`
+	Sources              *DownloadSources `protobuf:"bytes,4,opt,name=sources,proto3" json:"sources,omitempty"`
+	Workers              int64            `protobuf:"varint,5,opt,name=workers,proto3" json:"workers,omitempty"`
+	MaxBytesPerSecond    int64            `protobuf:"varint,6,opt,name=max_bytes_per_second,json=maxBytesPerSecond,proto3" json:"max_bytes_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *MongoDBDownloadOptions) Reset()         { *m = MongoDBDownloadOptions{} }
 func (m *MongoDBDownloadOptions) String() string { return proto.CompactTextString(m) }
 func (*MongoDBDownloadOptions) ProtoMessage()    {}
 func (*MongoDBDownloadOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{16}
+	return fileDescriptor_jasper_358a25047a98df41, []int{24}
 }
 func (m *MongoDBDownloadOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MongoDBDownloadOptions.Unmarshal(m, b)
@@ -1234,6 +1986,197 @@ func (m *MongoDBDownloadOptions) GetReleases() []string {
 	return nil
 }
 
+func (m *MongoDBDownloadOptions) GetSources() *DownloadSources {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *MongoDBDownloadOptions) GetWorkers() int64 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *MongoDBDownloadOptions) GetMaxBytesPerSecond() int64 {
+	if m != nil {
+		return m.MaxBytesPerSecond
+	}
+	return 0
+}
+
+type MongoDBRelease struct {
+	Spec                 string   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Sha256               string   `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Path                 string   `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	BinPath              string   `protobuf:"bytes,6,opt,name=bin_path,json=binPath,proto3" json:"bin_path,omitempty"`
+	Present              bool     `protobuf:"varint,7,opt,name=present,proto3" json:"present,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MongoDBRelease) Reset()         { *m = MongoDBRelease{} }
+func (m *MongoDBRelease) String() string { return proto.CompactTextString(m) }
+func (*MongoDBRelease) ProtoMessage()    {}
+func (*MongoDBRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{25}
+}
+func (m *MongoDBRelease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MongoDBRelease.Unmarshal(m, b)
+}
+func (m *MongoDBRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MongoDBRelease.Marshal(b, m, deterministic)
+}
+func (dst *MongoDBRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MongoDBRelease.Merge(dst, src)
+}
+func (m *MongoDBRelease) XXX_Size() int {
+	return xxx_messageInfo_MongoDBRelease.Size(m)
+}
+func (m *MongoDBRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MongoDBRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MongoDBRelease proto.InternalMessageInfo
+
+func (m *MongoDBRelease) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *MongoDBRelease) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *MongoDBRelease) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *MongoDBRelease) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *MongoDBRelease) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *MongoDBRelease) GetBinPath() string {
+	if m != nil {
+		return m.BinPath
+	}
+	return ""
+}
+
+func (m *MongoDBRelease) GetPresent() bool {
+	if m != nil {
+		return m.Present
+	}
+	return false
+}
+
+type MongoDBReleases struct {
+	Releases             []*MongoDBRelease `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MongoDBReleases) Reset()         { *m = MongoDBReleases{} }
+func (m *MongoDBReleases) String() string { return proto.CompactTextString(m) }
+func (*MongoDBReleases) ProtoMessage()    {}
+func (*MongoDBReleases) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{26}
+}
+func (m *MongoDBReleases) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MongoDBReleases.Unmarshal(m, b)
+}
+func (m *MongoDBReleases) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MongoDBReleases.Marshal(b, m, deterministic)
+}
+func (dst *MongoDBReleases) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MongoDBReleases.Merge(dst, src)
+}
+func (m *MongoDBReleases) XXX_Size() int {
+	return xxx_messageInfo_MongoDBReleases.Size(m)
+}
+func (m *MongoDBReleases) XXX_DiscardUnknown() {
+	xxx_messageInfo_MongoDBReleases.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MongoDBReleases proto.InternalMessageInfo
+
+func (m *MongoDBReleases) GetReleases() []*MongoDBRelease {
+	if m != nil {
+		return m.Releases
+	}
+	return nil
+}
+
+type DownloadSources struct {
+	Rewrites             map[string]string `protobuf:"bytes,1,rep,name=rewrites,proto3" json:"rewrites,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Mirrors              []string          `protobuf:"bytes,2,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DownloadSources) Reset()         { *m = DownloadSources{} }
+func (m *DownloadSources) String() string { return proto.CompactTextString(m) }
+func (*DownloadSources) ProtoMessage()    {}
+func (*DownloadSources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{27}
+}
+func (m *DownloadSources) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadSources.Unmarshal(m, b)
+}
+func (m *DownloadSources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadSources.Marshal(b, m, deterministic)
+}
+func (dst *DownloadSources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadSources.Merge(dst, src)
+}
+func (m *DownloadSources) XXX_Size() int {
+	return xxx_messageInfo_DownloadSources.Size(m)
+}
+func (m *DownloadSources) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadSources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadSources proto.InternalMessageInfo
+
+func (m *DownloadSources) GetRewrites() map[string]string {
+	if m != nil {
+		return m.Rewrites
+	}
+	return nil
+}
+
+func (m *DownloadSources) GetMirrors() []string {
+	if m != nil {
+		return m.Mirrors
+	}
+	return nil
+}
+
 type CacheOptions struct {
 	Disabled             bool     `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	PruneDelay           int64    `protobuf:"varint,2,opt,name=prune_delay,json=pruneDelay,proto3" json:"prune_delay,omitempty"`
@@ -1247,7 +2190,7 @@ func (m *CacheOptions) Reset()         { *m = CacheOptions{} }
 func (m *CacheOptions) String() string { return proto.CompactTextString(m) }
 func (*CacheOptions) ProtoMessage()    {}
 func (*CacheOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{17}
+	return fileDescriptor_jasper_358a25047a98df41, []int{28}
 }
 func (m *CacheOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CacheOptions.Unmarshal(m, b)
@@ -1292,6 +2235,11 @@ type ArchiveOptions struct {
 	ShouldExtract        bool          `protobuf:"varint,1,opt,name=should_extract,json=shouldExtract,proto3" json:"should_extract,omitempty"`
 	Format               ArchiveFormat `protobuf:"varint,2,opt,name=format,proto3,enum=jasper.ArchiveFormat" json:"format,omitempty"`
 	TargetPath           string        `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	StripComponents      int64         `protobuf:"varint,4,opt,name=strip_components,json=stripComponents,proto3" json:"strip_components,omitempty"`
+	Include              []string      `protobuf:"bytes,5,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude              []string      `protobuf:"bytes,6,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	MaxSize              int64         `protobuf:"varint,7,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	MaxFiles             int64         `protobuf:"varint,8,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -1301,7 +2249,7 @@ func (m *ArchiveOptions) Reset()         { *m = ArchiveOptions{} }
 func (m *ArchiveOptions) String() string { return proto.CompactTextString(m) }
 func (*ArchiveOptions) ProtoMessage()    {}
 func (*ArchiveOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{18}
+	return fileDescriptor_jasper_358a25047a98df41, []int{29}
 }
 func (m *ArchiveOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ArchiveOptions.Unmarshal(m, b)
@@ -1342,20 +2290,110 @@ func (m *ArchiveOptions) GetTargetPath() string {
 	return ""
 }
 
+func (m *ArchiveOptions) GetStripComponents() int64 {
+	if m != nil {
+		return m.StripComponents
+	}
+	return 0
+}
+
+func (m *ArchiveOptions) GetInclude() []string {
+	if m != nil {
+		return m.Include
+	}
+	return nil
+}
+
+func (m *ArchiveOptions) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
+func (m *ArchiveOptions) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *ArchiveOptions) GetMaxFiles() int64 {
+	if m != nil {
+		return m.MaxFiles
+	}
+	return 0
+}
+
+type ArchiveReport struct {
+	Files                []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveReport) Reset()         { *m = ArchiveReport{} }
+func (m *ArchiveReport) String() string { return proto.CompactTextString(m) }
+func (*ArchiveReport) ProtoMessage()    {}
+func (*ArchiveReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{30}
+}
+func (m *ArchiveReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveReport.Unmarshal(m, b)
+}
+func (m *ArchiveReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveReport.Marshal(b, m, deterministic)
+}
+func (dst *ArchiveReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveReport.Merge(dst, src)
+}
+func (m *ArchiveReport) XXX_Size() int {
+	return xxx_messageInfo_ArchiveReport.Size(m)
+}
+func (m *ArchiveReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveReport proto.InternalMessageInfo
+
+func (m *ArchiveReport) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ArchiveReport) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 type DownloadInfo struct {
-	Url                  string          `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Path                 string          `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	ArchiveOpts          *ArchiveOptions `protobuf:"bytes,3,opt,name=archive_opts,json=archiveOpts,proto3" json:"archive_opts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Url                  string           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Path                 string           `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	ArchiveOpts          *ArchiveOptions  `protobuf:"bytes,3,opt,name=archive_opts,json=archiveOpts,proto3" json:"archive_opts,omitempty"`
+	Sha256               string           `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Sha1                 string           `protobuf:"bytes,5,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	Md5                  string           `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5,omitempty"`
+	FetchChecksum        bool             `protobuf:"varint,7,opt,name=fetch_checksum,json=fetchChecksum,proto3" json:"fetch_checksum,omitempty"`
+	MaxRetries           int64            `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	RetryBackoff         int64            `protobuf:"varint,9,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	Timeout              int64            `protobuf:"varint,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Sources              *DownloadSources `protobuf:"bytes,11,opt,name=sources,proto3" json:"sources,omitempty"`
+	MaxBytesPerSecond    int64            `protobuf:"varint,12,opt,name=max_bytes_per_second,json=maxBytesPerSecond,proto3" json:"max_bytes_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *DownloadInfo) Reset()         { *m = DownloadInfo{} }
 func (m *DownloadInfo) String() string { return proto.CompactTextString(m) }
 func (*DownloadInfo) ProtoMessage()    {}
 func (*DownloadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{19}
+	return fileDescriptor_jasper_358a25047a98df41, []int{31}
 }
 func (m *DownloadInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownloadInfo.Unmarshal(m, b)
@@ -1396,295 +2434,1995 @@ func (m *DownloadInfo) GetArchiveOpts() *ArchiveOptions {
 	return nil
 }
 
-type BuildloggerURLs struct {
-	Urls                 []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DownloadInfo) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
 }
 
-func (m *BuildloggerURLs) Reset()         { *m = BuildloggerURLs{} }
-func (m *BuildloggerURLs) String() string { return proto.CompactTextString(m) }
-func (*BuildloggerURLs) ProtoMessage()    {}
-func (*BuildloggerURLs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_jasper_e87d9ea5412036d7, []int{20}
-}
-func (m *BuildloggerURLs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuildloggerURLs.Unmarshal(m, b)
+func (m *DownloadInfo) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
 }
-func (m *BuildloggerURLs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuildloggerURLs.Marshal(b, m, deterministic)
+
+func (m *DownloadInfo) GetMd5() string {
+	if m != nil {
+		return m.Md5
+	}
+	return ""
 }
-func (dst *BuildloggerURLs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildloggerURLs.Merge(dst, src)
+
+func (m *DownloadInfo) GetFetchChecksum() bool {
+	if m != nil {
+		return m.FetchChecksum
+	}
+	return false
 }
-func (m *BuildloggerURLs) XXX_Size() int {
-	return xxx_messageInfo_BuildloggerURLs.Size(m)
+
+func (m *DownloadInfo) GetMaxRetries() int64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
 }
-func (m *BuildloggerURLs) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildloggerURLs.DiscardUnknown(m)
+
+func (m *DownloadInfo) GetRetryBackoff() int64 {
+	if m != nil {
+		return m.RetryBackoff
+	}
+	return 0
 }
 
-var xxx_messageInfo_BuildloggerURLs proto.InternalMessageInfo
+func (m *DownloadInfo) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
 
-func (m *BuildloggerURLs) GetUrls() []string {
+func (m *DownloadInfo) GetSources() *DownloadSources {
 	if m != nil {
-		return m.Urls
+		return m.Sources
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Logger)(nil), "jasper.Logger")
-	proto.RegisterType((*OutputOptions)(nil), "jasper.OutputOptions")
-	proto.RegisterType((*LogOptions)(nil), "jasper.LogOptions")
-	proto.RegisterType((*BufferOptions)(nil), "jasper.BufferOptions")
-	proto.RegisterType((*BuildloggerOptions)(nil), "jasper.BuildloggerOptions")
-	proto.RegisterType((*SplunkOptions)(nil), "jasper.SplunkOptions")
-	proto.RegisterType((*CreateOptions)(nil), "jasper.CreateOptions")
-	proto.RegisterMapType((map[string]string)(nil), "jasper.CreateOptions.EnvironmentEntry")
-	proto.RegisterType((*ProcessInfo)(nil), "jasper.ProcessInfo")
-	proto.RegisterType((*StatusResponse)(nil), "jasper.StatusResponse")
-	proto.RegisterType((*Filter)(nil), "jasper.Filter")
-	proto.RegisterType((*SignalProcess)(nil), "jasper.SignalProcess")
-	proto.RegisterType((*TagName)(nil), "jasper.TagName")
-	proto.RegisterType((*ProcessTags)(nil), "jasper.ProcessTags")
-	proto.RegisterType((*JasperProcessID)(nil), "jasper.JasperProcessID")
-	proto.RegisterType((*OperationOutcome)(nil), "jasper.OperationOutcome")
-	proto.RegisterType((*BuildOptions)(nil), "jasper.BuildOptions")
-	proto.RegisterType((*MongoDBDownloadOptions)(nil), "jasper.MongoDBDownloadOptions")
-	proto.RegisterType((*CacheOptions)(nil), "jasper.CacheOptions")
-	proto.RegisterType((*ArchiveOptions)(nil), "jasper.ArchiveOptions")
-	proto.RegisterType((*DownloadInfo)(nil), "jasper.DownloadInfo")
-	proto.RegisterType((*BuildloggerURLs)(nil), "jasper.BuildloggerURLs")
-	proto.RegisterEnum("jasper.LogType", LogType_name, LogType_value)
-	proto.RegisterEnum("jasper.LogFormat", LogFormat_name, LogFormat_value)
-	proto.RegisterEnum("jasper.FilterSpecifications", FilterSpecifications_name, FilterSpecifications_value)
-	proto.RegisterEnum("jasper.Signals", Signals_name, Signals_value)
-	proto.RegisterEnum("jasper.ArchiveFormat", ArchiveFormat_name, ArchiveFormat_value)
+func (m *DownloadInfo) GetMaxBytesPerSecond() int64 {
+	if m != nil {
+		return m.MaxBytesPerSecond
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// JasperProcessManagerClient is the client API for JasperProcessManager service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type JasperProcessManagerClient interface {
-	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
-	Create(ctx context.Context, in *CreateOptions, opts ...grpc.CallOption) (*ProcessInfo, error)
-	List(ctx context.Context, in *Filter, opts ...grpc.CallOption) (JasperProcessManager_ListClient, error)
-	Group(ctx context.Context, in *TagName, opts ...grpc.CallOption) (JasperProcessManager_GroupClient, error)
-	Get(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error)
-	Wait(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
-	Respawn(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error)
-	Signal(ctx context.Context, in *SignalProcess, opts ...grpc.CallOption) (*OperationOutcome, error)
-	Clear(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error)
-	Close(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error)
-	TagProcess(ctx context.Context, in *ProcessTags, opts ...grpc.CallOption) (*OperationOutcome, error)
-	ResetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
-	GetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessTags, error)
-	DownloadFile(ctx context.Context, in *DownloadInfo, opts ...grpc.CallOption) (*OperationOutcome, error)
-	DownloadMongoDB(ctx context.Context, in *MongoDBDownloadOptions, opts ...grpc.CallOption) (*OperationOutcome, error)
-	ConfigureCache(ctx context.Context, in *CacheOptions, opts ...grpc.CallOption) (*OperationOutcome, error)
-	GetBuildloggerURLs(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*BuildloggerURLs, error)
+type DownloadOptions struct {
+	Workers              int64    `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	MaxBytesPerSecond    int64    `protobuf:"varint,2,opt,name=max_bytes_per_second,json=maxBytesPerSecond,proto3" json:"max_bytes_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type jasperProcessManagerClient struct {
-	cc *grpc.ClientConn
+func (m *DownloadOptions) Reset()         { *m = DownloadOptions{} }
+func (m *DownloadOptions) String() string { return proto.CompactTextString(m) }
+func (*DownloadOptions) ProtoMessage()    {}
+func (*DownloadOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{32}
 }
-
-func NewJasperProcessManagerClient(cc *grpc.ClientConn) JasperProcessManagerClient {
-	return &jasperProcessManagerClient{cc}
+func (m *DownloadOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadOptions.Unmarshal(m, b)
+}
+func (m *DownloadOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadOptions.Marshal(b, m, deterministic)
+}
+func (dst *DownloadOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadOptions.Merge(dst, src)
+}
+func (m *DownloadOptions) XXX_Size() int {
+	return xxx_messageInfo_DownloadOptions.Size(m)
+}
+func (m *DownloadOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadOptions.DiscardUnknown(m)
 }
 
-func (c *jasperProcessManagerClient) Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Status", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DownloadOptions proto.InternalMessageInfo
+
+func (m *DownloadOptions) GetWorkers() int64 {
+	if m != nil {
+		return m.Workers
 	}
-	return out, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) Create(ctx context.Context, in *CreateOptions, opts ...grpc.CallOption) (*ProcessInfo, error) {
-	out := new(ProcessInfo)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadOptions) GetMaxBytesPerSecond() int64 {
+	if m != nil {
+		return m.MaxBytesPerSecond
 	}
-	return out, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) List(ctx context.Context, in *Filter, opts ...grpc.CallOption) (JasperProcessManager_ListClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_JasperProcessManager_serviceDesc.Streams[0], "/jasper.JasperProcessManager/List", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &jasperProcessManagerListClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type DownloadStatus struct {
+	Options              *DownloadOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Pending              int64            `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Running              int64            `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	CacheEntries         int64            `protobuf:"varint,4,opt,name=cache_entries,json=cacheEntries,proto3" json:"cache_entries,omitempty"`
+	CacheSize            int64            `protobuf:"varint,5,opt,name=cache_size,json=cacheSize,proto3" json:"cache_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-type JasperProcessManager_ListClient interface {
-	Recv() (*ProcessInfo, error)
-	grpc.ClientStream
+func (m *DownloadStatus) Reset()         { *m = DownloadStatus{} }
+func (m *DownloadStatus) String() string { return proto.CompactTextString(m) }
+func (*DownloadStatus) ProtoMessage()    {}
+func (*DownloadStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{33}
+}
+func (m *DownloadStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadStatus.Unmarshal(m, b)
+}
+func (m *DownloadStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadStatus.Marshal(b, m, deterministic)
+}
+func (dst *DownloadStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadStatus.Merge(dst, src)
+}
+func (m *DownloadStatus) XXX_Size() int {
+	return xxx_messageInfo_DownloadStatus.Size(m)
+}
+func (m *DownloadStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadStatus.DiscardUnknown(m)
 }
 
-type jasperProcessManagerListClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_DownloadStatus proto.InternalMessageInfo
+
+func (m *DownloadStatus) GetOptions() *DownloadOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
 }
 
-func (x *jasperProcessManagerListClient) Recv() (*ProcessInfo, error) {
-	m := new(ProcessInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DownloadStatus) GetPending() int64 {
+	if m != nil {
+		return m.Pending
 	}
-	return m, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) Group(ctx context.Context, in *TagName, opts ...grpc.CallOption) (JasperProcessManager_GroupClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_JasperProcessManager_serviceDesc.Streams[1], "/jasper.JasperProcessManager/Group", opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadStatus) GetRunning() int64 {
+	if m != nil {
+		return m.Running
 	}
-	x := &jasperProcessManagerGroupClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *DownloadStatus) GetCacheEntries() int64 {
+	if m != nil {
+		return m.CacheEntries
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *DownloadStatus) GetCacheSize() int64 {
+	if m != nil {
+		return m.CacheSize
 	}
-	return x, nil
+	return 0
 }
 
-type JasperProcessManager_GroupClient interface {
-	Recv() (*ProcessInfo, error)
-	grpc.ClientStream
+type DownloadJobID struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type jasperProcessManagerGroupClient struct {
-	grpc.ClientStream
+func (m *DownloadJobID) Reset()         { *m = DownloadJobID{} }
+func (m *DownloadJobID) String() string { return proto.CompactTextString(m) }
+func (*DownloadJobID) ProtoMessage()    {}
+func (*DownloadJobID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{34}
+}
+func (m *DownloadJobID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadJobID.Unmarshal(m, b)
+}
+func (m *DownloadJobID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadJobID.Marshal(b, m, deterministic)
+}
+func (dst *DownloadJobID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadJobID.Merge(dst, src)
+}
+func (m *DownloadJobID) XXX_Size() int {
+	return xxx_messageInfo_DownloadJobID.Size(m)
+}
+func (m *DownloadJobID) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadJobID.DiscardUnknown(m)
 }
 
-func (x *jasperProcessManagerGroupClient) Recv() (*ProcessInfo, error) {
-	m := new(ProcessInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_DownloadJobID proto.InternalMessageInfo
+
+func (m *DownloadJobID) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return m, nil
+	return ""
 }
 
-func (c *jasperProcessManagerClient) Get(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error) {
-	out := new(ProcessInfo)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DownloadJobStatus struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Info                 *DownloadInfo    `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	State                DownloadJobState `protobuf:"varint,3,opt,name=state,proto3,enum=jasper.DownloadJobState" json:"state,omitempty"`
+	BytesTransferred     int64            `protobuf:"varint,4,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	BytesTotal           int64            `protobuf:"varint,5,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	Rate                 float64          `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	Error                string           `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	StartTime            int64            `protobuf:"varint,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64            `protobuf:"varint,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Extracted            *ArchiveReport   `protobuf:"bytes,10,opt,name=extracted,proto3" json:"extracted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *jasperProcessManagerClient) Wait(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Wait", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) Reset()         { *m = DownloadJobStatus{} }
+func (m *DownloadJobStatus) String() string { return proto.CompactTextString(m) }
+func (*DownloadJobStatus) ProtoMessage()    {}
+func (*DownloadJobStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{35}
+}
+func (m *DownloadJobStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadJobStatus.Unmarshal(m, b)
+}
+func (m *DownloadJobStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadJobStatus.Marshal(b, m, deterministic)
+}
+func (dst *DownloadJobStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadJobStatus.Merge(dst, src)
+}
+func (m *DownloadJobStatus) XXX_Size() int {
+	return xxx_messageInfo_DownloadJobStatus.Size(m)
+}
+func (m *DownloadJobStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadJobStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadJobStatus proto.InternalMessageInfo
+
+func (m *DownloadJobStatus) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *jasperProcessManagerClient) Respawn(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error) {
-	out := new(ProcessInfo)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Respawn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetInfo() *DownloadInfo {
+	if m != nil {
+		return m.Info
 	}
-	return out, nil
+	return nil
 }
 
-func (c *jasperProcessManagerClient) Signal(ctx context.Context, in *SignalProcess, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Signal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetState() DownloadJobState {
+	if m != nil {
+		return m.State
 	}
-	return out, nil
+	return DownloadJobState_DOWNLOADJOBUNKNOWN
 }
 
-func (c *jasperProcessManagerClient) Clear(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Clear", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetBytesTransferred() int64 {
+	if m != nil {
+		return m.BytesTransferred
 	}
-	return out, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) Close(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Close", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetBytesTotal() int64 {
+	if m != nil {
+		return m.BytesTotal
 	}
-	return out, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) TagProcess(ctx context.Context, in *ProcessTags, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/TagProcess", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetRate() float64 {
+	if m != nil {
+		return m.Rate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) ResetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ResetTags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *jasperProcessManagerClient) GetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessTags, error) {
-	out := new(ProcessTags)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetTags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) DownloadFile(ctx context.Context, in *DownloadInfo, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/DownloadFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *jasperProcessManagerClient) DownloadMongoDB(ctx context.Context, in *MongoDBDownloadOptions, opts ...grpc.CallOption) (*OperationOutcome, error) {
-	out := new(OperationOutcome)
-	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/DownloadMongoDB", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownloadJobStatus) GetExtracted() *ArchiveReport {
+	if m != nil {
+		return m.Extracted
 	}
-	return out, nil
+	return nil
 }
 
-func (c *jasperProcessManagerClient) ConfigureCache(ctx context.Context, in *CacheOptions, opts ...grpc.CallOption) (*OperationOutcome, error) {
+type DownloadJobStatuses struct {
+	Statuses             []*DownloadJobStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DownloadJobStatuses) Reset()         { *m = DownloadJobStatuses{} }
+func (m *DownloadJobStatuses) String() string { return proto.CompactTextString(m) }
+func (*DownloadJobStatuses) ProtoMessage()    {}
+func (*DownloadJobStatuses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{36}
+}
+func (m *DownloadJobStatuses) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadJobStatuses.Unmarshal(m, b)
+}
+func (m *DownloadJobStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadJobStatuses.Marshal(b, m, deterministic)
+}
+func (dst *DownloadJobStatuses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadJobStatuses.Merge(dst, src)
+}
+func (m *DownloadJobStatuses) XXX_Size() int {
+	return xxx_messageInfo_DownloadJobStatuses.Size(m)
+}
+func (m *DownloadJobStatuses) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadJobStatuses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadJobStatuses proto.InternalMessageInfo
+
+func (m *DownloadJobStatuses) GetStatuses() []*DownloadJobStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type DownloadCacheEntry struct {
+	Digest               string   `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Urls                 []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Pinned               bool     `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Hits                 int64    `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	AddTime              int64    `protobuf:"varint,6,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`
+	AccessTime           int64    `protobuf:"varint,7,opt,name=access_time,json=accessTime,proto3" json:"access_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadCacheEntry) Reset()         { *m = DownloadCacheEntry{} }
+func (m *DownloadCacheEntry) String() string { return proto.CompactTextString(m) }
+func (*DownloadCacheEntry) ProtoMessage()    {}
+func (*DownloadCacheEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{37}
+}
+func (m *DownloadCacheEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadCacheEntry.Unmarshal(m, b)
+}
+func (m *DownloadCacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadCacheEntry.Marshal(b, m, deterministic)
+}
+func (dst *DownloadCacheEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadCacheEntry.Merge(dst, src)
+}
+func (m *DownloadCacheEntry) XXX_Size() int {
+	return xxx_messageInfo_DownloadCacheEntry.Size(m)
+}
+func (m *DownloadCacheEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadCacheEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadCacheEntry proto.InternalMessageInfo
+
+func (m *DownloadCacheEntry) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *DownloadCacheEntry) GetUrls() []string {
+	if m != nil {
+		return m.Urls
+	}
+	return nil
+}
+
+func (m *DownloadCacheEntry) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *DownloadCacheEntry) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func (m *DownloadCacheEntry) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *DownloadCacheEntry) GetAddTime() int64 {
+	if m != nil {
+		return m.AddTime
+	}
+	return 0
+}
+
+func (m *DownloadCacheEntry) GetAccessTime() int64 {
+	if m != nil {
+		return m.AccessTime
+	}
+	return 0
+}
+
+type DownloadCacheEntries struct {
+	Entries              []*DownloadCacheEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DownloadCacheEntries) Reset()         { *m = DownloadCacheEntries{} }
+func (m *DownloadCacheEntries) String() string { return proto.CompactTextString(m) }
+func (*DownloadCacheEntries) ProtoMessage()    {}
+func (*DownloadCacheEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{38}
+}
+func (m *DownloadCacheEntries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadCacheEntries.Unmarshal(m, b)
+}
+func (m *DownloadCacheEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadCacheEntries.Marshal(b, m, deterministic)
+}
+func (dst *DownloadCacheEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadCacheEntries.Merge(dst, src)
+}
+func (m *DownloadCacheEntries) XXX_Size() int {
+	return xxx_messageInfo_DownloadCacheEntries.Size(m)
+}
+func (m *DownloadCacheEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadCacheEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadCacheEntries proto.InternalMessageInfo
+
+func (m *DownloadCacheEntries) GetEntries() []*DownloadCacheEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type DownloadCacheDigest struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadCacheDigest) Reset()         { *m = DownloadCacheDigest{} }
+func (m *DownloadCacheDigest) String() string { return proto.CompactTextString(m) }
+func (*DownloadCacheDigest) ProtoMessage()    {}
+func (*DownloadCacheDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{39}
+}
+func (m *DownloadCacheDigest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadCacheDigest.Unmarshal(m, b)
+}
+func (m *DownloadCacheDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadCacheDigest.Marshal(b, m, deterministic)
+}
+func (dst *DownloadCacheDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadCacheDigest.Merge(dst, src)
+}
+func (m *DownloadCacheDigest) XXX_Size() int {
+	return xxx_messageInfo_DownloadCacheDigest.Size(m)
+}
+func (m *DownloadCacheDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadCacheDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadCacheDigest proto.InternalMessageInfo
+
+func (m *DownloadCacheDigest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type DownloadCachePin struct {
+	Digest               string   `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Pinned               bool     `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadCachePin) Reset()         { *m = DownloadCachePin{} }
+func (m *DownloadCachePin) String() string { return proto.CompactTextString(m) }
+func (*DownloadCachePin) ProtoMessage()    {}
+func (*DownloadCachePin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{40}
+}
+func (m *DownloadCachePin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadCachePin.Unmarshal(m, b)
+}
+func (m *DownloadCachePin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadCachePin.Marshal(b, m, deterministic)
+}
+func (dst *DownloadCachePin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadCachePin.Merge(dst, src)
+}
+func (m *DownloadCachePin) XXX_Size() int {
+	return xxx_messageInfo_DownloadCachePin.Size(m)
+}
+func (m *DownloadCachePin) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadCachePin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadCachePin proto.InternalMessageInfo
+
+func (m *DownloadCachePin) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *DownloadCachePin) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+type BuildloggerURLs struct {
+	Urls                 []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildloggerURLs) Reset()         { *m = BuildloggerURLs{} }
+func (m *BuildloggerURLs) String() string { return proto.CompactTextString(m) }
+func (*BuildloggerURLs) ProtoMessage()    {}
+func (*BuildloggerURLs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{41}
+}
+func (m *BuildloggerURLs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildloggerURLs.Unmarshal(m, b)
+}
+func (m *BuildloggerURLs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildloggerURLs.Marshal(b, m, deterministic)
+}
+func (dst *BuildloggerURLs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildloggerURLs.Merge(dst, src)
+}
+func (m *BuildloggerURLs) XXX_Size() int {
+	return xxx_messageInfo_BuildloggerURLs.Size(m)
+}
+func (m *BuildloggerURLs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildloggerURLs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildloggerURLs proto.InternalMessageInfo
+
+func (m *BuildloggerURLs) GetUrls() []string {
+	if m != nil {
+		return m.Urls
+	}
+	return nil
+}
+
+type LogLines struct {
+	Lines                []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogLines) Reset()         { *m = LogLines{} }
+func (m *LogLines) String() string { return proto.CompactTextString(m) }
+func (*LogLines) ProtoMessage()    {}
+func (*LogLines) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{42}
+}
+func (m *LogLines) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLines.Unmarshal(m, b)
+}
+func (m *LogLines) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLines.Marshal(b, m, deterministic)
+}
+func (dst *LogLines) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLines.Merge(dst, src)
+}
+func (m *LogLines) XXX_Size() int {
+	return xxx_messageInfo_LogLines.Size(m)
+}
+func (m *LogLines) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLines.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLines proto.InternalMessageInfo
+
+func (m *LogLines) GetLines() []string {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+type LogQuery struct {
+	Id                   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stream               LogStream `protobuf:"varint,2,opt,name=stream,proto3,enum=jasper.LogStream" json:"stream,omitempty"`
+	Since                int64     `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Pattern              string    `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Tail                 int64     `protobuf:"varint,5,opt,name=tail,proto3" json:"tail,omitempty"`
+	Offset               int64     `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int64     `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LogQuery) Reset()         { *m = LogQuery{} }
+func (m *LogQuery) String() string { return proto.CompactTextString(m) }
+func (*LogQuery) ProtoMessage()    {}
+func (*LogQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{43}
+}
+func (m *LogQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogQuery.Unmarshal(m, b)
+}
+func (m *LogQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogQuery.Marshal(b, m, deterministic)
+}
+func (dst *LogQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogQuery.Merge(dst, src)
+}
+func (m *LogQuery) XXX_Size() int {
+	return xxx_messageInfo_LogQuery.Size(m)
+}
+func (m *LogQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogQuery proto.InternalMessageInfo
+
+func (m *LogQuery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LogQuery) GetStream() LogStream {
+	if m != nil {
+		return m.Stream
+	}
+	return LogStream_LOGSTREAMUNKNOWN
+}
+
+func (m *LogQuery) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *LogQuery) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *LogQuery) GetTail() int64 {
+	if m != nil {
+		return m.Tail
+	}
+	return 0
+}
+
+func (m *LogQuery) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *LogQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type LogRecord struct {
+	Timestamp            int64     `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Stream               LogStream `protobuf:"varint,2,opt,name=stream,proto3,enum=jasper.LogStream" json:"stream,omitempty"`
+	Logger               int64     `protobuf:"varint,3,opt,name=logger,proto3" json:"logger,omitempty"`
+	Line                 string    `protobuf:"bytes,4,opt,name=line,proto3" json:"line,omitempty"`
+	Fields               []byte    `protobuf:"bytes,5,opt,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LogRecord) Reset()         { *m = LogRecord{} }
+func (m *LogRecord) String() string { return proto.CompactTextString(m) }
+func (*LogRecord) ProtoMessage()    {}
+func (*LogRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{44}
+}
+func (m *LogRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogRecord.Unmarshal(m, b)
+}
+func (m *LogRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogRecord.Marshal(b, m, deterministic)
+}
+func (dst *LogRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRecord.Merge(dst, src)
+}
+func (m *LogRecord) XXX_Size() int {
+	return xxx_messageInfo_LogRecord.Size(m)
+}
+func (m *LogRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogRecord proto.InternalMessageInfo
+
+func (m *LogRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *LogRecord) GetStream() LogStream {
+	if m != nil {
+		return m.Stream
+	}
+	return LogStream_LOGSTREAMUNKNOWN
+}
+
+func (m *LogRecord) GetLogger() int64 {
+	if m != nil {
+		return m.Logger
+	}
+	return 0
+}
+
+func (m *LogRecord) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
+func (m *LogRecord) GetFields() []byte {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type LogRecords struct {
+	Records              []*LogRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *LogRecords) Reset()         { *m = LogRecords{} }
+func (m *LogRecords) String() string { return proto.CompactTextString(m) }
+func (*LogRecords) ProtoMessage()    {}
+func (*LogRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{45}
+}
+func (m *LogRecords) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogRecords.Unmarshal(m, b)
+}
+func (m *LogRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogRecords.Marshal(b, m, deterministic)
+}
+func (dst *LogRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRecords.Merge(dst, src)
+}
+func (m *LogRecords) XXX_Size() int {
+	return xxx_messageInfo_LogRecords.Size(m)
+}
+func (m *LogRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogRecords proto.InternalMessageInfo
+
+func (m *LogRecords) GetRecords() []*LogRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type ArtifactRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArtifactRequest) Reset()         { *m = ArtifactRequest{} }
+func (m *ArtifactRequest) String() string { return proto.CompactTextString(m) }
+func (*ArtifactRequest) ProtoMessage()    {}
+func (*ArtifactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{46}
+}
+func (m *ArtifactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArtifactRequest.Unmarshal(m, b)
+}
+func (m *ArtifactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArtifactRequest.Marshal(b, m, deterministic)
+}
+func (dst *ArtifactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactRequest.Merge(dst, src)
+}
+func (m *ArtifactRequest) XXX_Size() int {
+	return xxx_messageInfo_ArtifactRequest.Size(m)
+}
+func (m *ArtifactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactRequest proto.InternalMessageInfo
+
+func (m *ArtifactRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ArtifactRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ArtifactChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArtifactChunk) Reset()         { *m = ArtifactChunk{} }
+func (m *ArtifactChunk) String() string { return proto.CompactTextString(m) }
+func (*ArtifactChunk) ProtoMessage()    {}
+func (*ArtifactChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{47}
+}
+func (m *ArtifactChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArtifactChunk.Unmarshal(m, b)
+}
+func (m *ArtifactChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArtifactChunk.Marshal(b, m, deterministic)
+}
+func (dst *ArtifactChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactChunk.Merge(dst, src)
+}
+func (m *ArtifactChunk) XXX_Size() int {
+	return xxx_messageInfo_ArtifactChunk.Size(m)
+}
+func (m *ArtifactChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactChunk proto.InternalMessageInfo
+
+func (m *ArtifactChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ProcessCPUTimes struct {
+	Cpu                  string   `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	User                 float64  `protobuf:"fixed64,2,opt,name=user,proto3" json:"user,omitempty"`
+	System               float64  `protobuf:"fixed64,3,opt,name=system,proto3" json:"system,omitempty"`
+	Idle                 float64  `protobuf:"fixed64,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	Nice                 float64  `protobuf:"fixed64,5,opt,name=nice,proto3" json:"nice,omitempty"`
+	Iowait               float64  `protobuf:"fixed64,6,opt,name=iowait,proto3" json:"iowait,omitempty"`
+	Irq                  float64  `protobuf:"fixed64,7,opt,name=irq,proto3" json:"irq,omitempty"`
+	Softirq              float64  `protobuf:"fixed64,8,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	Steal                float64  `protobuf:"fixed64,9,opt,name=steal,proto3" json:"steal,omitempty"`
+	Guest                float64  `protobuf:"fixed64,10,opt,name=guest,proto3" json:"guest,omitempty"`
+	GuestNice            float64  `protobuf:"fixed64,11,opt,name=guest_nice,json=guestNice,proto3" json:"guest_nice,omitempty"`
+	Stolen               float64  `protobuf:"fixed64,12,opt,name=stolen,proto3" json:"stolen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessCPUTimes) Reset()         { *m = ProcessCPUTimes{} }
+func (m *ProcessCPUTimes) String() string { return proto.CompactTextString(m) }
+func (*ProcessCPUTimes) ProtoMessage()    {}
+func (*ProcessCPUTimes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{48}
+}
+func (m *ProcessCPUTimes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessCPUTimes.Unmarshal(m, b)
+}
+func (m *ProcessCPUTimes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessCPUTimes.Marshal(b, m, deterministic)
+}
+func (dst *ProcessCPUTimes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessCPUTimes.Merge(dst, src)
+}
+func (m *ProcessCPUTimes) XXX_Size() int {
+	return xxx_messageInfo_ProcessCPUTimes.Size(m)
+}
+func (m *ProcessCPUTimes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessCPUTimes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessCPUTimes proto.InternalMessageInfo
+
+func (m *ProcessCPUTimes) GetCpu() string {
+	if m != nil {
+		return m.Cpu
+	}
+	return ""
+}
+
+func (m *ProcessCPUTimes) GetUser() float64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetSystem() float64 {
+	if m != nil {
+		return m.System
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetIdle() float64 {
+	if m != nil {
+		return m.Idle
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetNice() float64 {
+	if m != nil {
+		return m.Nice
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetIowait() float64 {
+	if m != nil {
+		return m.Iowait
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetIrq() float64 {
+	if m != nil {
+		return m.Irq
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetSoftirq() float64 {
+	if m != nil {
+		return m.Softirq
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetSteal() float64 {
+	if m != nil {
+		return m.Steal
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetGuest() float64 {
+	if m != nil {
+		return m.Guest
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetGuestNice() float64 {
+	if m != nil {
+		return m.GuestNice
+	}
+	return 0
+}
+
+func (m *ProcessCPUTimes) GetStolen() float64 {
+	if m != nil {
+		return m.Stolen
+	}
+	return 0
+}
+
+type ProcessIOCounters struct {
+	ReadCount            uint64   `protobuf:"varint,1,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"`
+	WriteCount           uint64   `protobuf:"varint,2,opt,name=write_count,json=writeCount,proto3" json:"write_count,omitempty"`
+	ReadBytes            uint64   `protobuf:"varint,3,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes           uint64   `protobuf:"varint,4,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessIOCounters) Reset()         { *m = ProcessIOCounters{} }
+func (m *ProcessIOCounters) String() string { return proto.CompactTextString(m) }
+func (*ProcessIOCounters) ProtoMessage()    {}
+func (*ProcessIOCounters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{49}
+}
+func (m *ProcessIOCounters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessIOCounters.Unmarshal(m, b)
+}
+func (m *ProcessIOCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessIOCounters.Marshal(b, m, deterministic)
+}
+func (dst *ProcessIOCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessIOCounters.Merge(dst, src)
+}
+func (m *ProcessIOCounters) XXX_Size() int {
+	return xxx_messageInfo_ProcessIOCounters.Size(m)
+}
+func (m *ProcessIOCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessIOCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessIOCounters proto.InternalMessageInfo
+
+func (m *ProcessIOCounters) GetReadCount() uint64 {
+	if m != nil {
+		return m.ReadCount
+	}
+	return 0
+}
+
+func (m *ProcessIOCounters) GetWriteCount() uint64 {
+	if m != nil {
+		return m.WriteCount
+	}
+	return 0
+}
+
+func (m *ProcessIOCounters) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *ProcessIOCounters) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+type ProcessNetCounters struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BytesSent            uint64   `protobuf:"varint,2,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesRecv            uint64   `protobuf:"varint,3,opt,name=bytes_recv,json=bytesRecv,proto3" json:"bytes_recv,omitempty"`
+	PacketsSent          uint64   `protobuf:"varint,4,opt,name=packets_sent,json=packetsSent,proto3" json:"packets_sent,omitempty"`
+	PacketsRecv          uint64   `protobuf:"varint,5,opt,name=packets_recv,json=packetsRecv,proto3" json:"packets_recv,omitempty"`
+	Errin                uint64   `protobuf:"varint,6,opt,name=errin,proto3" json:"errin,omitempty"`
+	Errout               uint64   `protobuf:"varint,7,opt,name=errout,proto3" json:"errout,omitempty"`
+	Dropin               uint64   `protobuf:"varint,8,opt,name=dropin,proto3" json:"dropin,omitempty"`
+	Dropout              uint64   `protobuf:"varint,9,opt,name=dropout,proto3" json:"dropout,omitempty"`
+	Fifoin               uint64   `protobuf:"varint,10,opt,name=fifoin,proto3" json:"fifoin,omitempty"`
+	Fifoout              uint64   `protobuf:"varint,11,opt,name=fifoout,proto3" json:"fifoout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessNetCounters) Reset()         { *m = ProcessNetCounters{} }
+func (m *ProcessNetCounters) String() string { return proto.CompactTextString(m) }
+func (*ProcessNetCounters) ProtoMessage()    {}
+func (*ProcessNetCounters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{50}
+}
+func (m *ProcessNetCounters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessNetCounters.Unmarshal(m, b)
+}
+func (m *ProcessNetCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessNetCounters.Marshal(b, m, deterministic)
+}
+func (dst *ProcessNetCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessNetCounters.Merge(dst, src)
+}
+func (m *ProcessNetCounters) XXX_Size() int {
+	return xxx_messageInfo_ProcessNetCounters.Size(m)
+}
+func (m *ProcessNetCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessNetCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessNetCounters proto.InternalMessageInfo
+
+func (m *ProcessNetCounters) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProcessNetCounters) GetBytesSent() uint64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetBytesRecv() uint64 {
+	if m != nil {
+		return m.BytesRecv
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetPacketsSent() uint64 {
+	if m != nil {
+		return m.PacketsSent
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetPacketsRecv() uint64 {
+	if m != nil {
+		return m.PacketsRecv
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetErrin() uint64 {
+	if m != nil {
+		return m.Errin
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetErrout() uint64 {
+	if m != nil {
+		return m.Errout
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetDropin() uint64 {
+	if m != nil {
+		return m.Dropin
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetDropout() uint64 {
+	if m != nil {
+		return m.Dropout
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetFifoin() uint64 {
+	if m != nil {
+		return m.Fifoin
+	}
+	return 0
+}
+
+func (m *ProcessNetCounters) GetFifoout() uint64 {
+	if m != nil {
+		return m.Fifoout
+	}
+	return 0
+}
+
+type ProcessMemory struct {
+	Rss                  uint64   `protobuf:"varint,1,opt,name=rss,proto3" json:"rss,omitempty"`
+	Vms                  uint64   `protobuf:"varint,2,opt,name=vms,proto3" json:"vms,omitempty"`
+	Data                 uint64   `protobuf:"varint,3,opt,name=data,proto3" json:"data,omitempty"`
+	Stack                uint64   `protobuf:"varint,4,opt,name=stack,proto3" json:"stack,omitempty"`
+	Locked               uint64   `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"`
+	Swap                 uint64   `protobuf:"varint,6,opt,name=swap,proto3" json:"swap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessMemory) Reset()         { *m = ProcessMemory{} }
+func (m *ProcessMemory) String() string { return proto.CompactTextString(m) }
+func (*ProcessMemory) ProtoMessage()    {}
+func (*ProcessMemory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{51}
+}
+func (m *ProcessMemory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessMemory.Unmarshal(m, b)
+}
+func (m *ProcessMemory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessMemory.Marshal(b, m, deterministic)
+}
+func (dst *ProcessMemory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessMemory.Merge(dst, src)
+}
+func (m *ProcessMemory) XXX_Size() int {
+	return xxx_messageInfo_ProcessMemory.Size(m)
+}
+func (m *ProcessMemory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessMemory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessMemory proto.InternalMessageInfo
+
+func (m *ProcessMemory) GetRss() uint64 {
+	if m != nil {
+		return m.Rss
+	}
+	return 0
+}
+
+func (m *ProcessMemory) GetVms() uint64 {
+	if m != nil {
+		return m.Vms
+	}
+	return 0
+}
+
+func (m *ProcessMemory) GetData() uint64 {
+	if m != nil {
+		return m.Data
+	}
+	return 0
+}
+
+func (m *ProcessMemory) GetStack() uint64 {
+	if m != nil {
+		return m.Stack
+	}
+	return 0
+}
+
+func (m *ProcessMemory) GetLocked() uint64 {
+	if m != nil {
+		return m.Locked
+	}
+	return 0
+}
+
+func (m *ProcessMemory) GetSwap() uint64 {
+	if m != nil {
+		return m.Swap
+	}
+	return 0
+}
+
+type ProcessMetricsInfo struct {
+	Message              string                `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Pid                  int32                 `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	Parent               int32                 `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	Threads              int64                 `protobuf:"varint,4,opt,name=threads,proto3" json:"threads,omitempty"`
+	Command              string                `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	Cpu                  *ProcessCPUTimes      `protobuf:"bytes,6,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Io                   *ProcessIOCounters    `protobuf:"bytes,7,opt,name=io,proto3" json:"io,omitempty"`
+	Net                  []*ProcessNetCounters `protobuf:"bytes,8,rep,name=net,proto3" json:"net,omitempty"`
+	Memory               *ProcessMemory        `protobuf:"bytes,9,opt,name=memory,proto3" json:"memory,omitempty"`
+	MemoryPlatform       map[string]uint64     `protobuf:"bytes,10,rep,name=memory_platform,json=memoryPlatform,proto3" json:"memory_platform,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Errors               []string              `protobuf:"bytes,11,rep,name=errors,proto3" json:"errors,omitempty"`
+	Level                int64                 `protobuf:"varint,12,opt,name=level,proto3" json:"level,omitempty"`
+	Hostname             string                `protobuf:"bytes,13,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Time                 int64                 `protobuf:"varint,14,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ProcessMetricsInfo) Reset()         { *m = ProcessMetricsInfo{} }
+func (m *ProcessMetricsInfo) String() string { return proto.CompactTextString(m) }
+func (*ProcessMetricsInfo) ProtoMessage()    {}
+func (*ProcessMetricsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{52}
+}
+func (m *ProcessMetricsInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessMetricsInfo.Unmarshal(m, b)
+}
+func (m *ProcessMetricsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessMetricsInfo.Marshal(b, m, deterministic)
+}
+func (dst *ProcessMetricsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessMetricsInfo.Merge(dst, src)
+}
+func (m *ProcessMetricsInfo) XXX_Size() int {
+	return xxx_messageInfo_ProcessMetricsInfo.Size(m)
+}
+func (m *ProcessMetricsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessMetricsInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessMetricsInfo proto.InternalMessageInfo
+
+func (m *ProcessMetricsInfo) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ProcessMetricsInfo) GetPid() int32 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+func (m *ProcessMetricsInfo) GetParent() int32 {
+	if m != nil {
+		return m.Parent
+	}
+	return 0
+}
+
+func (m *ProcessMetricsInfo) GetThreads() int64 {
+	if m != nil {
+		return m.Threads
+	}
+	return 0
+}
+
+func (m *ProcessMetricsInfo) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *ProcessMetricsInfo) GetCpu() *ProcessCPUTimes {
+	if m != nil {
+		return m.Cpu
+	}
+	return nil
+}
+
+func (m *ProcessMetricsInfo) GetIo() *ProcessIOCounters {
+	if m != nil {
+		return m.Io
+	}
+	return nil
+}
+
+func (m *ProcessMetricsInfo) GetNet() []*ProcessNetCounters {
+	if m != nil {
+		return m.Net
+	}
+	return nil
+}
+
+func (m *ProcessMetricsInfo) GetMemory() *ProcessMemory {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *ProcessMetricsInfo) GetMemoryPlatform() map[string]uint64 {
+	if m != nil {
+		return m.MemoryPlatform
+	}
+	return nil
+}
+
+func (m *ProcessMetricsInfo) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *ProcessMetricsInfo) GetLevel() int64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *ProcessMetricsInfo) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *ProcessMetricsInfo) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type ProcessMetrics struct {
+	Processes            []*ProcessMetricsInfo `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ProcessMetrics) Reset()         { *m = ProcessMetrics{} }
+func (m *ProcessMetrics) String() string { return proto.CompactTextString(m) }
+func (*ProcessMetrics) ProtoMessage()    {}
+func (*ProcessMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{53}
+}
+func (m *ProcessMetrics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessMetrics.Unmarshal(m, b)
+}
+func (m *ProcessMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessMetrics.Marshal(b, m, deterministic)
+}
+func (dst *ProcessMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessMetrics.Merge(dst, src)
+}
+func (m *ProcessMetrics) XXX_Size() int {
+	return xxx_messageInfo_ProcessMetrics.Size(m)
+}
+func (m *ProcessMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessMetrics proto.InternalMessageInfo
+
+func (m *ProcessMetrics) GetProcesses() []*ProcessMetricsInfo {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+type FilePath struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilePath) Reset()         { *m = FilePath{} }
+func (m *FilePath) String() string { return proto.CompactTextString(m) }
+func (*FilePath) ProtoMessage()    {}
+func (*FilePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{54}
+}
+func (m *FilePath) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilePath.Unmarshal(m, b)
+}
+func (m *FilePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilePath.Marshal(b, m, deterministic)
+}
+func (dst *FilePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilePath.Merge(dst, src)
+}
+func (m *FilePath) XXX_Size() int {
+	return xxx_messageInfo_FilePath.Size(m)
+}
+func (m *FilePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilePath proto.InternalMessageInfo
+
+func (m *FilePath) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type FileTransferHeader struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Archive              bool     `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileTransferHeader) Reset()         { *m = FileTransferHeader{} }
+func (m *FileTransferHeader) String() string { return proto.CompactTextString(m) }
+func (*FileTransferHeader) ProtoMessage()    {}
+func (*FileTransferHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{55}
+}
+func (m *FileTransferHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileTransferHeader.Unmarshal(m, b)
+}
+func (m *FileTransferHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileTransferHeader.Marshal(b, m, deterministic)
+}
+func (dst *FileTransferHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileTransferHeader.Merge(dst, src)
+}
+func (m *FileTransferHeader) XXX_Size() int {
+	return xxx_messageInfo_FileTransferHeader.Size(m)
+}
+func (m *FileTransferHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileTransferHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileTransferHeader proto.InternalMessageInfo
+
+func (m *FileTransferHeader) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FileTransferHeader) GetArchive() bool {
+	if m != nil {
+		return m.Archive
+	}
+	return false
+}
+
+type FileTransferChunk struct {
+	Header               *FileTransferHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Data                 []byte              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *FileTransferChunk) Reset()         { *m = FileTransferChunk{} }
+func (m *FileTransferChunk) String() string { return proto.CompactTextString(m) }
+func (*FileTransferChunk) ProtoMessage()    {}
+func (*FileTransferChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_jasper_358a25047a98df41, []int{56}
+}
+func (m *FileTransferChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileTransferChunk.Unmarshal(m, b)
+}
+func (m *FileTransferChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileTransferChunk.Marshal(b, m, deterministic)
+}
+func (dst *FileTransferChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileTransferChunk.Merge(dst, src)
+}
+func (m *FileTransferChunk) XXX_Size() int {
+	return xxx_messageInfo_FileTransferChunk.Size(m)
+}
+func (m *FileTransferChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileTransferChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileTransferChunk proto.InternalMessageInfo
+
+func (m *FileTransferChunk) GetHeader() *FileTransferHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *FileTransferChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Logger)(nil), "jasper.Logger")
+	proto.RegisterType((*LogStreamOptions)(nil), "jasper.LogStreamOptions")
+	proto.RegisterType((*OutputOptions)(nil), "jasper.OutputOptions")
+	proto.RegisterType((*OutputLimitOptions)(nil), "jasper.OutputLimitOptions")
+	proto.RegisterType((*LineBufferOptions)(nil), "jasper.LineBufferOptions")
+	proto.RegisterType((*RedactionOptions)(nil), "jasper.RedactionOptions")
+	proto.RegisterType((*LogOptions)(nil), "jasper.LogOptions")
+	proto.RegisterType((*HTTPLogOptions)(nil), "jasper.HTTPLogOptions")
+	proto.RegisterMapType((map[string]string)(nil), "jasper.HTTPLogOptions.FieldsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "jasper.HTTPLogOptions.HeadersEntry")
+	proto.RegisterType((*SyslogOptions)(nil), "jasper.SyslogOptions")
+	proto.RegisterType((*LineParserOptions)(nil), "jasper.LineParserOptions")
+	proto.RegisterType((*BufferOptions)(nil), "jasper.BufferOptions")
+	proto.RegisterType((*RotationOptions)(nil), "jasper.RotationOptions")
+	proto.RegisterType((*BuildloggerOptions)(nil), "jasper.BuildloggerOptions")
+	proto.RegisterType((*SplunkOptions)(nil), "jasper.SplunkOptions")
+	proto.RegisterType((*CreateOptions)(nil), "jasper.CreateOptions")
+	proto.RegisterMapType((map[string]string)(nil), "jasper.CreateOptions.EnvironmentEntry")
+	proto.RegisterType((*ProcessInfo)(nil), "jasper.ProcessInfo")
+	proto.RegisterType((*StatusResponse)(nil), "jasper.StatusResponse")
+	proto.RegisterType((*Filter)(nil), "jasper.Filter")
+	proto.RegisterType((*SignalProcess)(nil), "jasper.SignalProcess")
+	proto.RegisterType((*TagName)(nil), "jasper.TagName")
+	proto.RegisterType((*ProcessTags)(nil), "jasper.ProcessTags")
+	proto.RegisterType((*JasperProcessID)(nil), "jasper.JasperProcessID")
+	proto.RegisterType((*OperationOutcome)(nil), "jasper.OperationOutcome")
+	proto.RegisterType((*BuildOptions)(nil), "jasper.BuildOptions")
+	proto.RegisterType((*MongoDBDownloadOptions)(nil), "jasper.MongoDBDownloadOptions")
+	proto.RegisterType((*MongoDBRelease)(nil), "jasper.MongoDBRelease")
+	proto.RegisterType((*MongoDBReleases)(nil), "jasper.MongoDBReleases")
+	proto.RegisterType((*DownloadSources)(nil), "jasper.DownloadSources")
+	proto.RegisterMapType((map[string]string)(nil), "jasper.DownloadSources.RewritesEntry")
+	proto.RegisterType((*CacheOptions)(nil), "jasper.CacheOptions")
+	proto.RegisterType((*ArchiveOptions)(nil), "jasper.ArchiveOptions")
+	proto.RegisterType((*ArchiveReport)(nil), "jasper.ArchiveReport")
+	proto.RegisterType((*DownloadInfo)(nil), "jasper.DownloadInfo")
+	proto.RegisterType((*DownloadOptions)(nil), "jasper.DownloadOptions")
+	proto.RegisterType((*DownloadStatus)(nil), "jasper.DownloadStatus")
+	proto.RegisterType((*DownloadJobID)(nil), "jasper.DownloadJobID")
+	proto.RegisterType((*DownloadJobStatus)(nil), "jasper.DownloadJobStatus")
+	proto.RegisterType((*DownloadJobStatuses)(nil), "jasper.DownloadJobStatuses")
+	proto.RegisterType((*DownloadCacheEntry)(nil), "jasper.DownloadCacheEntry")
+	proto.RegisterType((*DownloadCacheEntries)(nil), "jasper.DownloadCacheEntries")
+	proto.RegisterType((*DownloadCacheDigest)(nil), "jasper.DownloadCacheDigest")
+	proto.RegisterType((*DownloadCachePin)(nil), "jasper.DownloadCachePin")
+	proto.RegisterType((*BuildloggerURLs)(nil), "jasper.BuildloggerURLs")
+	proto.RegisterType((*LogLines)(nil), "jasper.LogLines")
+	proto.RegisterType((*LogQuery)(nil), "jasper.LogQuery")
+	proto.RegisterType((*LogRecord)(nil), "jasper.LogRecord")
+	proto.RegisterType((*LogRecords)(nil), "jasper.LogRecords")
+	proto.RegisterType((*ArtifactRequest)(nil), "jasper.ArtifactRequest")
+	proto.RegisterType((*ArtifactChunk)(nil), "jasper.ArtifactChunk")
+	proto.RegisterType((*ProcessCPUTimes)(nil), "jasper.ProcessCPUTimes")
+	proto.RegisterType((*ProcessIOCounters)(nil), "jasper.ProcessIOCounters")
+	proto.RegisterType((*ProcessNetCounters)(nil), "jasper.ProcessNetCounters")
+	proto.RegisterType((*ProcessMemory)(nil), "jasper.ProcessMemory")
+	proto.RegisterType((*ProcessMetricsInfo)(nil), "jasper.ProcessMetricsInfo")
+	proto.RegisterMapType((map[string]uint64)(nil), "jasper.ProcessMetricsInfo.MemoryPlatformEntry")
+	proto.RegisterType((*ProcessMetrics)(nil), "jasper.ProcessMetrics")
+	proto.RegisterType((*FilePath)(nil), "jasper.FilePath")
+	proto.RegisterType((*FileTransferHeader)(nil), "jasper.FileTransferHeader")
+	proto.RegisterType((*FileTransferChunk)(nil), "jasper.FileTransferChunk")
+	proto.RegisterEnum("jasper.LogStream", LogStream_name, LogStream_value)
+	proto.RegisterEnum("jasper.OutputLimitPolicy", OutputLimitPolicy_name, OutputLimitPolicy_value)
+	proto.RegisterEnum("jasper.LogType", LogType_name, LogType_value)
+	proto.RegisterEnum("jasper.LogFormat", LogFormat_name, LogFormat_value)
+	proto.RegisterEnum("jasper.LineParserType", LineParserType_name, LineParserType_value)
+	proto.RegisterEnum("jasper.FilterSpecifications", FilterSpecifications_name, FilterSpecifications_value)
+	proto.RegisterEnum("jasper.Signals", Signals_name, Signals_value)
+	proto.RegisterEnum("jasper.ArchiveFormat", ArchiveFormat_name, ArchiveFormat_value)
+	proto.RegisterEnum("jasper.DownloadJobState", DownloadJobState_name, DownloadJobState_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// JasperProcessManagerClient is the client API for JasperProcessManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type JasperProcessManagerClient interface {
+	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	Create(ctx context.Context, in *CreateOptions, opts ...grpc.CallOption) (*ProcessInfo, error)
+	List(ctx context.Context, in *Filter, opts ...grpc.CallOption) (JasperProcessManager_ListClient, error)
+	Group(ctx context.Context, in *TagName, opts ...grpc.CallOption) (JasperProcessManager_GroupClient, error)
+	Get(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error)
+	Wait(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
+	Respawn(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error)
+	Signal(ctx context.Context, in *SignalProcess, opts ...grpc.CallOption) (*OperationOutcome, error)
+	Clear(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error)
+	Close(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error)
+	TagProcess(ctx context.Context, in *ProcessTags, opts ...grpc.CallOption) (*OperationOutcome, error)
+	ResetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
+	GetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessTags, error)
+	DownloadFile(ctx context.Context, in *DownloadInfo, opts ...grpc.CallOption) (*OperationOutcome, error)
+	DownloadMongoDB(ctx context.Context, in *MongoDBDownloadOptions, opts ...grpc.CallOption) (*OperationOutcome, error)
+	ResolveMongoDB(ctx context.Context, in *MongoDBDownloadOptions, opts ...grpc.CallOption) (*MongoDBReleases, error)
+	StartDownload(ctx context.Context, in *DownloadInfo, opts ...grpc.CallOption) (*DownloadJobStatus, error)
+	ListDownloads(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DownloadJobStatuses, error)
+	GetDownload(ctx context.Context, in *DownloadJobID, opts ...grpc.CallOption) (*DownloadJobStatus, error)
+	CancelDownload(ctx context.Context, in *DownloadJobID, opts ...grpc.CallOption) (*OperationOutcome, error)
+	WaitDownload(ctx context.Context, in *DownloadJobID, opts ...grpc.CallOption) (*DownloadJobStatus, error)
+	ConfigureCache(ctx context.Context, in *CacheOptions, opts ...grpc.CallOption) (*OperationOutcome, error)
+	ConfigureDownloadSources(ctx context.Context, in *DownloadSources, opts ...grpc.CallOption) (*OperationOutcome, error)
+	ConfigureDownloads(ctx context.Context, in *DownloadOptions, opts ...grpc.CallOption) (*OperationOutcome, error)
+	GetDownloadStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DownloadStatus, error)
+	ListCachedDownloads(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DownloadCacheEntries, error)
+	GetCachedDownload(ctx context.Context, in *DownloadCacheDigest, opts ...grpc.CallOption) (*DownloadCacheEntry, error)
+	PinCachedDownload(ctx context.Context, in *DownloadCachePin, opts ...grpc.CallOption) (*OperationOutcome, error)
+	EvictCachedDownload(ctx context.Context, in *DownloadCacheDigest, opts ...grpc.CallOption) (*OperationOutcome, error)
+	GetBuildloggerURLs(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*BuildloggerURLs, error)
+	GetLogs(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*LogLines, error)
+	QueryLogs(ctx context.Context, in *LogQuery, opts ...grpc.CallOption) (*LogRecords, error)
+	GetProcessMetrics(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessMetrics, error)
+	GetArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (JasperProcessManager_GetArtifactClient, error)
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (JasperProcessManager_UploadFileClient, error)
+	FetchFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (JasperProcessManager_FetchFileClient, error)
+}
+
+type jasperProcessManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJasperProcessManagerClient(cc *grpc.ClientConn) JasperProcessManagerClient {
+	return &jasperProcessManagerClient{cc}
+}
+
+func (c *jasperProcessManagerClient) Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Create(ctx context.Context, in *CreateOptions, opts ...grpc.CallOption) (*ProcessInfo, error) {
+	out := new(ProcessInfo)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) List(ctx context.Context, in *Filter, opts ...grpc.CallOption) (JasperProcessManager_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JasperProcessManager_serviceDesc.Streams[0], "/jasper.JasperProcessManager/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JasperProcessManager_ListClient interface {
+	Recv() (*ProcessInfo, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerListClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerListClient) Recv() (*ProcessInfo, error) {
+	m := new(ProcessInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) Group(ctx context.Context, in *TagName, opts ...grpc.CallOption) (JasperProcessManager_GroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JasperProcessManager_serviceDesc.Streams[1], "/jasper.JasperProcessManager/Group", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerGroupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JasperProcessManager_GroupClient interface {
+	Recv() (*ProcessInfo, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerGroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerGroupClient) Recv() (*ProcessInfo, error) {
+	m := new(ProcessInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) Get(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error) {
+	out := new(ProcessInfo)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Wait(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Wait", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Respawn(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error) {
+	out := new(ProcessInfo)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Respawn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Signal(ctx context.Context, in *SignalProcess, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Signal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Clear(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Clear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Close(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) TagProcess(ctx context.Context, in *ProcessTags, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/TagProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ResetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ResetTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessTags, error) {
+	out := new(ProcessTags)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) DownloadFile(ctx context.Context, in *DownloadInfo, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/DownloadFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) DownloadMongoDB(ctx context.Context, in *MongoDBDownloadOptions, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/DownloadMongoDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ResolveMongoDB(ctx context.Context, in *MongoDBDownloadOptions, opts ...grpc.CallOption) (*MongoDBReleases, error) {
+	out := new(MongoDBReleases)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ResolveMongoDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) StartDownload(ctx context.Context, in *DownloadInfo, opts ...grpc.CallOption) (*DownloadJobStatus, error) {
+	out := new(DownloadJobStatus)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/StartDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ListDownloads(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DownloadJobStatuses, error) {
+	out := new(DownloadJobStatuses)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ListDownloads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetDownload(ctx context.Context, in *DownloadJobID, opts ...grpc.CallOption) (*DownloadJobStatus, error) {
+	out := new(DownloadJobStatus)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) CancelDownload(ctx context.Context, in *DownloadJobID, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/CancelDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) WaitDownload(ctx context.Context, in *DownloadJobID, opts ...grpc.CallOption) (*DownloadJobStatus, error) {
+	out := new(DownloadJobStatus)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/WaitDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ConfigureCache(ctx context.Context, in *CacheOptions, opts ...grpc.CallOption) (*OperationOutcome, error) {
 	out := new(OperationOutcome)
 	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ConfigureCache", in, out, opts...)
 	if err != nil {
@@ -1693,13 +4431,201 @@ func (c *jasperProcessManagerClient) ConfigureCache(ctx context.Context, in *Cac
 	return out, nil
 }
 
+func (c *jasperProcessManagerClient) ConfigureDownloadSources(ctx context.Context, in *DownloadSources, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ConfigureDownloadSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ConfigureDownloads(ctx context.Context, in *DownloadOptions, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ConfigureDownloads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetDownloadStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DownloadStatus, error) {
+	out := new(DownloadStatus)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetDownloadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ListCachedDownloads(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DownloadCacheEntries, error) {
+	out := new(DownloadCacheEntries)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/ListCachedDownloads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetCachedDownload(ctx context.Context, in *DownloadCacheDigest, opts ...grpc.CallOption) (*DownloadCacheEntry, error) {
+	out := new(DownloadCacheEntry)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetCachedDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) PinCachedDownload(ctx context.Context, in *DownloadCachePin, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/PinCachedDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) EvictCachedDownload(ctx context.Context, in *DownloadCacheDigest, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/EvictCachedDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jasperProcessManagerClient) GetBuildloggerURLs(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*BuildloggerURLs, error) {
 	out := new(BuildloggerURLs)
 	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetBuildloggerURLs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetLogs(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*LogLines, error) {
+	out := new(LogLines)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) QueryLogs(ctx context.Context, in *LogQuery, opts ...grpc.CallOption) (*LogRecords, error) {
+	out := new(LogRecords)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/QueryLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetProcessMetrics(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessMetrics, error) {
+	out := new(ProcessMetrics)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetProcessMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (JasperProcessManager_GetArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JasperProcessManager_serviceDesc.Streams[2], "/jasper.JasperProcessManager/GetArtifact", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerGetArtifactClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JasperProcessManager_GetArtifactClient interface {
+	Recv() (*ArtifactChunk, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerGetArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerGetArtifactClient) Recv() (*ArtifactChunk, error) {
+	m := new(ArtifactChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (JasperProcessManager_UploadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JasperProcessManager_serviceDesc.Streams[3], "/jasper.JasperProcessManager/UploadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerUploadFileClient{stream}
+	return x, nil
+}
+
+type JasperProcessManager_UploadFileClient interface {
+	Send(*FileTransferChunk) error
+	CloseAndRecv() (*OperationOutcome, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerUploadFileClient) Send(m *FileTransferChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *jasperProcessManagerUploadFileClient) CloseAndRecv() (*OperationOutcome, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(OperationOutcome)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) FetchFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (JasperProcessManager_FetchFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JasperProcessManager_serviceDesc.Streams[4], "/jasper.JasperProcessManager/FetchFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerFetchFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JasperProcessManager_FetchFileClient interface {
+	Recv() (*FileTransferChunk, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerFetchFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerFetchFileClient) Recv() (*FileTransferChunk, error) {
+	m := new(FileTransferChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 // JasperProcessManagerServer is the server API for JasperProcessManager service.
@@ -1719,326 +4645,701 @@ type JasperProcessManagerServer interface {
 	GetTags(context.Context, *JasperProcessID) (*ProcessTags, error)
 	DownloadFile(context.Context, *DownloadInfo) (*OperationOutcome, error)
 	DownloadMongoDB(context.Context, *MongoDBDownloadOptions) (*OperationOutcome, error)
+	ResolveMongoDB(context.Context, *MongoDBDownloadOptions) (*MongoDBReleases, error)
+	StartDownload(context.Context, *DownloadInfo) (*DownloadJobStatus, error)
+	ListDownloads(context.Context, *empty.Empty) (*DownloadJobStatuses, error)
+	GetDownload(context.Context, *DownloadJobID) (*DownloadJobStatus, error)
+	CancelDownload(context.Context, *DownloadJobID) (*OperationOutcome, error)
+	WaitDownload(context.Context, *DownloadJobID) (*DownloadJobStatus, error)
 	ConfigureCache(context.Context, *CacheOptions) (*OperationOutcome, error)
+	ConfigureDownloadSources(context.Context, *DownloadSources) (*OperationOutcome, error)
+	ConfigureDownloads(context.Context, *DownloadOptions) (*OperationOutcome, error)
+	GetDownloadStatus(context.Context, *empty.Empty) (*DownloadStatus, error)
+	ListCachedDownloads(context.Context, *empty.Empty) (*DownloadCacheEntries, error)
+	GetCachedDownload(context.Context, *DownloadCacheDigest) (*DownloadCacheEntry, error)
+	PinCachedDownload(context.Context, *DownloadCachePin) (*OperationOutcome, error)
+	EvictCachedDownload(context.Context, *DownloadCacheDigest) (*OperationOutcome, error)
 	GetBuildloggerURLs(context.Context, *JasperProcessID) (*BuildloggerURLs, error)
+	GetLogs(context.Context, *JasperProcessID) (*LogLines, error)
+	QueryLogs(context.Context, *LogQuery) (*LogRecords, error)
+	GetProcessMetrics(context.Context, *JasperProcessID) (*ProcessMetrics, error)
+	GetArtifact(*ArtifactRequest, JasperProcessManager_GetArtifactServer) error
+	UploadFile(JasperProcessManager_UploadFileServer) error
+	FetchFile(*FilePath, JasperProcessManager_FetchFileServer) error
 }
 
 func RegisterJasperProcessManagerServer(s *grpc.Server, srv JasperProcessManagerServer) {
 	s.RegisterService(&_JasperProcessManager_serviceDesc, srv)
 }
 
-func _JasperProcessManager_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _JasperProcessManager_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Status(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Create(ctx, req.(*CreateOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Filter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).List(m, &jasperProcessManagerListServer{stream})
+}
+
+type JasperProcessManager_ListServer interface {
+	Send(*ProcessInfo) error
+	grpc.ServerStream
+}
+
+type jasperProcessManagerListServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerListServer) Send(m *ProcessInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JasperProcessManager_Group_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TagName)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).Group(m, &jasperProcessManagerGroupServer{stream})
+}
+
+type JasperProcessManager_GroupServer interface {
+	Send(*ProcessInfo) error
+	grpc.ServerStream
+}
+
+type jasperProcessManagerGroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerGroupServer) Send(m *ProcessInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JasperProcessManager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Get(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Wait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Wait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Wait(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Respawn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Respawn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Respawn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Respawn(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalProcess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Signal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Signal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Signal(ctx, req.(*SignalProcess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Clear(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Close(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_TagProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessTags)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).TagProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/TagProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).TagProcess(ctx, req.(*ProcessTags))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_ResetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).ResetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/ResetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).ResetTags(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/GetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).GetTags(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_DownloadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).DownloadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/DownloadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).DownloadFile(ctx, req.(*DownloadInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_DownloadMongoDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MongoDBDownloadOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Status(ctx, in)
+		return srv.(JasperProcessManagerServer).DownloadMongoDB(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Status",
+		FullMethod: "/jasper.JasperProcessManager/DownloadMongoDB",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Status(ctx, req.(*empty.Empty))
+		return srv.(JasperProcessManagerServer).DownloadMongoDB(ctx, req.(*MongoDBDownloadOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOptions)
+func _JasperProcessManager_ResolveMongoDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MongoDBDownloadOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Create(ctx, in)
+		return srv.(JasperProcessManagerServer).ResolveMongoDB(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Create",
+		FullMethod: "/jasper.JasperProcessManager/ResolveMongoDB",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Create(ctx, req.(*CreateOptions))
+		return srv.(JasperProcessManagerServer).ResolveMongoDB(ctx, req.(*MongoDBDownloadOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_List_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Filter)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _JasperProcessManager_StartDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(JasperProcessManagerServer).List(m, &jasperProcessManagerListServer{stream})
-}
-
-type JasperProcessManager_ListServer interface {
-	Send(*ProcessInfo) error
-	grpc.ServerStream
-}
-
-type jasperProcessManagerListServer struct {
-	grpc.ServerStream
-}
-
-func (x *jasperProcessManagerListServer) Send(m *ProcessInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _JasperProcessManager_Group_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(TagName)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).StartDownload(ctx, in)
 	}
-	return srv.(JasperProcessManagerServer).Group(m, &jasperProcessManagerGroupServer{stream})
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/StartDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).StartDownload(ctx, req.(*DownloadInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type JasperProcessManager_GroupServer interface {
-	Send(*ProcessInfo) error
-	grpc.ServerStream
+func _JasperProcessManager_ListDownloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).ListDownloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/ListDownloads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).ListDownloads(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type jasperProcessManagerGroupServer struct {
-	grpc.ServerStream
+func _JasperProcessManager_GetDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadJobID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).GetDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/GetDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).GetDownload(ctx, req.(*DownloadJobID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *jasperProcessManagerGroupServer) Send(m *ProcessInfo) error {
-	return x.ServerStream.SendMsg(m)
+func _JasperProcessManager_CancelDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadJobID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).CancelDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/CancelDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).CancelDownload(ctx, req.(*DownloadJobID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JasperProcessID)
+func _JasperProcessManager_WaitDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadJobID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Get(ctx, in)
+		return srv.(JasperProcessManagerServer).WaitDownload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Get",
+		FullMethod: "/jasper.JasperProcessManager/WaitDownload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Get(ctx, req.(*JasperProcessID))
+		return srv.(JasperProcessManagerServer).WaitDownload(ctx, req.(*DownloadJobID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JasperProcessID)
+func _JasperProcessManager_ConfigureCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Wait(ctx, in)
+		return srv.(JasperProcessManagerServer).ConfigureCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Wait",
+		FullMethod: "/jasper.JasperProcessManager/ConfigureCache",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Wait(ctx, req.(*JasperProcessID))
+		return srv.(JasperProcessManagerServer).ConfigureCache(ctx, req.(*CacheOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_Respawn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JasperProcessID)
+func _JasperProcessManager_ConfigureDownloadSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadSources)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Respawn(ctx, in)
+		return srv.(JasperProcessManagerServer).ConfigureDownloadSources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Respawn",
+		FullMethod: "/jasper.JasperProcessManager/ConfigureDownloadSources",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Respawn(ctx, req.(*JasperProcessID))
+		return srv.(JasperProcessManagerServer).ConfigureDownloadSources(ctx, req.(*DownloadSources))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignalProcess)
+func _JasperProcessManager_ConfigureDownloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Signal(ctx, in)
+		return srv.(JasperProcessManagerServer).ConfigureDownloads(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Signal",
+		FullMethod: "/jasper.JasperProcessManager/ConfigureDownloads",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Signal(ctx, req.(*SignalProcess))
+		return srv.(JasperProcessManagerServer).ConfigureDownloads(ctx, req.(*DownloadOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _JasperProcessManager_GetDownloadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Clear(ctx, in)
+		return srv.(JasperProcessManagerServer).GetDownloadStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Clear",
+		FullMethod: "/jasper.JasperProcessManager/GetDownloadStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Clear(ctx, req.(*empty.Empty))
+		return srv.(JasperProcessManagerServer).GetDownloadStatus(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _JasperProcessManager_ListCachedDownloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).Close(ctx, in)
+		return srv.(JasperProcessManagerServer).ListCachedDownloads(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/Close",
+		FullMethod: "/jasper.JasperProcessManager/ListCachedDownloads",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).Close(ctx, req.(*empty.Empty))
+		return srv.(JasperProcessManagerServer).ListCachedDownloads(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_TagProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProcessTags)
+func _JasperProcessManager_GetCachedDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadCacheDigest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).TagProcess(ctx, in)
+		return srv.(JasperProcessManagerServer).GetCachedDownload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/TagProcess",
+		FullMethod: "/jasper.JasperProcessManager/GetCachedDownload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).TagProcess(ctx, req.(*ProcessTags))
+		return srv.(JasperProcessManagerServer).GetCachedDownload(ctx, req.(*DownloadCacheDigest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_ResetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JasperProcessID)
+func _JasperProcessManager_PinCachedDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadCachePin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).ResetTags(ctx, in)
+		return srv.(JasperProcessManagerServer).PinCachedDownload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/ResetTags",
+		FullMethod: "/jasper.JasperProcessManager/PinCachedDownload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).ResetTags(ctx, req.(*JasperProcessID))
+		return srv.(JasperProcessManagerServer).PinCachedDownload(ctx, req.(*DownloadCachePin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JasperProcessID)
+func _JasperProcessManager_EvictCachedDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadCacheDigest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).GetTags(ctx, in)
+		return srv.(JasperProcessManagerServer).EvictCachedDownload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/GetTags",
+		FullMethod: "/jasper.JasperProcessManager/EvictCachedDownload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).GetTags(ctx, req.(*JasperProcessID))
+		return srv.(JasperProcessManagerServer).EvictCachedDownload(ctx, req.(*DownloadCacheDigest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_DownloadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownloadInfo)
+func _JasperProcessManager_GetBuildloggerURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).DownloadFile(ctx, in)
+		return srv.(JasperProcessManagerServer).GetBuildloggerURLs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/DownloadFile",
+		FullMethod: "/jasper.JasperProcessManager/GetBuildloggerURLs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).DownloadFile(ctx, req.(*DownloadInfo))
+		return srv.(JasperProcessManagerServer).GetBuildloggerURLs(ctx, req.(*JasperProcessID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_DownloadMongoDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MongoDBDownloadOptions)
+func _JasperProcessManager_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).DownloadMongoDB(ctx, in)
+		return srv.(JasperProcessManagerServer).GetLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/DownloadMongoDB",
+		FullMethod: "/jasper.JasperProcessManager/GetLogs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).DownloadMongoDB(ctx, req.(*MongoDBDownloadOptions))
+		return srv.(JasperProcessManagerServer).GetLogs(ctx, req.(*JasperProcessID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_ConfigureCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CacheOptions)
+func _JasperProcessManager_QueryLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).ConfigureCache(ctx, in)
+		return srv.(JasperProcessManagerServer).QueryLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/ConfigureCache",
+		FullMethod: "/jasper.JasperProcessManager/QueryLogs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).ConfigureCache(ctx, req.(*CacheOptions))
+		return srv.(JasperProcessManagerServer).QueryLogs(ctx, req.(*LogQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JasperProcessManager_GetBuildloggerURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _JasperProcessManager_GetProcessMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JasperProcessID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JasperProcessManagerServer).GetBuildloggerURLs(ctx, in)
+		return srv.(JasperProcessManagerServer).GetProcessMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jasper.JasperProcessManager/GetBuildloggerURLs",
+		FullMethod: "/jasper.JasperProcessManager/GetProcessMetrics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JasperProcessManagerServer).GetBuildloggerURLs(ctx, req.(*JasperProcessID))
+		return srv.(JasperProcessManagerServer).GetProcessMetrics(ctx, req.(*JasperProcessID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JasperProcessManager_GetArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).GetArtifact(m, &jasperProcessManagerGetArtifactServer{stream})
+}
+
+type JasperProcessManager_GetArtifactServer interface {
+	Send(*ArtifactChunk) error
+	grpc.ServerStream
+}
+
+type jasperProcessManagerGetArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerGetArtifactServer) Send(m *ArtifactChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JasperProcessManager_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JasperProcessManagerServer).UploadFile(&jasperProcessManagerUploadFileServer{stream})
+}
+
+type JasperProcessManager_UploadFileServer interface {
+	SendAndClose(*OperationOutcome) error
+	Recv() (*FileTransferChunk, error)
+	grpc.ServerStream
+}
+
+type jasperProcessManagerUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerUploadFileServer) SendAndClose(m *OperationOutcome) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *jasperProcessManagerUploadFileServer) Recv() (*FileTransferChunk, error) {
+	m := new(FileTransferChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _JasperProcessManager_FetchFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FilePath)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).FetchFile(m, &jasperProcessManagerFetchFileServer{stream})
+}
+
+type JasperProcessManager_FetchFileServer interface {
+	Send(*FileTransferChunk) error
+	grpc.ServerStream
+}
+
+type jasperProcessManagerFetchFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerFetchFileServer) Send(m *FileTransferChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _JasperProcessManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "jasper.JasperProcessManager",
 	HandlerType: (*JasperProcessManagerServer)(nil),
@@ -2095,14 +5396,78 @@ var _JasperProcessManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DownloadMongoDB",
 			Handler:    _JasperProcessManager_DownloadMongoDB_Handler,
 		},
+		{
+			MethodName: "ResolveMongoDB",
+			Handler:    _JasperProcessManager_ResolveMongoDB_Handler,
+		},
+		{
+			MethodName: "StartDownload",
+			Handler:    _JasperProcessManager_StartDownload_Handler,
+		},
+		{
+			MethodName: "ListDownloads",
+			Handler:    _JasperProcessManager_ListDownloads_Handler,
+		},
+		{
+			MethodName: "GetDownload",
+			Handler:    _JasperProcessManager_GetDownload_Handler,
+		},
+		{
+			MethodName: "CancelDownload",
+			Handler:    _JasperProcessManager_CancelDownload_Handler,
+		},
+		{
+			MethodName: "WaitDownload",
+			Handler:    _JasperProcessManager_WaitDownload_Handler,
+		},
 		{
 			MethodName: "ConfigureCache",
 			Handler:    _JasperProcessManager_ConfigureCache_Handler,
 		},
+		{
+			MethodName: "ConfigureDownloadSources",
+			Handler:    _JasperProcessManager_ConfigureDownloadSources_Handler,
+		},
+		{
+			MethodName: "ConfigureDownloads",
+			Handler:    _JasperProcessManager_ConfigureDownloads_Handler,
+		},
+		{
+			MethodName: "GetDownloadStatus",
+			Handler:    _JasperProcessManager_GetDownloadStatus_Handler,
+		},
+		{
+			MethodName: "ListCachedDownloads",
+			Handler:    _JasperProcessManager_ListCachedDownloads_Handler,
+		},
+		{
+			MethodName: "GetCachedDownload",
+			Handler:    _JasperProcessManager_GetCachedDownload_Handler,
+		},
+		{
+			MethodName: "PinCachedDownload",
+			Handler:    _JasperProcessManager_PinCachedDownload_Handler,
+		},
+		{
+			MethodName: "EvictCachedDownload",
+			Handler:    _JasperProcessManager_EvictCachedDownload_Handler,
+		},
 		{
 			MethodName: "GetBuildloggerURLs",
 			Handler:    _JasperProcessManager_GetBuildloggerURLs_Handler,
 		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _JasperProcessManager_GetLogs_Handler,
+		},
+		{
+			MethodName: "QueryLogs",
+			Handler:    _JasperProcessManager_QueryLogs_Handler,
+		},
+		{
+			MethodName: "GetProcessMetrics",
+			Handler:    _JasperProcessManager_GetProcessMetrics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2115,134 +5480,322 @@ var _JasperProcessManager_serviceDesc = grpc.ServiceDesc{
 			Handler:       _JasperProcessManager_Group_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetArtifact",
+			Handler:       _JasperProcessManager_GetArtifact_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFile",
+			Handler:       _JasperProcessManager_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "FetchFile",
+			Handler:       _JasperProcessManager_FetchFile_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "jasper.proto",
 }
 
-func init() { proto.RegisterFile("jasper.proto", fileDescriptor_jasper_e87d9ea5412036d7) }
-
-var fileDescriptor_jasper_e87d9ea5412036d7 = []byte{
-	// 1950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x0f, 0x44, 0x0a, 0x24, 0x97, 0x22, 0x05, 0x5f, 0x14, 0x99, 0x55, 0x32, 0xb1, 0x86, 0x9d,
-	0xd4, 0x8a, 0x3a, 0x91, 0x53, 0x39, 0x9d, 0xc4, 0x69, 0xc7, 0x2d, 0x4d, 0x91, 0x34, 0x23, 0x88,
-	0x54, 0x41, 0xd2, 0x69, 0xdd, 0x69, 0x31, 0x47, 0xe2, 0x48, 0x21, 0x02, 0x71, 0x98, 0x03, 0x20,
-	0x4b, 0x79, 0xed, 0x43, 0xfb, 0x92, 0xa7, 0x7e, 0x85, 0x7e, 0x8d, 0x7e, 0xb2, 0xbe, 0x74, 0xee,
-	0x1f, 0xf8, 0xc7, 0x92, 0x3c, 0xf1, 0x93, 0x6e, 0x7f, 0xb7, 0xbb, 0xb7, 0xf8, 0xed, 0xde, 0xee,
-	0x51, 0xb0, 0xf5, 0x03, 0x8e, 0x23, 0xc2, 0x8e, 0x22, 0x46, 0x13, 0x8a, 0x4c, 0x29, 0xed, 0x7d,
-	0x3c, 0xa3, 0x74, 0x16, 0x90, 0x27, 0x02, 0x1d, 0xa7, 0xd3, 0x27, 0x64, 0x1e, 0x25, 0x37, 0x52,
-	0xa9, 0xee, 0x83, 0x69, 0xd3, 0xd9, 0x8c, 0x30, 0x74, 0x08, 0xc5, 0x80, 0xce, 0xdc, 0xe4, 0x26,
-	0x22, 0x35, 0x63, 0xdf, 0x38, 0xa8, 0x1e, 0x6f, 0x1f, 0x29, 0x7f, 0x36, 0x9d, 0x0d, 0x6f, 0x22,
-	0xe2, 0x14, 0x02, 0xb9, 0x40, 0x4f, 0xa1, 0xcc, 0x75, 0x69, 0x94, 0xf8, 0x34, 0x8c, 0x6b, 0x1b,
-	0xfb, 0xc6, 0x41, 0xf9, 0x18, 0x2d, 0xa9, 0xf7, 0xe5, 0x8e, 0x03, 0x41, 0xb6, 0xae, 0xff, 0xcf,
-	0x80, 0x4a, 0x3f, 0x4d, 0xa2, 0x34, 0x51, 0x08, 0x3a, 0x00, 0xee, 0x71, 0x46, 0x58, 0x5c, 0x33,
-	0xf6, 0x73, 0x07, 0xe5, 0xe3, 0xea, 0x92, 0x8b, 0x19, 0x61, 0x8e, 0xde, 0x46, 0x8f, 0x61, 0x3b,
-	0x4e, 0xa3, 0x88, 0x91, 0x38, 0x76, 0xa9, 0xf0, 0x21, 0x0e, 0x2d, 0x3a, 0x55, 0x0d, 0x4b, 0xcf,
-	0xe8, 0x33, 0xc8, 0x10, 0x97, 0x30, 0x46, 0x59, 0x2d, 0x27, 0xf4, 0x2a, 0x1a, 0x6d, 0x71, 0x10,
-	0x7d, 0x0d, 0x35, 0x46, 0x3c, 0x9f, 0x91, 0x49, 0xa2, 0xfc, 0xb9, 0x09, 0x55, 0x06, 0x79, 0x61,
-	0xf0, 0x91, 0xde, 0x97, 0x8e, 0x87, 0xf4, 0x6d, 0x43, 0xa1, 0xce, 0xed, 0x54, 0x44, 0x9b, 0xab,
-	0x86, 0xc2, 0x60, 0x48, 0xa5, 0x7d, 0xfd, 0xa7, 0x1c, 0xc0, 0x82, 0x18, 0xf4, 0x7b, 0xa8, 0x8e,
-	0xd3, 0xe9, 0x94, 0xb0, 0x8c, 0x44, 0x43, 0x90, 0xf8, 0x91, 0x66, 0xe0, 0x85, 0xd8, 0xd5, 0x3c,
-	0x56, 0xc6, 0xcb, 0x22, 0x3a, 0x85, 0x0f, 0xc7, 0xa9, 0x1f, 0x78, 0x92, 0x9e, 0xb5, 0x3c, 0xec,
-	0x2d, 0x5c, 0x64, 0x2a, 0xda, 0x0f, 0x1a, 0xbf, 0x85, 0x71, 0xca, 0x3c, 0x32, 0xc5, 0x69, 0x90,
-	0xb8, 0x11, 0x23, 0x53, 0xff, 0x5a, 0x50, 0x56, 0x72, 0x2a, 0x0a, 0x3d, 0x17, 0x20, 0xfa, 0x18,
-	0x4a, 0x53, 0x3f, 0x20, 0x6e, 0x88, 0xe7, 0x44, 0x70, 0x54, 0x72, 0x8a, 0x1c, 0xe8, 0xe1, 0x39,
-	0x41, 0x9f, 0x83, 0x39, 0xa5, 0x6c, 0x8e, 0x25, 0x09, 0xd5, 0xe3, 0x07, 0x4b, 0x89, 0x6c, 0x8b,
-	0x0d, 0x47, 0x29, 0xa0, 0x3a, 0x54, 0xfc, 0xd0, 0x9d, 0x93, 0x39, 0x65, 0x37, 0xee, 0x04, 0x47,
-	0x35, 0x73, 0xdf, 0x38, 0xc8, 0x39, 0x65, 0x3f, 0x3c, 0x13, 0x58, 0x13, 0x47, 0x9c, 0x9d, 0x38,
-	0x0a, 0xd2, 0xf0, 0x32, 0xfb, 0xb4, 0xc2, 0x2a, 0x3b, 0x03, 0xb1, 0x9b, 0xb1, 0x13, 0x2f, 0x8b,
-	0xe8, 0x97, 0x50, 0x89, 0xd3, 0x39, 0x75, 0x49, 0xe8, 0x45, 0xd4, 0x0f, 0x93, 0x5a, 0x51, 0x44,
-	0xbb, 0xc5, 0xc1, 0x96, 0xc2, 0xea, 0x63, 0xa8, 0xac, 0x50, 0x8c, 0xf6, 0xa0, 0x28, 0x49, 0x26,
-	0x9e, 0xc8, 0x45, 0xd1, 0xc9, 0x64, 0xbe, 0xe7, 0xa5, 0x0c, 0x73, 0x45, 0x41, 0x72, 0xce, 0xc9,
-	0x64, 0xf4, 0x0b, 0x28, 0xce, 0xf1, 0xb5, 0x1b, 0xfb, 0x3f, 0x12, 0x41, 0x5c, 0xce, 0x29, 0xcc,
-	0xf1, 0xf5, 0xc0, 0xff, 0x91, 0xd4, 0xff, 0x6b, 0x00, 0x7a, 0x3b, 0x09, 0xe8, 0x11, 0x94, 0x27,
-	0x8c, 0xe0, 0x84, 0xb8, 0x09, 0x89, 0x13, 0x75, 0x18, 0x48, 0x68, 0x48, 0xe2, 0x04, 0x59, 0x90,
-	0x4b, 0x59, 0x20, 0x4e, 0x2a, 0x39, 0x7c, 0x89, 0x76, 0xc1, 0x0c, 0xd3, 0xf9, 0x98, 0x30, 0x75,
-	0x84, 0x92, 0xd0, 0x0e, 0x6c, 0x46, 0x17, 0x38, 0xd6, 0x09, 0x91, 0x02, 0xaa, 0x41, 0x41, 0xe4,
-	0x99, 0x30, 0x91, 0x8e, 0x92, 0xa3, 0x45, 0x84, 0x20, 0x2f, 0xce, 0x34, 0x05, 0x2c, 0xd6, 0x5c,
-	0x7b, 0x42, 0xe7, 0x73, 0x1c, 0x7a, 0x82, 0xe5, 0x92, 0xa3, 0xc5, 0xfa, 0x9f, 0xa0, 0xb2, 0x42,
-	0xb4, 0x0e, 0xcc, 0x58, 0x04, 0xb6, 0x03, 0x9b, 0x09, 0xbd, 0x24, 0xa1, 0x0a, 0x56, 0x0a, 0xc2,
-	0xe5, 0x05, 0x0e, 0x43, 0x12, 0xa8, 0x5a, 0xd2, 0x62, 0xfd, 0xdf, 0x79, 0xa8, 0x34, 0xc5, 0x97,
-	0x6a, 0x9f, 0x08, 0xf2, 0x98, 0xcd, 0x64, 0x07, 0x28, 0x39, 0x62, 0x8d, 0x7e, 0x0d, 0x0f, 0xde,
-	0x50, 0x76, 0xe9, 0x87, 0x33, 0x57, 0xde, 0x25, 0xca, 0x6e, 0xd4, 0x09, 0x96, 0xda, 0x38, 0xd1,
-	0x38, 0x7a, 0x09, 0x65, 0x12, 0x5e, 0xf9, 0x8c, 0x86, 0x73, 0x12, 0x26, 0xb5, 0x9c, 0xe8, 0x24,
-	0xbf, 0xd2, 0x95, 0xb2, 0x72, 0xd8, 0x51, 0x6b, 0xa1, 0xd8, 0x0a, 0x13, 0x76, 0xe3, 0x2c, 0x9b,
-	0xa2, 0xcf, 0xc1, 0xa2, 0x57, 0x84, 0x31, 0xdf, 0x23, 0xae, 0xc2, 0x55, 0x37, 0xd8, 0xd6, 0xb8,
-	0x72, 0xc0, 0x1b, 0x52, 0xe2, 0xcf, 0x09, 0x4d, 0x13, 0x37, 0x26, 0x13, 0x1a, 0x7a, 0xb1, 0xa0,
-	0x3a, 0xe7, 0x54, 0x15, 0x3c, 0x90, 0xa8, 0x60, 0x1c, 0xcf, 0xe2, 0x9a, 0x29, 0x3f, 0x8f, 0xaf,
-	0xd1, 0x57, 0x00, 0x34, 0x74, 0xe3, 0x74, 0x32, 0x21, 0x31, 0x2f, 0xed, 0xdc, 0x72, 0x69, 0xaf,
-	0x04, 0xec, 0x94, 0x68, 0x38, 0x90, 0x7a, 0xca, 0x6a, 0x8a, 0xfd, 0x20, 0x65, 0xa4, 0x56, 0x7c,
-	0x87, 0x55, 0x5b, 0xea, 0x29, 0x2b, 0x15, 0x54, 0xad, 0xf4, 0x0e, 0xab, 0xa1, 0xd4, 0x43, 0x5f,
-	0x80, 0xa9, 0x9a, 0x1a, 0xac, 0x5e, 0xbc, 0x95, 0x06, 0xee, 0x28, 0xa5, 0xbd, 0xe7, 0x60, 0xad,
-	0x33, 0xcb, 0x6b, 0xe5, 0x92, 0xdc, 0xe8, 0x5a, 0xb9, 0x24, 0x37, 0xbc, 0x56, 0xae, 0x70, 0x90,
-	0x12, 0x5d, 0x2b, 0x42, 0xf8, 0x76, 0xe3, 0x1b, 0xa3, 0xfe, 0xaf, 0x0d, 0x28, 0x9f, 0x33, 0xca,
-	0x3f, 0xb3, 0x1b, 0x4e, 0x29, 0xaa, 0xc2, 0x86, 0xef, 0x29, 0xd3, 0x0d, 0xdf, 0xe3, 0xbe, 0x22,
-	0xdf, 0x53, 0x57, 0x8f, 0x2f, 0xd1, 0x43, 0x28, 0x5c, 0xd0, 0x38, 0x71, 0x7d, 0x4f, 0x55, 0x98,
-	0xc9, 0xc5, 0xae, 0xc7, 0x4b, 0x8f, 0xa5, 0x61, 0xe8, 0x87, 0x33, 0x95, 0x3a, 0x2d, 0xa2, 0x4f,
-	0x01, 0x14, 0xe5, 0xd3, 0x34, 0x50, 0xcd, 0x7a, 0x09, 0xe1, 0x97, 0x7c, 0x42, 0xe7, 0x51, 0x40,
-	0x12, 0x22, 0xee, 0x47, 0xd1, 0xc9, 0x64, 0xbe, 0xc7, 0x29, 0xf4, 0x38, 0x87, 0x05, 0xb9, 0xa7,
-	0x65, 0xf4, 0x04, 0x0a, 0xba, 0x4b, 0x15, 0x57, 0xc9, 0x5a, 0xa5, 0x57, 0x6b, 0xf1, 0x4e, 0x4a,
-	0xae, 0xfd, 0xc4, 0x9d, 0x50, 0x8f, 0xd4, 0x4a, 0xfb, 0xc6, 0xc1, 0xa6, 0x53, 0xe4, 0x40, 0x93,
-	0x7a, 0xa4, 0xde, 0x80, 0xea, 0x20, 0xc1, 0x49, 0x1a, 0x3b, 0x24, 0x8e, 0x68, 0x18, 0x93, 0xe5,
-	0x4f, 0x35, 0x56, 0x3e, 0x75, 0x17, 0x4c, 0x3c, 0x49, 0xfc, 0x2b, 0xa2, 0x66, 0xa1, 0x92, 0xea,
-	0xdf, 0x82, 0xd9, 0xf6, 0x83, 0x84, 0x30, 0xf4, 0x25, 0xe4, 0x45, 0xbb, 0x96, 0xf3, 0xfc, 0x13,
-	0x1d, 0x97, 0xdc, 0x1d, 0x44, 0x64, 0xe2, 0x4f, 0xfd, 0x09, 0x96, 0xe1, 0x09, 0xcd, 0x3a, 0x85,
-	0xca, 0xc0, 0x9f, 0x85, 0x38, 0x50, 0xe9, 0x40, 0xbf, 0x85, 0x92, 0xce, 0xcc, 0x89, 0x9a, 0x51,
-	0x0f, 0xb5, 0x9f, 0xef, 0xc4, 0x9f, 0x6c, 0xdb, 0x59, 0x68, 0xa2, 0xc7, 0x60, 0xc6, 0xc2, 0x8f,
-	0x88, 0x6d, 0xe9, 0x2d, 0x21, 0xbd, 0xc7, 0x8e, 0xda, 0xae, 0x3f, 0x82, 0xc2, 0x10, 0xcf, 0xc4,
-	0x10, 0xc9, 0xea, 0xc3, 0x58, 0xaa, 0x8f, 0xfa, 0x1f, 0xb2, 0xd2, 0x18, 0xf2, 0xbb, 0xf3, 0x09,
-	0x94, 0xa2, 0x95, 0x78, 0x4a, 0xce, 0x02, 0xc8, 0x6e, 0xdb, 0xc6, 0xe2, 0xb6, 0xd5, 0x1f, 0xc3,
-	0xf6, 0x5a, 0xa0, 0x77, 0x9c, 0xf4, 0x37, 0xb0, 0xfa, 0x11, 0x91, 0x6d, 0xbd, 0x9f, 0x26, 0x13,
-	0x3a, 0x17, 0xad, 0x54, 0xdf, 0x53, 0xd9, 0xa7, 0xb5, 0x28, 0x5b, 0xe9, 0x75, 0xa2, 0x8a, 0x59,
-	0xac, 0x57, 0x33, 0x9b, 0x5b, 0xcb, 0xec, 0x0f, 0xb0, 0x25, 0x86, 0x81, 0x6e, 0x7c, 0xbb, 0x60,
-	0x26, 0x98, 0xcd, 0x48, 0xa2, 0xd3, 0x2a, 0x25, 0xd9, 0x10, 0x27, 0x17, 0xda, 0x31, 0x5f, 0xf3,
-	0x30, 0x88, 0xe7, 0x8b, 0xf9, 0xa3, 0x1a, 0xaa, 0x12, 0xf9, 0xa7, 0x78, 0x64, 0x9c, 0xea, 0x6a,
-	0x97, 0x42, 0xfd, 0x1f, 0x06, 0xec, 0x9e, 0xd1, 0x70, 0x46, 0x4f, 0x5e, 0x9c, 0xd0, 0x37, 0x61,
-	0x40, 0x71, 0x76, 0xec, 0x33, 0xa8, 0x88, 0x69, 0xb0, 0xf6, 0xf0, 0xd8, 0x59, 0x79, 0x35, 0xe8,
-	0x9a, 0xdd, 0x1a, 0x2f, 0x47, 0x8c, 0x20, 0x1f, 0xe1, 0x24, 0x8b, 0x8c, 0xaf, 0xf9, 0xcd, 0x60,
-	0x24, 0x20, 0x38, 0x26, 0xb1, 0x68, 0xbd, 0x25, 0x27, 0x93, 0xeb, 0x53, 0xd8, 0x6a, 0xe2, 0xc9,
-	0x05, 0x59, 0x1a, 0xb1, 0x9e, 0x1f, 0xe3, 0x71, 0xb0, 0x18, 0xb1, 0x5a, 0xe6, 0x43, 0x31, 0x62,
-	0x69, 0x48, 0x5c, 0x8f, 0x04, 0xf8, 0x46, 0x5d, 0x75, 0x10, 0xd0, 0x09, 0x47, 0xee, 0x9b, 0xb3,
-	0xff, 0x34, 0xa0, 0xda, 0x60, 0x93, 0x0b, 0xff, 0x8a, 0x2c, 0x3d, 0x6a, 0xe2, 0x0b, 0x9a, 0x06,
-	0x9e, 0x4b, 0xae, 0x13, 0x86, 0x27, 0x7a, 0xcc, 0x56, 0x24, 0xda, 0x92, 0x20, 0xef, 0x73, 0xea,
-	0xdd, 0x22, 0xcb, 0x34, 0xbb, 0xba, 0xca, 0xdd, 0xda, 0xdb, 0xe5, 0x11, 0x94, 0x65, 0x92, 0x5c,
-	0xc1, 0x83, 0x4c, 0x05, 0x48, 0xe8, 0x1c, 0x27, 0x17, 0x75, 0x0a, 0x5b, 0x9a, 0x6f, 0xd1, 0xc8,
-	0xde, 0x1e, 0x98, 0xb7, 0x71, 0xf8, 0x0c, 0xb6, 0xb0, 0x3c, 0x8f, 0x27, 0x25, 0x16, 0x7e, 0xcb,
-	0xc7, 0xbb, 0x6b, 0xb1, 0xe8, 0x9c, 0x94, 0x71, 0x26, 0xc7, 0xf5, 0xcf, 0x60, 0x7b, 0xe9, 0x85,
-	0x31, 0x72, 0x6c, 0x91, 0xa5, 0x94, 0x05, 0xd9, 0x40, 0xe5, 0xeb, 0xc3, 0xff, 0x18, 0x50, 0x50,
-	0xaf, 0x78, 0x54, 0x05, 0xb0, 0xfb, 0x9d, 0x51, 0xef, 0xb4, 0xd7, 0xff, 0xbe, 0x67, 0x7d, 0x80,
-	0x76, 0xc0, 0xb2, 0xfb, 0x9d, 0x17, 0xa3, 0xae, 0x7d, 0x62, 0xf7, 0x3b, 0x9d, 0x96, 0xf3, 0xea,
-	0xd8, 0x32, 0x6e, 0x41, 0x9f, 0x5a, 0x1b, 0xca, 0xf6, 0xa4, 0xd5, 0x6e, 0x8c, 0xec, 0xa1, 0x95,
-	0x43, 0x65, 0x28, 0xd8, 0xfd, 0x4e, 0xbb, 0x6b, 0xb7, 0xac, 0xbc, 0xda, 0xec, 0xf6, 0x5e, 0xb6,
-	0x9c, 0xee, 0xd0, 0xda, 0x44, 0x15, 0x28, 0xd9, 0xfd, 0xce, 0xe0, 0xdc, 0x1e, 0xf5, 0x4e, 0x2d,
-	0x13, 0x59, 0xb0, 0xc5, 0xc5, 0xd1, 0x59, 0x9f, 0x6b, 0x35, 0xad, 0x02, 0xda, 0x86, 0xb2, 0x30,
-	0x38, 0x6b, 0x9d, 0xf5, 0x9d, 0xbf, 0x58, 0xc5, 0xc3, 0xbf, 0x43, 0x29, 0x7b, 0x30, 0xaa, 0x08,
-	0xda, 0x7d, 0xe7, 0xac, 0x31, 0x5c, 0x8f, 0x56, 0xa2, 0x3a, 0x0e, 0x03, 0x3d, 0x80, 0x4a, 0x86,
-	0x7e, 0x37, 0xe8, 0xf7, 0xac, 0x0d, 0x84, 0xa0, 0x9a, 0x41, 0xe7, 0x76, 0xa3, 0xdb, 0xb3, 0x72,
-	0x87, 0x7f, 0x86, 0x9d, 0xdb, 0x7a, 0x1f, 0x2a, 0x40, 0xae, 0x61, 0xdb, 0xd6, 0x07, 0xfc, 0x7b,
-	0x9c, 0x51, 0xaf, 0xd7, 0xed, 0x75, 0x2c, 0x83, 0x7f, 0xcf, 0xb0, 0xe5, 0x9c, 0x75, 0x7b, 0x8d,
-	0x61, 0xeb, 0xc4, 0xda, 0x40, 0x00, 0x66, 0xbb, 0xd1, 0xb5, 0x5b, 0x27, 0x56, 0x8e, 0xef, 0x0d,
-	0x46, 0xcd, 0x66, 0x6b, 0x30, 0x68, 0x8f, 0x6c, 0x2b, 0x7f, 0xf8, 0x57, 0x28, 0xa8, 0xce, 0xc6,
-	0x7d, 0x2c, 0xc2, 0xad, 0x40, 0x29, 0xf3, 0x61, 0x19, 0xa8, 0x08, 0xf9, 0xd3, 0xae, 0x6d, 0x4b,
-	0x67, 0x2f, 0x1b, 0xbd, 0xce, 0xe8, 0xdc, 0xca, 0x71, 0xb4, 0xdb, 0xeb, 0x0e, 0xad, 0x3c, 0x2a,
-	0xc1, 0xe6, 0x68, 0xd0, 0x72, 0x7e, 0x63, 0x6d, 0xea, 0xe5, 0xb1, 0x65, 0x1e, 0xbe, 0x82, 0xca,
-	0x4a, 0x3d, 0xf2, 0x6f, 0x6b, 0x38, 0xcd, 0x97, 0xdd, 0x57, 0xad, 0xc5, 0x49, 0xdb, 0x50, 0x56,
-	0x58, 0x63, 0x34, 0xec, 0x5b, 0x06, 0xe7, 0x5b, 0x01, 0xc3, 0x86, 0xd3, 0x79, 0x2d, 0xb3, 0xa7,
-	0x90, 0xd7, 0xdd, 0x73, 0x2b, 0x77, 0xfc, 0x53, 0x11, 0x76, 0x56, 0x5a, 0xe3, 0x19, 0x0e, 0x31,
-	0xff, 0x2d, 0xf8, 0x0d, 0x98, 0x72, 0x08, 0xa1, 0xdd, 0x23, 0xf9, 0xeb, 0xf1, 0x48, 0xff, 0x7a,
-	0x3c, 0x6a, 0xf1, 0x5f, 0x8f, 0x7b, 0x59, 0x71, 0xae, 0x0d, 0xab, 0xaf, 0xc0, 0x94, 0x53, 0x0f,
-	0xdd, 0x3e, 0x05, 0xf7, 0x3e, 0xd4, 0xf0, 0xf2, 0xbc, 0xff, 0x02, 0xf2, 0xb6, 0x1f, 0x27, 0xa8,
-	0xba, 0x3a, 0xa1, 0x6e, 0x55, 0xfe, 0xd2, 0x40, 0x4f, 0x60, 0xb3, 0xc3, 0x68, 0x1a, 0xa1, 0x6c,
-	0xaa, 0xa8, 0x11, 0x72, 0x97, 0xc1, 0x53, 0xc8, 0x75, 0x48, 0x82, 0xee, 0x1a, 0x5c, 0xb7, 0x07,
-	0xf5, 0x0c, 0xf2, 0xdf, 0x63, 0xff, 0x1e, 0xab, 0x5a, 0xf6, 0x28, 0x5a, 0x9f, 0x1a, 0x5f, 0x43,
-	0x81, 0x33, 0x82, 0xdf, 0x84, 0x3f, 0xfb, 0x4c, 0x53, 0x96, 0xd1, 0x82, 0xbe, 0x95, 0x71, 0x7c,
-	0xcf, 0x99, 0xcf, 0x60, 0xb3, 0x19, 0x10, 0xcc, 0xee, 0x4c, 0xd9, 0x3b, 0x4c, 0x69, 0x4c, 0xde,
-	0xc3, 0xf4, 0x77, 0x00, 0x43, 0x3c, 0xd3, 0x8f, 0x85, 0xf5, 0x6f, 0xe2, 0x13, 0xfb, 0x1e, 0xe3,
-	0xe7, 0x50, 0x72, 0x48, 0x4c, 0x12, 0x31, 0xd8, 0xdf, 0x8f, 0xe6, 0xce, 0xbb, 0xac, 0x6f, 0x0b,
-	0x09, 0x3d, 0x5f, 0xb4, 0xe9, 0xb6, 0x1f, 0x10, 0x94, 0x0d, 0xbf, 0xe5, 0xe6, 0x7d, 0xcf, 0xc1,
-	0xa7, 0xb0, 0xad, 0x35, 0xd5, 0x94, 0x45, 0x9f, 0x6a, 0xe5, 0xdb, 0xc7, 0xee, 0x3d, 0xce, 0xfe,
-	0x08, 0xd5, 0x26, 0x0d, 0xa7, 0xfe, 0x2c, 0x65, 0x44, 0x8c, 0xcb, 0x45, 0x38, 0xcb, 0xd3, 0xf3,
-	0x1e, 0x0f, 0x6d, 0x40, 0x1d, 0x92, 0xac, 0xcf, 0x81, 0x3b, 0x29, 0x79, 0x78, 0xcb, 0x3f, 0x08,
-	0xb8, 0xc5, 0x0b, 0x78, 0x5d, 0xf4, 0xc3, 0x84, 0xb0, 0x10, 0x07, 0x63, 0x53, 0x94, 0xc0, 0xd3,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x82, 0xde, 0xbe, 0xa3, 0x54, 0x12, 0x00, 0x00,
+func init() { proto.RegisterFile("jasper.proto", fileDescriptor_jasper_358a25047a98df41) }
+
+var fileDescriptor_jasper_358a25047a98df41 = []byte{
+	// 4712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x7a, 0x4b, 0x73, 0x23, 0x47,
+	0x72, 0xb0, 0x1a, 0x00, 0xf1, 0x48, 0x3c, 0xd8, 0xac, 0x19, 0x8d, 0x20, 0x4a, 0xbb, 0x9a, 0xaf,
+	0x15, 0xfa, 0x34, 0x9a, 0xb5, 0x28, 0x89, 0x92, 0x76, 0x35, 0xb3, 0xb6, 0x56, 0x18, 0x12, 0xe4,
+	0x60, 0x06, 0x04, 0xb8, 0x45, 0x50, 0x8f, 0x59, 0x3b, 0x10, 0xcd, 0xee, 0x02, 0xd8, 0x4b, 0xa0,
+	0xbb, 0xb7, 0xba, 0xc1, 0x19, 0xea, 0xb6, 0x27, 0x87, 0xcf, 0xbe, 0xec, 0xc5, 0x7b, 0xb1, 0xc3,
+	0x17, 0x9f, 0x7c, 0xd8, 0x70, 0x38, 0xfc, 0xb8, 0xef, 0x7f, 0xf0, 0xd1, 0xe1, 0xdf, 0xe1, 0xc8,
+	0x7a, 0xf4, 0x03, 0x20, 0x38, 0x9a, 0xf5, 0x09, 0x95, 0x59, 0x99, 0xd9, 0x59, 0x59, 0x99, 0x59,
+	0x59, 0x59, 0x80, 0xc6, 0xaf, 0xed, 0x28, 0x64, 0x7c, 0x27, 0xe4, 0x41, 0x1c, 0x90, 0xb2, 0x84,
+	0xb6, 0xdf, 0x9a, 0x06, 0xc1, 0x74, 0xc6, 0x3e, 0x12, 0xd8, 0xb3, 0xc5, 0xe4, 0x23, 0x36, 0x0f,
+	0xe3, 0x2b, 0x49, 0x64, 0xfd, 0x9d, 0x01, 0xe5, 0x7e, 0x30, 0x9d, 0x32, 0x4e, 0xee, 0x43, 0x75,
+	0x16, 0x4c, 0xc7, 0xf1, 0x55, 0xc8, 0xda, 0xc6, 0x5d, 0xe3, 0x5e, 0x6b, 0x77, 0x73, 0x47, 0x09,
+	0xec, 0x07, 0xd3, 0xd1, 0x55, 0xc8, 0x68, 0x65, 0x26, 0x07, 0xe4, 0x53, 0xa8, 0x23, 0x6d, 0x10,
+	0xc6, 0x5e, 0xe0, 0x47, 0xed, 0xc2, 0x5d, 0xe3, 0x5e, 0x7d, 0x97, 0x64, 0xc8, 0x87, 0x72, 0x86,
+	0xc2, 0x2c, 0x19, 0x93, 0x5d, 0xa8, 0x44, 0x31, 0x67, 0xf6, 0x3c, 0x6a, 0x17, 0xef, 0x16, 0xef,
+	0xd5, 0x77, 0xdb, 0x19, 0x86, 0x13, 0x31, 0xa3, 0xd9, 0x34, 0xa1, 0x75, 0x01, 0xe6, 0xf2, 0x24,
+	0xf9, 0x00, 0xca, 0x72, 0x5a, 0xa9, 0xb9, 0xb5, 0x22, 0x86, 0x2a, 0x02, 0x72, 0x07, 0xca, 0x21,
+	0x67, 0x13, 0xef, 0x85, 0x50, 0xb1, 0x46, 0x15, 0x44, 0x6e, 0xc3, 0xc6, 0x8c, 0x5d, 0xb2, 0x59,
+	0xbb, 0x78, 0xd7, 0xb8, 0xb7, 0x41, 0x25, 0x60, 0xfd, 0x7d, 0x11, 0x9a, 0xc3, 0x45, 0x1c, 0x2e,
+	0x62, 0xfd, 0xa9, 0x7b, 0x80, 0x4b, 0x9e, 0x32, 0x1e, 0xb5, 0x0d, 0xa1, 0x72, 0x2b, 0xf3, 0xad,
+	0x29, 0xe3, 0x54, 0x4f, 0x93, 0xf7, 0x61, 0x33, 0x5a, 0x84, 0x21, 0x67, 0x51, 0x34, 0x0e, 0x84,
+	0x0c, 0xf1, 0xc9, 0x2a, 0x6d, 0x69, 0xb4, 0x94, 0x4c, 0xde, 0x83, 0x04, 0x33, 0x66, 0x9c, 0x07,
+	0x5c, 0xe8, 0x50, 0xa5, 0x4d, 0x8d, 0xed, 0x22, 0x92, 0xfc, 0x0c, 0xda, 0x9c, 0xb9, 0x1e, 0x67,
+	0x4e, 0xac, 0xe4, 0x8d, 0xe3, 0x40, 0x31, 0x94, 0x04, 0xc3, 0xeb, 0x7a, 0x5e, 0x0a, 0x1e, 0x05,
+	0xab, 0x8c, 0x82, 0x1c, 0xf9, 0x94, 0x46, 0x1b, 0x79, 0x46, 0xc1, 0x30, 0x0a, 0x94, 0x62, 0x3f,
+	0x85, 0x1a, 0x67, 0xae, 0xed, 0xe0, 0xca, 0xdb, 0xe5, 0xbb, 0x46, 0x76, 0x83, 0xa8, 0x9e, 0xd0,
+	0x1b, 0x94, 0x92, 0x92, 0x87, 0x50, 0x9f, 0x79, 0x3e, 0x1b, 0x9f, 0x2d, 0x26, 0x13, 0xc6, 0xdb,
+	0x15, 0xc1, 0xf9, 0x66, 0x62, 0x27, 0xcf, 0x67, 0x8f, 0xc4, 0x4c, 0xea, 0x12, 0x09, 0x8a, 0xec,
+	0x42, 0x79, 0xe6, 0xcd, 0xbd, 0x38, 0x6a, 0x57, 0x05, 0xdb, 0xb6, 0x66, 0x93, 0x3a, 0xf5, 0x71,
+	0x4e, 0xf3, 0x29, 0x4a, 0xcb, 0x05, 0xb2, 0x3a, 0x4b, 0xde, 0x82, 0xda, 0xdc, 0x7e, 0x31, 0x3e,
+	0xbb, 0x8a, 0x59, 0x24, 0xfc, 0xa2, 0x48, 0xab, 0x73, 0xfb, 0xc5, 0x23, 0x84, 0xc9, 0x27, 0x50,
+	0x0e, 0x83, 0x99, 0xe7, 0x5c, 0x89, 0x3d, 0x69, 0xa5, 0xda, 0x65, 0x04, 0x1d, 0x0b, 0x02, 0xaa,
+	0x08, 0xad, 0x10, 0xb6, 0x56, 0x54, 0x27, 0x6d, 0xa8, 0x30, 0xdf, 0x3e, 0x9b, 0x31, 0x57, 0x7c,
+	0xa2, 0x4a, 0x35, 0xb8, 0xd6, 0xd1, 0x3e, 0x00, 0x33, 0xf6, 0xe6, 0x2c, 0x8a, 0xed, 0x79, 0x38,
+	0x9e, 0x04, 0x7c, 0x6e, 0xc7, 0x62, 0xbf, 0x6b, 0x74, 0x33, 0xc1, 0x1f, 0x08, 0xb4, 0xc5, 0xc0,
+	0x5c, 0x36, 0x33, 0xd9, 0x86, 0xea, 0xcc, 0x8b, 0x19, 0xb7, 0x67, 0xd2, 0x01, 0x6b, 0x34, 0x81,
+	0x71, 0x2e, 0xb4, 0xe3, 0x98, 0x71, 0x11, 0x80, 0x62, 0x4e, 0xc3, 0xe4, 0x4d, 0xa8, 0x32, 0xff,
+	0x72, 0x7c, 0x69, 0x73, 0x19, 0x6b, 0x35, 0xd4, 0xf4, 0xf2, 0x6b, 0x9b, 0x47, 0xd6, 0x1f, 0x36,
+	0x00, 0xd2, 0x00, 0x25, 0x7f, 0x0e, 0x2d, 0xb9, 0x71, 0x49, 0x30, 0x1b, 0x62, 0x27, 0x5e, 0xd7,
+	0x26, 0xca, 0x6f, 0x5e, 0xf3, 0x2c, 0x0b, 0x92, 0xa7, 0x70, 0xeb, 0x6c, 0xe1, 0xcd, 0x5c, 0x19,
+	0x05, 0x4b, 0xf9, 0x60, 0x3b, 0x15, 0x91, 0x90, 0x68, 0x39, 0xe4, 0x6c, 0x05, 0x87, 0x91, 0xe1,
+	0xb2, 0x89, 0xbd, 0x98, 0xc5, 0x63, 0x65, 0x4b, 0x69, 0xa9, 0xa6, 0xc2, 0x1e, 0x4b, 0x93, 0xbe,
+	0x05, 0xb5, 0x89, 0x37, 0x63, 0x63, 0xdf, 0x9e, 0x33, 0x11, 0x0a, 0x35, 0x5a, 0x45, 0xc4, 0xc0,
+	0x9e, 0x33, 0xcc, 0x0d, 0xca, 0xca, 0x1b, 0x2b, 0xb9, 0x41, 0xda, 0x99, 0x2a, 0x02, 0x62, 0x41,
+	0xd3, 0xf3, 0xc7, 0x73, 0x36, 0x0f, 0xf8, 0xd5, 0xd8, 0xb1, 0x43, 0xe1, 0xf3, 0x45, 0x5a, 0xf7,
+	0xfc, 0x23, 0x81, 0xdb, 0xb3, 0x43, 0xb4, 0x4e, 0x14, 0xce, 0x16, 0xfe, 0x45, 0xb2, 0xb4, 0x4a,
+	0xde, 0x3a, 0x27, 0x62, 0x36, 0xb1, 0x4e, 0x94, 0x05, 0xc9, 0xbb, 0xd0, 0x8c, 0x16, 0xf3, 0x60,
+	0xcc, 0x7c, 0x37, 0x0c, 0x3c, 0x3f, 0x16, 0x4e, 0x5e, 0xa3, 0x0d, 0x44, 0x76, 0x15, 0x8e, 0x3c,
+	0x02, 0x93, 0x07, 0xb1, 0x8d, 0x1c, 0xc9, 0x47, 0x6a, 0xe2, 0x23, 0x6f, 0x24, 0xd1, 0xa7, 0xe6,
+	0xf5, 0x67, 0x36, 0x79, 0x1e, 0x91, 0x84, 0x60, 0x68, 0xf3, 0x88, 0xf1, 0x36, 0xac, 0x86, 0xe0,
+	0xb1, 0x98, 0xc9, 0x85, 0xa0, 0x44, 0x91, 0x0f, 0xe1, 0x56, 0x6a, 0x86, 0x34, 0x84, 0xea, 0xc2,
+	0x18, 0xa6, 0x36, 0xc6, 0x91, 0x0e, 0x25, 0xb4, 0xc8, 0x55, 0x94, 0x4d, 0xfe, 0x8d, 0x25, 0x8b,
+	0x88, 0xd9, 0xd4, 0x22, 0x59, 0x90, 0x3c, 0x80, 0xc6, 0x79, 0x1c, 0x87, 0x09, 0x6f, 0x53, 0xf0,
+	0xde, 0xd1, 0xbc, 0x8f, 0x47, 0xa3, 0xe3, 0xcc, 0xe1, 0x51, 0x47, 0x5a, 0x05, 0x58, 0x7f, 0x2c,
+	0x42, 0x2b, 0x3f, 0x4f, 0x4c, 0x28, 0x2e, 0xf8, 0x4c, 0x38, 0x6c, 0x8d, 0xe2, 0x90, 0xfc, 0x05,
+	0x54, 0xce, 0x99, 0xed, 0x32, 0x2e, 0x43, 0xa2, 0xbe, 0xfb, 0xee, 0xf5, 0xa2, 0x77, 0x1e, 0x4b,
+	0xaa, 0xae, 0x1f, 0xf3, 0x2b, 0xaa, 0x79, 0x30, 0xa4, 0x16, 0x11, 0xe3, 0xc2, 0xb3, 0xa4, 0xef,
+	0x25, 0xb0, 0x0c, 0xb7, 0x28, 0x7a, 0x1e, 0x70, 0x57, 0x7b, 0x9d, 0x86, 0xd1, 0x25, 0xcf, 0xec,
+	0xd8, 0x39, 0x1f, 0x5f, 0xb0, 0x2b, 0xe1, 0x78, 0x35, 0x5a, 0x15, 0x88, 0xa7, 0xec, 0x8a, 0x3c,
+	0x84, 0xf2, 0xc4, 0x63, 0x33, 0x37, 0x6a, 0x97, 0x85, 0x4a, 0xd6, 0x1a, 0x95, 0x0e, 0x04, 0x91,
+	0xd4, 0x48, 0x71, 0x90, 0x77, 0xa0, 0x8e, 0x5b, 0xc2, 0x59, 0xcc, 0x3d, 0x26, 0x9d, 0xaf, 0x48,
+	0x61, 0x6e, 0xbf, 0xa0, 0x12, 0x83, 0x2e, 0x86, 0x93, 0x57, 0xe3, 0x33, 0xdb, 0xb9, 0x08, 0x26,
+	0x13, 0xe1, 0x62, 0x45, 0xda, 0x10, 0xc8, 0x47, 0x12, 0x87, 0x69, 0x0b, 0x93, 0x4d, 0xb0, 0x88,
+	0x85, 0x67, 0x15, 0xa9, 0x06, 0xb7, 0x1f, 0x42, 0x23, 0x6b, 0x09, 0xb4, 0x28, 0x2e, 0x41, 0x59,
+	0xf4, 0x82, 0x5d, 0xe1, 0x49, 0x79, 0x69, 0xcf, 0x16, 0x4c, 0xe5, 0x35, 0x09, 0x3c, 0x2c, 0x7c,
+	0x61, 0x6c, 0x3f, 0x80, 0x7a, 0x46, 0xe5, 0x57, 0x61, 0xb5, 0xbe, 0x87, 0x66, 0xce, 0x4d, 0x50,
+	0x43, 0x9f, 0xc5, 0xcf, 0x03, 0x7e, 0xa1, 0x04, 0x68, 0x10, 0x67, 0x6c, 0xd7, 0xe5, 0x2c, 0x8a,
+	0x94, 0x18, 0x0d, 0xe2, 0x86, 0x4c, 0x6c, 0xc7, 0x9b, 0x79, 0xf1, 0x95, 0xde, 0x2c, 0x0d, 0x63,
+	0xfe, 0xb3, 0xc3, 0x30, 0x9b, 0x22, 0x2a, 0x76, 0x18, 0x62, 0x86, 0xb0, 0xbe, 0x93, 0x89, 0x3d,
+	0x17, 0x10, 0xe4, 0x3e, 0x94, 0x32, 0x75, 0xcf, 0x9d, 0xd5, 0xc8, 0x11, 0xe5, 0x8f, 0xa0, 0x41,
+	0x8d, 0x54, 0x9e, 0xd5, 0x1a, 0x29, 0xd0, 0x3a, 0x83, 0x66, 0xfe, 0xbc, 0xd8, 0x86, 0xaa, 0xcc,
+	0x97, 0xc9, 0x81, 0x91, 0xc0, 0x38, 0xe7, 0x2e, 0xb8, 0x08, 0x63, 0x21, 0xa7, 0x48, 0x13, 0x18,
+	0xd5, 0xc7, 0x6d, 0x8f, 0xbc, 0xef, 0xa5, 0x1f, 0x16, 0x69, 0x65, 0x6e, 0xbf, 0x38, 0xf1, 0xbe,
+	0x67, 0xd6, 0x6f, 0x0d, 0xd8, 0x5c, 0xca, 0x07, 0x39, 0x72, 0x23, 0x47, 0x8e, 0x5f, 0xf1, 0xfc,
+	0x98, 0xf1, 0x4b, 0x7b, 0xa6, 0xbf, 0xa2, 0x61, 0x7d, 0x64, 0x62, 0xee, 0x8c, 0xd4, 0x67, 0x50,
+	0xce, 0x01, 0xc2, 0xc8, 0xe8, 0x04, 0x73, 0x51, 0x90, 0xa8, 0x7a, 0x23, 0x81, 0xad, 0xff, 0x30,
+	0x80, 0xac, 0xe6, 0x74, 0x74, 0x56, 0x87, 0x33, 0x3b, 0x66, 0xe3, 0x98, 0x45, 0xb1, 0x5a, 0x30,
+	0x48, 0xd4, 0x88, 0x45, 0xb1, 0x8e, 0xd7, 0x42, 0x1a, 0xaf, 0x77, 0xa0, 0xec, 0x2f, 0xe6, 0x67,
+	0x8c, 0xab, 0xef, 0x2b, 0x08, 0x5d, 0x27, 0x3c, 0xb7, 0x23, 0xbd, 0x79, 0x12, 0x40, 0xcb, 0x8b,
+	0x63, 0x83, 0x71, 0x15, 0x64, 0x1a, 0x24, 0x04, 0x4a, 0xe2, 0x9b, 0x65, 0x81, 0x16, 0x63, 0xa4,
+	0x76, 0x82, 0xf9, 0xdc, 0xf6, 0x5d, 0x11, 0x37, 0x35, 0xaa, 0x41, 0xeb, 0x97, 0xd0, 0xcc, 0xe5,
+	0xed, 0x6b, 0x12, 0xc9, 0x6d, 0xd8, 0x88, 0x83, 0x0b, 0xa6, 0xb7, 0x58, 0x02, 0x42, 0xe4, 0xb9,
+	0xed, 0xfb, 0xaa, 0x70, 0xac, 0x51, 0x0d, 0x5a, 0x7f, 0x2c, 0x41, 0x73, 0x4f, 0xac, 0x54, 0xcb,
+	0x24, 0x50, 0xb2, 0xf9, 0x54, 0x1f, 0xdb, 0x62, 0x4c, 0x7e, 0x02, 0x5b, 0xe8, 0xd4, 0x9e, 0x3f,
+	0x1d, 0xcb, 0x0a, 0x2c, 0xe0, 0x57, 0xea, 0x0b, 0xa6, 0x9a, 0xd8, 0xd7, 0x78, 0xf2, 0x18, 0xea,
+	0xcc, 0xbf, 0xf4, 0x78, 0xe0, 0xcf, 0x99, 0x1f, 0xab, 0x92, 0xf9, 0xff, 0x6b, 0xd7, 0xcc, 0x7d,
+	0x6c, 0xa7, 0x9b, 0x12, 0xca, 0x04, 0x92, 0x65, 0xc5, 0x22, 0x24, 0xb8, 0x64, 0x9c, 0x7b, 0x2e,
+	0x1b, 0x2b, 0xbc, 0xda, 0xd3, 0x4d, 0x8d, 0x57, 0x02, 0xb0, 0x8c, 0x55, 0xb9, 0x61, 0x1c, 0x31,
+	0x27, 0xf0, 0xdd, 0x48, 0x98, 0xba, 0x48, 0x5b, 0x0a, 0x7d, 0x22, 0xb1, 0xc2, 0xe2, 0xf6, 0x54,
+	0xe6, 0x34, 0xb4, 0xb8, 0x3d, 0x8d, 0xc8, 0x67, 0x00, 0x81, 0x3f, 0x8e, 0x16, 0x8e, 0x83, 0x5e,
+	0x53, 0xb9, 0x5b, 0xcc, 0x9e, 0x0b, 0x39, 0x85, 0x69, 0x2d, 0xf0, 0x4f, 0x24, 0x9d, 0xe2, 0x9a,
+	0xd8, 0xde, 0x6c, 0xc1, 0x59, 0xbb, 0xfa, 0x12, 0xae, 0x03, 0x49, 0xa7, 0xb8, 0xd2, 0xb4, 0x76,
+	0x33, 0xd7, 0x48, 0xd2, 0x91, 0x0f, 0xa1, 0xac, 0x4a, 0x61, 0xc8, 0x9f, 0x5a, 0xb9, 0xb2, 0x9f,
+	0x2a, 0x22, 0x0c, 0x82, 0xf3, 0x20, 0x8a, 0x45, 0x1a, 0xa9, 0xcb, 0x14, 0xa3, 0xe1, 0x6c, 0x52,
+	0x6d, 0xe4, 0x93, 0xea, 0x97, 0x60, 0x2e, 0xef, 0xc7, 0x2b, 0x65, 0xc7, 0xff, 0x2a, 0x40, 0xfd,
+	0x98, 0x07, 0x68, 0x9c, 0x9e, 0x3f, 0x09, 0x48, 0x0b, 0x0a, 0x9e, 0xab, 0x58, 0x0b, 0x9e, 0x8b,
+	0xb2, 0x42, 0xcf, 0x55, 0xe1, 0x8c, 0x43, 0xf2, 0x06, 0x54, 0x50, 0xaf, 0xb1, 0xe7, 0x2a, 0xbf,
+	0x2c, 0x23, 0xd8, 0x73, 0x51, 0x49, 0xbe, 0xf0, 0x7d, 0xcf, 0x9f, 0xaa, 0x0d, 0xd7, 0x20, 0xf9,
+	0x31, 0x80, 0xda, 0xa8, 0xc9, 0x62, 0xa6, 0x2e, 0x06, 0x19, 0x8c, 0x8e, 0xff, 0x19, 0x8b, 0x59,
+	0xbb, 0x9c, 0xc6, 0x3f, 0xc2, 0x38, 0x87, 0x6b, 0x75, 0x71, 0xed, 0x15, 0x39, 0xa7, 0x61, 0xf2,
+	0x11, 0x54, 0xf4, 0xe1, 0x5e, 0xbd, 0x6b, 0xac, 0xdf, 0x14, 0x4d, 0x85, 0x59, 0x88, 0xbd, 0xf0,
+	0xe2, 0xb1, 0x13, 0xb8, 0x4c, 0x1c, 0x4f, 0x1b, 0xb4, 0x8a, 0x88, 0xbd, 0xc0, 0x65, 0xc2, 0x73,
+	0xd5, 0xe5, 0x87, 0x2f, 0x7c, 0xc7, 0x8e, 0x99, 0xdb, 0x06, 0xe5, 0xb9, 0x02, 0x3f, 0xd2, 0x68,
+	0xf4, 0x5c, 0x75, 0xdd, 0x49, 0x28, 0xeb, 0xf2, 0x02, 0x26, 0xd0, 0x09, 0xa1, 0xd5, 0x81, 0xd6,
+	0x49, 0x6c, 0xc7, 0x8b, 0x88, 0xb2, 0x28, 0x0c, 0xfc, 0x88, 0x65, 0xcd, 0x67, 0xe4, 0xcc, 0x77,
+	0x07, 0xca, 0x58, 0x8f, 0x5f, 0x32, 0x75, 0x97, 0x53, 0x90, 0xf5, 0x10, 0xca, 0x07, 0xde, 0x2c,
+	0x66, 0x9c, 0x7c, 0x0c, 0x25, 0xe1, 0x1d, 0xf2, 0xe0, 0x78, 0x5b, 0xaf, 0x55, 0xce, 0x9e, 0x84,
+	0xcc, 0xf1, 0x26, 0x9e, 0x63, 0xcb, 0x25, 0x0b, 0x4a, 0xeb, 0x0f, 0x06, 0x34, 0x4f, 0xbc, 0xa9,
+	0x6f, 0xcf, 0xd4, 0x1e, 0x93, 0xcf, 0xa1, 0xa6, 0xb7, 0x7b, 0xbf, 0x6d, 0xe4, 0x4b, 0xbf, 0x27,
+	0xe2, 0x27, 0x99, 0xa6, 0x29, 0x25, 0x79, 0x1f, 0xca, 0x91, 0x90, 0xd3, 0x2e, 0xe4, 0x6f, 0xeb,
+	0x52, 0x7a, 0x44, 0xd5, 0xb4, 0x28, 0x43, 0xc5, 0x68, 0x9c, 0xc9, 0xb5, 0x1b, 0xb4, 0x21, 0x91,
+	0x03, 0x81, 0xc3, 0xe4, 0xad, 0x89, 0xd2, 0x43, 0x13, 0x14, 0x09, 0xea, 0xfd, 0x0e, 0x54, 0x46,
+	0xf6, 0x14, 0x87, 0xa9, 0xeb, 0x1a, 0x19, 0xd7, 0xb5, 0x7e, 0x91, 0x78, 0xed, 0x08, 0x93, 0xc1,
+	0xdb, 0x50, 0x0b, 0x73, 0xab, 0xaa, 0xd1, 0x14, 0x91, 0xa4, 0x8f, 0x42, 0x9a, 0x3e, 0xac, 0xf7,
+	0x61, 0x73, 0x69, 0xb9, 0x6b, 0xbe, 0xf4, 0x57, 0x60, 0x0e, 0x43, 0x26, 0xcf, 0xca, 0xe1, 0x22,
+	0x76, 0x02, 0x19, 0x8e, 0x3a, 0xf1, 0xa8, 0xab, 0x99, 0x02, 0xc5, 0xa7, 0xd8, 0x8b, 0x58, 0xc5,
+	0x99, 0x18, 0xe7, 0x9d, 0xae, 0x98, 0x77, 0x3a, 0xeb, 0xd7, 0xd0, 0x10, 0xa7, 0x9b, 0xce, 0xe4,
+	0x77, 0xa0, 0x1c, 0xdb, 0x7c, 0xca, 0x62, 0xed, 0x1d, 0x12, 0x92, 0x19, 0xde, 0x39, 0xd7, 0x82,
+	0x71, 0x8c, 0x6a, 0x30, 0xd7, 0x43, 0x3e, 0x7d, 0x42, 0x28, 0x10, 0x97, 0xe2, 0xb2, 0xb3, 0x85,
+	0x0e, 0x44, 0x09, 0x58, 0xbf, 0x2d, 0xc0, 0x9d, 0xa3, 0xc0, 0x9f, 0x06, 0xfb, 0x8f, 0xf6, 0x83,
+	0xe7, 0xfe, 0x2c, 0xb0, 0xdd, 0xb4, 0x56, 0x6e, 0x8a, 0xe3, 0x6d, 0xe9, 0x62, 0x76, 0x3b, 0x77,
+	0xab, 0xd2, 0xe1, 0xd4, 0x38, 0xcb, 0x6a, 0x4c, 0xa0, 0x14, 0xda, 0x71, 0xa2, 0x19, 0x8e, 0x31,
+	0x68, 0x39, 0x9b, 0x31, 0x3b, 0x62, 0xfa, 0x4a, 0x98, 0xc0, 0xe4, 0x13, 0xa8, 0x44, 0xc1, 0x82,
+	0x3b, 0x4c, 0x9e, 0xf5, 0x19, 0xff, 0xd3, 0x4a, 0x9d, 0xc8, 0x69, 0xaa, 0xe9, 0x70, 0xa1, 0x78,
+	0x62, 0x31, 0xae, 0x0f, 0x08, 0x0d, 0x92, 0x8f, 0xe0, 0x76, 0x72, 0x8d, 0x18, 0x87, 0x8c, 0xab,
+	0x83, 0x44, 0x5d, 0xaf, 0xb6, 0xf4, 0xa5, 0xfc, 0x98, 0x71, 0x79, 0x96, 0x58, 0xff, 0x6c, 0x40,
+	0x4b, 0xd9, 0x80, 0x4a, 0x8d, 0x70, 0x01, 0x51, 0xc8, 0x1c, 0x65, 0x70, 0x31, 0xc6, 0x2f, 0x5e,
+	0x32, 0x1e, 0xe9, 0x7a, 0xa9, 0x46, 0x35, 0xa8, 0x8f, 0xef, 0x62, 0xae, 0xae, 0x88, 0xce, 0xed,
+	0xdd, 0xcf, 0x7f, 0xaa, 0x1c, 0x59, 0x41, 0x89, 0x61, 0x36, 0x32, 0x86, 0x79, 0x13, 0xaa, 0x67,
+	0x9e, 0x3f, 0x16, 0xf8, 0xb2, 0x2a, 0x2b, 0x3c, 0xff, 0x18, 0xa7, 0xb0, 0xd4, 0xe3, 0x2c, 0x62,
+	0xbe, 0xce, 0x73, 0x1a, 0xb4, 0xba, 0xb0, 0x99, 0x57, 0x19, 0xdb, 0x5b, 0xa9, 0x81, 0x65, 0xb3,
+	0x28, 0xa9, 0x23, 0xf3, 0xa4, 0xa9, 0xe1, 0xad, 0x7f, 0x32, 0x60, 0x73, 0xc9, 0xc4, 0xa4, 0x83,
+	0x72, 0x9e, 0x73, 0x2f, 0x4e, 0xe4, 0xbc, 0xb7, 0x66, 0x37, 0x76, 0xa8, 0xa2, 0x93, 0x67, 0x7e,
+	0xc2, 0x86, 0x7a, 0xcf, 0x3d, 0xce, 0x03, 0xae, 0x03, 0x4c, 0x83, 0xdb, 0x3f, 0x87, 0x66, 0x8e,
+	0xe9, 0x95, 0x0e, 0xa6, 0x09, 0x34, 0xf6, 0x6c, 0xe7, 0x9c, 0x65, 0xca, 0x5b, 0xd7, 0x8b, 0xb2,
+	0xfd, 0x90, 0x04, 0xc6, 0x7c, 0x12, 0xf2, 0x85, 0xcf, 0xc6, 0x2e, 0x9b, 0xd9, 0x57, 0xea, 0xb0,
+	0x02, 0x81, 0xda, 0x47, 0xcc, 0x4d, 0x35, 0xee, 0xef, 0x0b, 0xd0, 0xea, 0x70, 0xe7, 0xdc, 0xbb,
+	0x64, 0x99, 0xde, 0x40, 0x74, 0x1e, 0x2c, 0x66, 0xee, 0x98, 0xbd, 0x88, 0xb9, 0xed, 0xe8, 0xf2,
+	0xb2, 0x29, 0xb1, 0x5d, 0x89, 0xc4, 0xf3, 0x5d, 0x5d, 0xff, 0x65, 0x4e, 0x4c, 0x0e, 0x1f, 0x25,
+	0x6e, 0xa9, 0x05, 0xf0, 0x0e, 0xd4, 0x65, 0x2c, 0xcb, 0xdd, 0x97, 0x0e, 0x04, 0x12, 0x25, 0x1c,
+	0xe0, 0x03, 0x30, 0xa3, 0x98, 0x7b, 0xe1, 0x18, 0xcf, 0xbe, 0xc0, 0x67, 0x7e, 0x2c, 0x23, 0xa4,
+	0x48, 0x37, 0x05, 0x7e, 0x2f, 0x41, 0xa3, 0xcd, 0x3d, 0xdf, 0x99, 0x2d, 0x5c, 0xd6, 0xde, 0x90,
+	0x36, 0x57, 0x20, 0xce, 0xb0, 0x17, 0x72, 0x46, 0x56, 0x4b, 0x1a, 0xcc, 0xd9, 0xa0, 0x92, 0x2f,
+	0xdc, 0x73, 0xc5, 0x79, 0x35, 0x5f, 0x9c, 0x5b, 0x0f, 0xa0, 0xa9, 0x16, 0x44, 0x59, 0x18, 0xf0,
+	0x18, 0xf7, 0x4c, 0x52, 0xca, 0x6a, 0x53, 0x02, 0x22, 0x8a, 0x50, 0xb4, 0x34, 0xbe, 0x18, 0x5b,
+	0xbf, 0x2b, 0x42, 0x43, 0xbb, 0x91, 0xa8, 0x2e, 0x56, 0x6b, 0xdf, 0xeb, 0xb2, 0xc7, 0x03, 0x68,
+	0xd8, 0xf2, 0x8b, 0x98, 0x8e, 0xe4, 0x75, 0x21, 0xe3, 0xe0, 0xf9, 0xdd, 0xa2, 0x75, 0x3b, 0x81,
+	0xa3, 0x9b, 0x62, 0x31, 0x3a, 0xb7, 0x3f, 0xd1, 0xb1, 0x88, 0x63, 0x54, 0x66, 0xee, 0x7e, 0xae,
+	0xc2, 0x10, 0x87, 0xb8, 0xf1, 0x13, 0x86, 0x57, 0x6b, 0xe7, 0x9c, 0x39, 0x17, 0xd1, 0x62, 0xae,
+	0x22, 0xb1, 0x29, 0xb0, 0x7b, 0x0a, 0xb9, 0x7c, 0x51, 0xae, 0xbe, 0xfc, 0xa2, 0x5c, 0xbb, 0xf9,
+	0xa2, 0x0c, 0xb9, 0x9a, 0x2e, 0x9b, 0x21, 0xeb, 0x3f, 0x30, 0x43, 0xae, 0xcb, 0x83, 0x8d, 0x75,
+	0x79, 0xf0, 0x2f, 0xd3, 0x5c, 0x90, 0xb9, 0x17, 0xeb, 0x2c, 0x6b, 0xfc, 0xb0, 0x2c, 0x5b, 0x58,
+	0x27, 0xfd, 0xdf, 0x0c, 0x68, 0x25, 0xba, 0x8a, 0xfa, 0x07, 0x17, 0x95, 0x3f, 0x5b, 0x56, 0x16,
+	0xb5, 0x52, 0xad, 0x61, 0x46, 0x64, 0xbe, 0x8b, 0x05, 0xa5, 0xfc, 0x92, 0x06, 0xb3, 0xa5, 0xa6,
+	0x0a, 0x67, 0x05, 0xa2, 0xe9, 0x1d, 0x4c, 0x1b, 0x63, 0xe6, 0xcb, 0xdd, 0x91, 0x11, 0xd4, 0x10,
+	0xc8, 0xae, 0xc4, 0x91, 0x1f, 0x01, 0x48, 0x22, 0xe1, 0xb1, 0xf2, 0x48, 0xa9, 0x09, 0x8c, 0x48,
+	0x09, 0xef, 0x41, 0x53, 0xeb, 0xf4, 0x24, 0x38, 0x5b, 0x5b, 0x19, 0xfc, 0x77, 0x01, 0xb6, 0x32,
+	0x74, 0x6a, 0x9d, 0xcb, 0x05, 0xf4, 0x3d, 0x28, 0x79, 0xfe, 0x24, 0x68, 0x17, 0xf2, 0x07, 0x6a,
+	0x36, 0x2c, 0xa8, 0xa0, 0x20, 0x3b, 0xb0, 0x11, 0xc5, 0x76, 0x2c, 0x33, 0x54, 0x6b, 0xb7, 0xbd,
+	0x4c, 0xaa, 0xbe, 0xc1, 0xa8, 0x24, 0xc3, 0x0b, 0x9e, 0xdc, 0x91, 0x98, 0xdb, 0x7e, 0x34, 0x61,
+	0x1c, 0x6f, 0xfe, 0x72, 0xb9, 0xa6, 0x98, 0x18, 0xa5, 0x78, 0xf4, 0x59, 0x45, 0x1c, 0xc4, 0xf6,
+	0x4c, 0xad, 0x19, 0x24, 0x19, 0x62, 0x30, 0x42, 0xb8, 0xad, 0xea, 0x6f, 0x83, 0x8a, 0x31, 0xae,
+	0x5b, 0x3e, 0x02, 0xc8, 0x3b, 0xad, 0x04, 0xd0, 0x7a, 0x51, 0x6c, 0xf3, 0x58, 0x5c, 0x88, 0x94,
+	0xf7, 0xd7, 0x04, 0x06, 0x6f, 0x3e, 0xb2, 0x1d, 0xec, 0xca, 0x49, 0xd5, 0x01, 0x62, 0xbe, 0x2b,
+	0xa6, 0x3e, 0xc5, 0x4a, 0x48, 0x24, 0x4f, 0x55, 0x5a, 0xd7, 0x57, 0x92, 0xa6, 0xcc, 0x31, 0x34,
+	0xa5, 0xb3, 0xfa, 0x70, 0x6b, 0xc5, 0xca, 0x0c, 0x0b, 0xd9, 0x6a, 0xa4, 0xc6, 0xea, 0xe4, 0x7a,
+	0x73, 0x8d, 0xc1, 0x16, 0x11, 0x4d, 0x48, 0xad, 0x7f, 0x37, 0x80, 0xe8, 0xf9, 0x3d, 0xed, 0x13,
+	0x57, 0x98, 0x37, 0x5c, 0x6f, 0xaa, 0x3b, 0x09, 0x35, 0xaa, 0x20, 0xb4, 0xca, 0x82, 0xcf, 0x92,
+	0xd2, 0x11, 0xc7, 0x49, 0xa6, 0x2b, 0xa6, 0x99, 0x0e, 0xf9, 0x43, 0xcf, 0xf7, 0xd5, 0x06, 0x54,
+	0xa9, 0x82, 0x90, 0xf6, 0x1c, 0x5f, 0x1c, 0xa4, 0xbd, 0xc5, 0x58, 0xf4, 0x8b, 0x5c, 0x65, 0x20,
+	0x59, 0xa7, 0x60, 0x9b, 0x49, 0x18, 0xe8, 0x1d, 0xa8, 0xdb, 0xa2, 0x90, 0x94, 0xb3, 0xaa, 0x05,
+	0x27, 0x51, 0x48, 0x60, 0xf5, 0xe1, 0xf6, 0x8a, 0xf6, 0xe8, 0xd1, 0x9f, 0xe1, 0x63, 0x81, 0x18,
+	0x2a, 0x63, 0x6c, 0x2f, 0x1b, 0x23, 0x5d, 0x2c, 0xd5, 0xa4, 0xd6, 0x4f, 0xe0, 0x56, 0x6e, 0x7a,
+	0x5f, 0x2e, 0xfa, 0x7a, 0x77, 0x7f, 0x04, 0x66, 0x8e, 0xf8, 0xd8, 0xf3, 0xd7, 0x9a, 0x2d, 0x35,
+	0x47, 0x21, 0x6b, 0x0e, 0xeb, 0x3d, 0xd8, 0xcc, 0xf4, 0x72, 0x4e, 0x69, 0x3f, 0x4a, 0x2c, 0x6c,
+	0xa4, 0x16, 0xb6, 0xee, 0x42, 0xb5, 0x1f, 0x4c, 0xb1, 0x21, 0x16, 0x89, 0xd7, 0x33, 0x1c, 0xe8,
+	0xd3, 0x46, 0x00, 0xd6, 0xbf, 0x18, 0x82, 0xe4, 0x97, 0x0b, 0xc6, 0xaf, 0x56, 0x42, 0x2e, 0x7d,
+	0xb3, 0x2b, 0xbc, 0xec, 0xcd, 0xee, 0x36, 0x6c, 0x44, 0x9e, 0xef, 0xe8, 0xcd, 0x94, 0x40, 0xb6,
+	0xeb, 0x56, 0xca, 0x75, 0xdd, 0xe4, 0x55, 0xc2, 0xd3, 0xf1, 0x23, 0xc6, 0xb8, 0xd8, 0x60, 0x32,
+	0x89, 0x58, 0xac, 0x76, 0x53, 0x41, 0x52, 0xf3, 0xb9, 0x17, 0xab, 0x6d, 0x94, 0x80, 0xf5, 0x3b,
+	0x03, 0x6a, 0xfd, 0x60, 0x4a, 0x99, 0x83, 0xcd, 0xdc, 0xb7, 0xa1, 0x96, 0x3c, 0xcd, 0xa8, 0xac,
+	0x9b, 0x22, 0x5e, 0x65, 0x21, 0x77, 0xa0, 0x2c, 0x8d, 0xaa, 0x9b, 0x5b, 0x12, 0x42, 0x85, 0xd1,
+	0x62, 0x6a, 0x1d, 0x62, 0x8c, 0xb4, 0xaa, 0x49, 0x8c, 0xcb, 0x68, 0xe8, 0x06, 0xb0, 0xf5, 0x00,
+	0x20, 0xd1, 0x0c, 0xfb, 0x47, 0x15, 0x2e, 0x87, 0xca, 0xa5, 0xb2, 0x5f, 0x97, 0x44, 0x54, 0x53,
+	0x58, 0x9f, 0xc3, 0x66, 0x87, 0xc7, 0xde, 0xc4, 0x76, 0x62, 0xca, 0x7e, 0xb3, 0x40, 0x1f, 0x58,
+	0xde, 0x15, 0xa2, 0x6e, 0xaf, 0xea, 0xa4, 0xc7, 0xb1, 0xf5, 0x2e, 0x34, 0x35, 0xdb, 0xde, 0xf9,
+	0xc2, 0xbf, 0x40, 0x22, 0xd7, 0x8e, 0x6d, 0xc1, 0xd6, 0xa0, 0x62, 0x8c, 0x15, 0xda, 0xa6, 0xba,
+	0xa5, 0xed, 0x1d, 0x9f, 0x62, 0x18, 0x88, 0x26, 0x9a, 0x13, 0x2e, 0x74, 0x21, 0xe1, 0x84, 0x0b,
+	0xe1, 0x47, 0x11, 0xe3, 0x42, 0xbc, 0x41, 0xc5, 0x18, 0x17, 0x1a, 0x5d, 0x45, 0x31, 0x9b, 0x0b,
+	0xa3, 0x18, 0x54, 0x41, 0x48, 0xeb, 0xb9, 0x33, 0x69, 0x14, 0x83, 0x8a, 0xb1, 0x50, 0xcf, 0x73,
+	0xe4, 0x69, 0x60, 0x50, 0x31, 0x46, 0x7e, 0x2f, 0x78, 0x6e, 0x7b, 0xb1, 0xca, 0x8a, 0x0a, 0xc2,
+	0xaf, 0x7b, 0xfc, 0x37, 0x62, 0x5f, 0x0d, 0x8a, 0x43, 0x71, 0x23, 0x0c, 0x26, 0x31, 0x62, 0xab,
+	0x02, 0xab, 0x41, 0xe1, 0x61, 0x31, 0xb3, 0x67, 0x22, 0x17, 0x1a, 0x54, 0x02, 0x88, 0x9d, 0xa2,
+	0x95, 0x44, 0x16, 0x34, 0xa8, 0x04, 0x30, 0xb3, 0x8a, 0xc1, 0x58, 0x68, 0x52, 0x17, 0x53, 0x35,
+	0x81, 0x19, 0x28, 0x75, 0xa2, 0x38, 0x98, 0x31, 0xbf, 0xdd, 0x50, 0xcb, 0x11, 0x90, 0xf5, 0xb7,
+	0x06, 0x6c, 0xe9, 0x6b, 0xec, 0x70, 0x2f, 0x58, 0xf8, 0x31, 0x1e, 0xda, 0x3f, 0x02, 0xe0, 0xcc,
+	0x76, 0xc7, 0x0e, 0x22, 0x84, 0xa5, 0x4a, 0xf8, 0x92, 0x6a, 0xbb, 0x82, 0x02, 0x53, 0x8d, 0x28,
+	0xcd, 0xd5, 0x7c, 0x41, 0xcc, 0x83, 0x40, 0x49, 0x02, 0xcd, 0x2f, 0x9f, 0x68, 0x8a, 0x29, 0xbf,
+	0x7c, 0x9b, 0x49, 0xf8, 0xe5, 0x7c, 0x29, 0xc3, 0x2f, 0x08, 0xac, 0xff, 0x2c, 0x00, 0x51, 0x5a,
+	0x0d, 0x58, 0x9c, 0xa8, 0x45, 0x32, 0x4d, 0x0c, 0xe5, 0x06, 0xf8, 0x29, 0x79, 0x38, 0x45, 0x2c,
+	0x51, 0xa5, 0x26, 0x30, 0x27, 0x4c, 0x6a, 0x22, 0xa7, 0x39, 0x73, 0x2e, 0xb5, 0x26, 0x02, 0x43,
+	0x99, 0x73, 0x49, 0xfe, 0x1f, 0x34, 0x42, 0xdb, 0xb9, 0x60, 0xb1, 0xe2, 0x97, 0xaa, 0xd4, 0x15,
+	0x4e, 0x48, 0xc8, 0x90, 0x08, 0x19, 0x1b, 0x39, 0x12, 0x21, 0x45, 0x9e, 0x75, 0x9e, 0x7c, 0x8d,
+	0x2e, 0x51, 0x09, 0xa0, 0xc9, 0x19, 0xe7, 0xba, 0xf7, 0x54, 0xa2, 0x0a, 0x42, 0xbc, 0xcb, 0x83,
+	0xd0, 0xf3, 0xc5, 0x76, 0x97, 0xa8, 0x82, 0xd0, 0x0f, 0x70, 0xa4, 0x5f, 0x3f, 0x4a, 0x54, 0x83,
+	0x32, 0xe8, 0x26, 0x81, 0xe7, 0x8b, 0x2d, 0x2f, 0x51, 0x05, 0x21, 0x07, 0x8e, 0x90, 0xa3, 0x2e,
+	0x39, 0x14, 0x68, 0xfd, 0x8d, 0x01, 0x4d, 0x65, 0x40, 0xf9, 0x2e, 0x86, 0x7e, 0xc7, 0x55, 0xcf,
+	0xa1, 0x44, 0x71, 0x88, 0x98, 0xcb, 0x79, 0xa4, 0x4c, 0x86, 0xc3, 0x24, 0x82, 0xa4, 0x99, 0xc4,
+	0x58, 0xfa, 0xa0, 0xed, 0x5c, 0x28, 0xd3, 0x48, 0x40, 0xa6, 0x0c, 0xe7, 0x82, 0xb9, 0xca, 0x1c,
+	0x0a, 0x42, 0x09, 0xd1, 0x73, 0xf5, 0x44, 0x59, 0xa2, 0x62, 0x6c, 0xfd, 0x6b, 0x29, 0xd9, 0xcc,
+	0x23, 0x2c, 0x72, 0x1d, 0xd9, 0x2d, 0xc4, 0xbb, 0x1f, 0x8b, 0x22, 0x7b, 0xaa, 0xf7, 0x53, 0x83,
+	0xd9, 0xbe, 0xe1, 0x86, 0xec, 0x1b, 0xe2, 0x99, 0x60, 0x73, 0xd9, 0x5d, 0x46, 0xa4, 0x82, 0x50,
+	0x46, 0x7c, 0x8e, 0x7e, 0xa5, 0x6b, 0x35, 0x0d, 0x66, 0x9b, 0xea, 0x1b, 0xb9, 0xa6, 0x3a, 0xf9,
+	0x40, 0x86, 0x7f, 0x39, 0x5f, 0x48, 0x2e, 0x25, 0x09, 0x99, 0x17, 0x3e, 0x80, 0x82, 0x17, 0x2c,
+	0xff, 0x51, 0x60, 0x25, 0x5a, 0x68, 0xc1, 0x0b, 0xc8, 0x9f, 0x41, 0xd1, 0x67, 0x71, 0xbb, 0x9a,
+	0x3f, 0x40, 0x57, 0x7d, 0x98, 0x22, 0x19, 0x5e, 0xff, 0xe4, 0x43, 0x66, 0xbb, 0x96, 0xaf, 0x64,
+	0x72, 0x7b, 0x46, 0x15, 0x11, 0xf9, 0x06, 0x36, 0xe5, 0x68, 0x1c, 0xce, 0xec, 0x18, 0xef, 0x84,
+	0x6d, 0x10, 0x1f, 0xda, 0x59, 0xe1, 0x4b, 0xec, 0xbb, 0x23, 0x65, 0x1c, 0x2b, 0x06, 0x79, 0x7a,
+	0xb7, 0xe6, 0x39, 0xa4, 0x76, 0x51, 0x8e, 0x97, 0x05, 0x3c, 0x21, 0x15, 0x94, 0xfe, 0xed, 0xa4,
+	0xa1, 0x8e, 0x1f, 0x04, 0x72, 0x5d, 0xe6, 0xe6, 0x52, 0x97, 0x19, 0x0f, 0x37, 0x2c, 0x3b, 0x5a,
+	0xea, 0x70, 0xf3, 0xe6, 0x6c, 0xbb, 0x03, 0xb7, 0xae, 0x51, 0xe2, 0x65, 0x37, 0xf9, 0x52, 0xf6,
+	0x26, 0xff, 0x04, 0x5a, 0xf9, 0xa5, 0x91, 0x2f, 0x92, 0x76, 0xdd, 0x6a, 0xbd, 0xb2, 0x6a, 0x05,
+	0x9a, 0x12, 0x63, 0x65, 0x80, 0xb7, 0x52, 0x71, 0x5f, 0x5e, 0x57, 0xa6, 0x10, 0xa4, 0xd0, 0xb5,
+	0xaf, 0x7c, 0x71, 0x4c, 0xae, 0x99, 0x46, 0xe6, 0x9a, 0x89, 0xaf, 0x7d, 0xb2, 0xe8, 0x54, 0x55,
+	0x8a, 0x06, 0xad, 0x5f, 0xc1, 0x56, 0x56, 0x86, 0x3c, 0x9a, 0x76, 0xa1, 0x2c, 0x9f, 0x6e, 0xd5,
+	0xfd, 0x65, 0x3b, 0xd3, 0x7f, 0x5d, 0xfa, 0x1c, 0x2d, 0x9f, 0x27, 0x9f, 0x15, 0xc1, 0x58, 0x48,
+	0x8f, 0xb3, 0xfb, 0x4f, 0xa0, 0x96, 0x1c, 0xdf, 0xe4, 0x36, 0x98, 0xfd, 0xe1, 0xe1, 0xc9, 0x88,
+	0x76, 0x3b, 0x47, 0xa7, 0x83, 0xa7, 0x83, 0xe1, 0x37, 0x03, 0xf3, 0x35, 0x72, 0x0b, 0x36, 0x13,
+	0xec, 0xf0, 0x74, 0x74, 0x7c, 0x3a, 0x32, 0x0d, 0x42, 0xa0, 0x95, 0x20, 0xbb, 0x94, 0x0e, 0xa9,
+	0x59, 0xb8, 0xff, 0xd7, 0x06, 0x6c, 0xad, 0xfc, 0xad, 0x84, 0xdc, 0x01, 0x22, 0xb9, 0xfa, 0xbd,
+	0xa3, 0xde, 0x28, 0x15, 0xfb, 0x06, 0xdc, 0xca, 0xe0, 0x9f, 0x76, 0xbb, 0xc7, 0x8f, 0xbb, 0x9d,
+	0x7d, 0xd3, 0xb8, 0x66, 0x62, 0xd4, 0xe9, 0xf5, 0xcd, 0x02, 0x2a, 0x92, 0x99, 0xd8, 0xa7, 0xc3,
+	0x63, 0xb3, 0xb8, 0x84, 0x7c, 0xda, 0xeb, 0xf7, 0xcd, 0xd2, 0xfd, 0xff, 0x31, 0xa0, 0xa2, 0xfe,
+	0xb9, 0x45, 0x5a, 0x00, 0xfd, 0xe1, 0x61, 0xfa, 0x5d, 0xb9, 0xc8, 0x47, 0xa7, 0xbd, 0xfe, 0x7e,
+	0x7f, 0x78, 0x78, 0xd8, 0xa5, 0x5f, 0xef, 0x9a, 0xc6, 0x35, 0xd8, 0x4f, 0xcd, 0x82, 0xe2, 0xdd,
+	0xef, 0x1e, 0x74, 0x4e, 0xfb, 0x23, 0xb3, 0x48, 0xea, 0x50, 0xe9, 0x0f, 0x0f, 0x0f, 0x7a, 0xfd,
+	0xae, 0x59, 0x52, 0x93, 0xbd, 0xc1, 0xe3, 0x2e, 0xed, 0x8d, 0xcc, 0x0d, 0xd2, 0x84, 0x1a, 0x9a,
+	0xe4, 0xb8, 0x7f, 0x3a, 0x78, 0x6a, 0x96, 0x89, 0x09, 0x0d, 0x04, 0x4f, 0x8f, 0x86, 0x48, 0xb5,
+	0x67, 0x56, 0xc8, 0x26, 0xd4, 0x05, 0xc3, 0x51, 0xf7, 0x68, 0x48, 0xbf, 0x33, 0xab, 0xca, 0xb2,
+	0x74, 0x38, 0xea, 0x8c, 0x7a, 0x03, 0x29, 0xb6, 0xa6, 0xc5, 0x7c, 0x77, 0xd2, 0x1f, 0x1e, 0x9a,
+	0xa0, 0x98, 0x9e, 0x0c, 0x4f, 0xe9, 0xa0, 0xd3, 0xdf, 0x37, 0xeb, 0x4a, 0x07, 0x7c, 0x41, 0x37,
+	0x1b, 0xf7, 0x63, 0xb1, 0x7d, 0xb2, 0xb7, 0xa3, 0xd6, 0x70, 0x30, 0xa4, 0x47, 0x9d, 0xd1, 0xf2,
+	0x7a, 0x25, 0x56, 0xaf, 0xc4, 0x20, 0x5b, 0xd0, 0x4c, 0xb0, 0x4f, 0x4e, 0x86, 0x03, 0xb3, 0xa0,
+	0xb6, 0x54, 0xa2, 0x8e, 0xfb, 0x9d, 0xde, 0xc0, 0x2c, 0xe6, 0x98, 0x7b, 0x83, 0xaf, 0x3b, 0xfd,
+	0xde, 0xbe, 0x59, 0xba, 0xef, 0x40, 0x2b, 0xff, 0x3e, 0x2c, 0x78, 0x7b, 0x83, 0xee, 0x71, 0x87,
+	0x9e, 0x74, 0xe9, 0x60, 0x38, 0xe8, 0x9a, 0xaf, 0xe5, 0x71, 0xe2, 0x1b, 0xd2, 0xcc, 0x09, 0x0e,
+	0x25, 0x1f, 0x8d, 0xe4, 0xc6, 0xa6, 0x58, 0xda, 0x3d, 0xec, 0x7e, 0x6b, 0x16, 0xef, 0x7f, 0x0b,
+	0xb7, 0xaf, 0x7b, 0x4b, 0x20, 0x15, 0x28, 0x76, 0xfa, 0x7d, 0xf3, 0x35, 0x34, 0x04, 0x3d, 0x1d,
+	0x0c, 0x7a, 0x83, 0x43, 0xd3, 0xc0, 0xcd, 0x18, 0x75, 0xe9, 0x51, 0x6f, 0xd0, 0x19, 0x75, 0xf7,
+	0xcd, 0x02, 0x01, 0x28, 0x1f, 0x74, 0x7a, 0xfd, 0xee, 0xbe, 0x59, 0xc4, 0xb9, 0x93, 0xd3, 0xbd,
+	0xbd, 0xee, 0xc9, 0xc9, 0xc1, 0x29, 0x7a, 0xc7, 0xaf, 0xa0, 0xa2, 0x1e, 0x0a, 0x50, 0x46, 0x6a,
+	0xa9, 0x26, 0xd4, 0x12, 0x19, 0xa6, 0x41, 0xaa, 0x50, 0x12, 0xee, 0x24, 0x84, 0x3d, 0xee, 0x0c,
+	0x0e, 0x4f, 0xd1, 0xdf, 0xaa, 0x50, 0xea, 0x0d, 0x7a, 0x23, 0xb3, 0x44, 0x6a, 0xb0, 0x71, 0x7a,
+	0xd2, 0xa5, 0x9f, 0x98, 0x1b, 0x7a, 0xb8, 0x6b, 0x96, 0xef, 0xff, 0xde, 0x48, 0x3a, 0x54, 0x6a,
+	0x5b, 0x08, 0xb4, 0x3a, 0x74, 0xef, 0x71, 0xef, 0xeb, 0x6e, 0xfa, 0xa9, 0x4d, 0xa8, 0x2b, 0x5c,
+	0xe7, 0x74, 0x34, 0x34, 0x0d, 0xf4, 0x16, 0x85, 0x18, 0x75, 0xe8, 0xe1, 0x33, 0xe9, 0x7b, 0x0a,
+	0xf3, 0xac, 0x77, 0x6c, 0x16, 0x33, 0xf0, 0xa8, 0x43, 0xcd, 0x12, 0xee, 0x60, 0x0a, 0x3f, 0x7a,
+	0xb6, 0x6b, 0x6e, 0xe4, 0x85, 0x7c, 0xfb, 0xcc, 0x2c, 0xe7, 0x89, 0x9e, 0x9d, 0x8c, 0xcc, 0xca,
+	0xfd, 0x7f, 0x30, 0xc0, 0x5c, 0xba, 0x93, 0x62, 0x31, 0x47, 0xf6, 0x87, 0xdf, 0x0c, 0xfa, 0xc3,
+	0xce, 0xfe, 0x93, 0xe1, 0xa3, 0x54, 0xcf, 0x3c, 0xfe, 0xb8, 0x3b, 0xd8, 0x97, 0xe6, 0xce, 0xe3,
+	0xf5, 0x36, 0x14, 0x48, 0x1b, 0x6e, 0x67, 0xf0, 0x7b, 0xc3, 0xa3, 0xe3, 0x7e, 0x77, 0x24, 0x36,
+	0xe1, 0x75, 0xd8, 0xca, 0xcc, 0xa8, 0xbd, 0x29, 0x61, 0xb0, 0x67, 0x19, 0x3a, 0x83, 0xbd, 0x2e,
+	0x4e, 0x6c, 0xec, 0xfe, 0x23, 0x81, 0xdb, 0xb9, 0x37, 0x91, 0x23, 0xdb, 0xb7, 0xf1, 0x0e, 0xf1,
+	0x05, 0x94, 0x55, 0x73, 0xe3, 0xce, 0x8e, 0xfc, 0x7f, 0xe7, 0x8e, 0xfe, 0x7f, 0xe7, 0x4e, 0x17,
+	0xff, 0xdf, 0xb9, 0x9d, 0xf4, 0xe6, 0x96, 0x1e, 0xbb, 0x3e, 0x83, 0xb2, 0x7c, 0x89, 0x23, 0xd7,
+	0xbf, 0xcc, 0x6d, 0xdf, 0x5a, 0x3e, 0x91, 0xb1, 0xaa, 0xf8, 0x10, 0x4a, 0x7d, 0x0f, 0x6f, 0x10,
+	0xf9, 0x17, 0xae, 0x6b, 0x89, 0x3f, 0x36, 0xc8, 0x47, 0xb0, 0x71, 0xc8, 0x83, 0x45, 0x48, 0x92,
+	0x47, 0x29, 0xf5, 0x76, 0xb4, 0x8e, 0xe1, 0x53, 0x28, 0x1e, 0xb2, 0x98, 0xac, 0x7b, 0xf7, 0xba,
+	0x5e, 0xa9, 0x07, 0x50, 0xfa, 0xc6, 0xf6, 0x6e, 0xe0, 0x4a, 0xfa, 0x35, 0x2b, 0xcf, 0x45, 0x3f,
+	0x83, 0x0a, 0x5a, 0xc4, 0x7e, 0xee, 0xbf, 0xf2, 0x37, 0xcb, 0x32, 0x6c, 0x52, 0xf3, 0xe5, 0x5e,
+	0xf3, 0x6e, 0xf8, 0xe6, 0x03, 0xd8, 0xd8, 0x9b, 0x31, 0x9b, 0xaf, 0xdd, 0xb2, 0x97, 0xb0, 0x06,
+	0x11, 0xfb, 0x13, 0x58, 0x7f, 0x0e, 0x30, 0xb2, 0xa7, 0x4a, 0x3b, 0xb2, 0xbc, 0x26, 0x7c, 0xaa,
+	0xbb, 0x81, 0xf9, 0x4b, 0xa8, 0x51, 0x16, 0xb1, 0x18, 0xc9, 0xfe, 0x44, 0x33, 0x1f, 0xbe, 0x8c,
+	0xfb, 0x3a, 0x95, 0xc8, 0x97, 0x69, 0x97, 0x1a, 0xcf, 0x72, 0x72, 0x6d, 0x93, 0xee, 0x86, 0x0f,
+	0x3f, 0x4d, 0x7b, 0xa9, 0xea, 0xf1, 0x85, 0xfc, 0x78, 0xe9, 0x35, 0x66, 0xa9, 0xc7, 0x79, 0x83,
+	0xb0, 0x1e, 0xb4, 0x28, 0x8b, 0x82, 0xd9, 0x25, 0xfb, 0xa1, 0xb2, 0xde, 0xb8, 0xfe, 0xe5, 0x27,
+	0x22, 0x5f, 0x41, 0xf3, 0x04, 0xdb, 0x72, 0x9a, 0x61, 0xcd, 0xc2, 0xd6, 0xf7, 0xcd, 0xc8, 0x3e,
+	0x34, 0x31, 0x12, 0xf5, 0xc4, 0xfa, 0x04, 0xf0, 0xd6, 0x5a, 0x19, 0x2c, 0x22, 0xbf, 0x80, 0xfa,
+	0x21, 0x4b, 0xb5, 0x78, 0xfd, 0x1a, 0xda, 0xde, 0xfe, 0x4d, 0x6a, 0x74, 0xa0, 0xb5, 0x67, 0xfb,
+	0x0e, 0x9b, 0xbd, 0x4c, 0xc6, 0x7a, 0xb3, 0x7e, 0x05, 0x0d, 0x0c, 0xdf, 0xff, 0x83, 0x12, 0x5f,
+	0x41, 0x6b, 0x2f, 0xf0, 0x27, 0xde, 0x74, 0xc1, 0x99, 0x68, 0x80, 0xa5, 0xe6, 0xcc, 0x3e, 0x54,
+	0xdd, 0xa0, 0xc3, 0x11, 0xb4, 0x13, 0x09, 0xcb, 0x0f, 0x71, 0xeb, 0x5a, 0xfc, 0x37, 0x88, 0x3b,
+	0x04, 0xb2, 0x22, 0xee, 0x1a, 0x41, 0x2f, 0xd7, 0x6b, 0x0f, 0xb6, 0x32, 0xfb, 0xf3, 0x43, 0x53,
+	0xfd, 0x12, 0xfd, 0x53, 0xb8, 0x85, 0xae, 0x22, 0x4c, 0xe1, 0xbe, 0xdc, 0x61, 0xde, 0x5e, 0xdb,
+	0x9f, 0xf4, 0x58, 0x44, 0xfa, 0x42, 0xa3, 0xbc, 0x2c, 0xf2, 0xd6, 0xb5, 0x2c, 0xb2, 0x67, 0xb9,
+	0x7d, 0x43, 0xbf, 0x93, 0x1c, 0xc2, 0xd6, 0xb1, 0xe7, 0x2f, 0x49, 0x6b, 0x5f, 0xcb, 0x70, 0xec,
+	0xf9, 0x37, 0x18, 0xaa, 0x0f, 0xb7, 0xba, 0x97, 0x9e, 0xf3, 0x4a, 0x8a, 0xad, 0x97, 0x76, 0x00,
+	0xe4, 0x90, 0xc5, 0xcb, 0xfd, 0xd0, 0xb5, 0xa9, 0xeb, 0x8d, 0x6b, 0xfe, 0xe1, 0x2c, 0x38, 0x3e,
+	0x13, 0x79, 0xaf, 0x1f, 0xdc, 0x94, 0xf7, 0xcc, 0x4c, 0xef, 0x4e, 0xf6, 0x55, 0x3f, 0x81, 0x9a,
+	0xe8, 0x9e, 0x0a, 0xbe, 0xec, 0xb4, 0xc0, 0x6e, 0x93, 0x95, 0x66, 0x1f, 0x66, 0x03, 0xdc, 0x95,
+	0xa5, 0xbb, 0xdc, 0xda, 0x4f, 0xde, 0xb9, 0xfe, 0x46, 0xa7, 0xb2, 0x81, 0x6e, 0xfb, 0xa5, 0xfc,
+	0x4b, 0xfd, 0xc3, 0xed, 0xd7, 0x97, 0x27, 0xc4, 0x35, 0xec, 0x63, 0x83, 0xec, 0x01, 0x9c, 0x86,
+	0x49, 0xb2, 0x7e, 0xf3, 0xba, 0x6b, 0x98, 0x20, 0x5d, 0x6f, 0xfa, 0x7b, 0x06, 0x79, 0x08, 0xb5,
+	0x03, 0x7c, 0xd4, 0x13, 0x32, 0xcc, 0xac, 0x0c, 0xbc, 0x5b, 0x6e, 0xaf, 0x97, 0xfa, 0xb1, 0xf1,
+	0x08, 0x9e, 0xc9, 0xff, 0x35, 0xfa, 0xf6, 0xec, 0xac, 0x2c, 0xfc, 0xfa, 0xd3, 0xff, 0x1d, 0x00,
+	0x78, 0x52, 0x17, 0xb1, 0x0f, 0x33, 0x00, 0x00,
 }
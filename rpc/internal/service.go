@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strings"
@@ -10,6 +11,7 @@ import (
 
 	empty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper"
 	"github.com/pkg/errors"
 	"github.com/tychoish/lru"
@@ -17,15 +19,53 @@ import (
 	grpc "google.golang.org/grpc"
 )
 
+// ServiceOptions configures the optional features of the service. The
+// service records its metrics in Metrics, or in a new
+// jasper.ServiceMetrics if it is nil, serves process artifacts from
+// Artifacts, if it is not nil, serves downloads from DownloadCache, or the
+// jasper.DefaultDownloadCache if it is nil, and allows the file transfers
+// in FileTransfers.
+type ServiceOptions struct {
+	Metrics       *jasper.ServiceMetrics
+	Artifacts     *jasper.ProcessArtifacts
+	DownloadCache *jasper.DownloadCache
+	FileTransfers jasper.FileTransferOptions
+}
+
 // AttachService attaches the given manager to the jasper GRPC server. This
 // function eventually calls generated Protobuf code for registering the the
-// GRPC Jasper server with the given Manager.
-func AttachService(manager jasper.Manager, s *grpc.Server) error {
+// GRPC Jasper server with the given Manager, configured with the given
+// ServiceOptions. The service's download jobs are canceled, and its
+// background work stops, when the context is done.
+func AttachService(ctx context.Context, manager jasper.Manager, s *grpc.Server, opts ServiceOptions) error {
 	hn, err := os.Hostname()
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
+	if err = opts.FileTransfers.Validate(); err != nil {
+		return errors.Wrap(err, "problem validating file transfer options")
+	}
+
+	metrics := opts.Metrics
+	if metrics == nil {
+		metrics = jasper.NewServiceMetrics()
+	}
+
+	cache := opts.DownloadCache
+	if cache == nil {
+		cache, err = jasper.DefaultDownloadCache()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	downloads, err := jasper.NewDownloadJobs(ctx, jasper.DefaultDownloadJobWorkers)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	downloads.SetCache(cache)
+
 	srv := &jasperService{
 		hostID:  hn,
 		manager: manager,
@@ -34,28 +74,46 @@ func AttachService(manager jasper.Manager, s *grpc.Server) error {
 			PruneDelay: jasper.DefaultCachePruneDelay,
 			MaxSize:    jasper.DefaultMaxCacheSize,
 		},
+		metrics:       metrics,
+		artifacts:     opts.Artifacts,
+		downloads:     downloads,
+		downloadCache: cache,
+		fileTransfers: opts.FileTransfers,
 	}
 
 	RegisterJasperProcessManagerServer(s, srv)
 
-	go srv.backgroundPrune()
+	go srv.backgroundPrune(ctx)
 
 	return nil
 }
 
-func (s *jasperService) backgroundPrune() {
+func (s *jasperService) backgroundPrune(ctx context.Context) {
 	s.cacheMutex.RLock()
 	timer := time.NewTimer(s.cacheOpts.PruneDelay)
 	s.cacheMutex.RUnlock()
+	defer timer.Stop()
 
 	for {
-		<-timer.C
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
 		s.cacheMutex.RLock()
 		if !s.cacheOpts.Disabled {
-			if err := s.cache.Prune(s.cacheOpts.MaxSize, nil, false); err != nil {
+			sizeBefore := s.cache.Size()
+			err := s.cache.Prune(s.cacheOpts.MaxSize, nil, false)
+			if err != nil {
 				grip.Error(errors.Wrap(err, "error during cache pruning"))
 			}
+			s.metrics.ObservePrune(sizeBefore, s.cache.Size(), err)
 		}
+		sizeBefore := s.downloadCache.Size()
+		err := s.downloadCache.Prune(s.downloadCache.MaxSize())
+		grip.Error(errors.Wrap(err, "error during download cache pruning"))
+		s.metrics.ObserveDownloadCachePrune(sizeBefore, s.downloadCache.Size(), len(s.downloadCache.List()), err)
+		s.metrics.ObserveCache(s.cache.Size(), s.cache.Count())
 		timer.Reset(s.cacheOpts.PruneDelay)
 		s.cacheMutex.RUnlock()
 	}
@@ -64,16 +122,23 @@ func (s *jasperService) backgroundPrune() {
 func getProcInfoNoHang(ctx context.Context, p jasper.Process) *ProcessInfo {
 	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
 	defer cancel()
-	return ConvertProcessInfo(p.Info(ctx))
+	return ConvertProcessInfo(p.Info(ctx).Redacted())
 }
 
 type jasperService struct {
-	hostID     string
-	manager    jasper.Manager
-	client     http.Client
-	cache      *lru.Cache
-	cacheOpts  jasper.CacheOptions
-	cacheMutex sync.RWMutex
+	hostID        string
+	manager       jasper.Manager
+	client        http.Client
+	cache         *lru.Cache
+	cacheOpts     jasper.CacheOptions
+	cacheMutex    sync.RWMutex
+	metrics       *jasper.ServiceMetrics
+	artifacts     *jasper.ProcessArtifacts
+	downloads     *jasper.DownloadJobs
+	downloadCache *jasper.DownloadCache
+	sources       jasper.DownloadSources
+	sourcesMutex  sync.RWMutex
+	fileTransfers jasper.FileTransferOptions
 }
 
 func (s *jasperService) Status(ctx context.Context, _ *empty.Empty) (*StatusResponse, error) {
@@ -85,6 +150,9 @@ func (s *jasperService) Status(ctx context.Context, _ *empty.Empty) (*StatusResp
 
 func (s *jasperService) Create(ctx context.Context, opts *CreateOptions) (*ProcessInfo, error) {
 	jopts := opts.Export()
+	if err := jopts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid creation options")
+	}
 
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how rest_service.go's createProcess() does
@@ -92,12 +160,13 @@ func (s *jasperService) Create(ctx context.Context, opts *CreateOptions) (*Proce
 	var cctx context.Context
 	var cancel context.CancelFunc
 	if jopts.Timeout > 0 {
-		cctx, cancel = context.WithTimeout(context.Background(), jopts.Timeout)
+		cctx, cancel = context.WithTimeout(jasper.WithServiceMetrics(context.Background(), s.metrics), jopts.Timeout)
 	} else {
-		cctx, cancel = context.WithCancel(context.Background())
+		cctx, cancel = context.WithCancel(jasper.WithServiceMetrics(context.Background(), s.metrics))
 	}
 
 	proc, err := s.manager.Create(cctx, jopts)
+	s.metrics.ObserveCreate(cctx, proc, err)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -106,7 +175,7 @@ func (s *jasperService) Create(ctx context.Context, opts *CreateOptions) (*Proce
 		cancel()
 	}); err != nil {
 		if !proc.Info(cctx).Complete {
-			return ConvertProcessInfo(proc.Info(cctx)), nil
+			return ConvertProcessInfo(proc.Info(cctx).Redacted()), nil
 		}
 		cancel()
 	}
@@ -174,8 +243,18 @@ func (s *jasperService) Signal(ctx context.Context, sig *SignalProcess) (*Operat
 		}, nil
 	}
 
-	if err = proc.Signal(ctx, sig.Signal.Export()); err != nil {
-		err = errors.Wrapf(err, "problem sending '%s' to '%s'", sig.Signal, sig.ProcessID)
+	signal, err := sig.Export()
+	if err != nil {
+		err = errors.Wrapf(err, "problem validating signal for '%s'", sig.ProcessID.Value)
+		return &OperationOutcome{
+			Success:  false,
+			ExitCode: -1,
+			Text:     err.Error(),
+		}, nil
+	}
+
+	if err = proc.Signal(ctx, signal); err != nil {
+		err = errors.Wrapf(err, "problem sending '%s' to '%s'", jasper.SignalName(signal), sig.ProcessID.Value)
 		return &OperationOutcome{
 			Success:  false,
 			ExitCode: -3,
@@ -185,7 +264,7 @@ func (s *jasperService) Signal(ctx context.Context, sig *SignalProcess) (*Operat
 
 	return &OperationOutcome{
 		Success:  true,
-		Text:     fmt.Sprintf("sending '%s' to '%s'", sig.Signal, sig.ProcessID),
+		Text:     fmt.Sprintf("sending '%s' to '%s'", jasper.SignalName(signal), sig.ProcessID.Value),
 		ExitCode: int32(getProcInfoNoHang(ctx, proc).ExitCode),
 	}, nil
 }
@@ -228,8 +307,9 @@ func (s *jasperService) Respawn(ctx context.Context, id *JasperProcessID) (*Proc
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how rest_service.go's createProcess() does
 	// this same thing.
-	cctx, cancel := context.WithCancel(context.Background())
+	cctx, cancel := context.WithCancel(jasper.WithServiceMetrics(context.Background(), s.metrics))
 	newProc, err := proc.Respawn(cctx)
+	s.metrics.ObserveCreate(cctx, newProc, err)
 	if err != nil {
 		err = errors.Wrap(err, "problem encountered while respawning")
 		cancel()
@@ -316,6 +396,7 @@ func (s *jasperService) ResetTags(ctx context.Context, id *JasperProcessID) (*Op
 
 func (s *jasperService) DownloadMongoDB(ctx context.Context, opts *MongoDBDownloadOptions) (*OperationOutcome, error) {
 	jopts := opts.Export()
+	jopts.Sources = jopts.Sources.Merge(s.downloadSources())
 	if err := jopts.Validate(); err != nil {
 		return &OperationOutcome{
 			Success: false,
@@ -323,7 +404,7 @@ func (s *jasperService) DownloadMongoDB(ctx context.Context, opts *MongoDBDownlo
 		}, nil
 	}
 
-	if err := jasper.SetupDownloadMongoDBReleases(ctx, s.cache, jopts); err != nil {
+	if _, err := s.downloads.StartMongoDB(ctx, s.cache, jopts); err != nil {
 		err = errors.Wrap(err, "problem in download setup")
 		return &OperationOutcome{Success: false, Text: err.Error()}, err
 	}
@@ -331,6 +412,21 @@ func (s *jasperService) DownloadMongoDB(ctx context.Context, opts *MongoDBDownlo
 	return &OperationOutcome{Success: true, Text: "download jobs started"}, nil
 }
 
+func (s *jasperService) ResolveMongoDB(ctx context.Context, opts *MongoDBDownloadOptions) (*MongoDBReleases, error) {
+	jopts := opts.Export()
+	jopts.Sources = jopts.Sources.Merge(s.downloadSources())
+	if err := jopts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "problem validating MongoDB download options")
+	}
+
+	releases, err := jasper.ResolveMongoDBReleases(ctx, jopts)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem resolving MongoDB releases")
+	}
+
+	return ConvertMongoDBReleases(releases), nil
+}
+
 func (s *jasperService) ConfigureCache(ctx context.Context, opts *CacheOptions) (*OperationOutcome, error) {
 	jopts := opts.Export()
 	if err := jopts.Validate(); err != nil {
@@ -354,15 +450,81 @@ func (s *jasperService) ConfigureCache(ctx context.Context, opts *CacheOptions)
 	return &OperationOutcome{Success: true, Text: "cache configured"}, nil
 }
 
+func (s *jasperService) downloadSources() jasper.DownloadSources {
+	s.sourcesMutex.RLock()
+	defer s.sourcesMutex.RUnlock()
+
+	return s.sources
+}
+
+func (s *jasperService) ConfigureDownloadSources(ctx context.Context, sources *DownloadSources) (*OperationOutcome, error) {
+	jsources := sources.Export()
+	if err := jsources.Validate(); err != nil {
+		err = errors.Wrap(err, "problem validating download sources")
+		return &OperationOutcome{
+			Success: false,
+			Text:    err.Error(),
+		}, err
+	}
+
+	s.sourcesMutex.Lock()
+	s.sources = jsources
+	s.sourcesMutex.Unlock()
+
+	return &OperationOutcome{Success: true, Text: "download sources configured"}, nil
+}
+
+func (s *jasperService) ConfigureDownloads(ctx context.Context, opts *DownloadOptions) (*OperationOutcome, error) {
+	if err := s.downloads.Configure(opts.Export()); err != nil {
+		return &OperationOutcome{Success: false, Text: err.Error()}, nil
+	}
+
+	return &OperationOutcome{Success: true, Text: "downloads configured"}, nil
+}
+
+func (s *jasperService) GetDownloadStatus(ctx context.Context, _ *empty.Empty) (*DownloadStatus, error) {
+	return ConvertDownloadStatus(s.downloads.Status()), nil
+}
+
+func (s *jasperService) ListCachedDownloads(ctx context.Context, _ *empty.Empty) (*DownloadCacheEntries, error) {
+	return ConvertDownloadCacheEntries(s.downloadCache.List()), nil
+}
+
+func (s *jasperService) GetCachedDownload(ctx context.Context, digest *DownloadCacheDigest) (*DownloadCacheEntry, error) {
+	entry, err := s.downloadCache.Get(digest.Value)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ConvertDownloadCacheEntry(entry), nil
+}
+
+func (s *jasperService) PinCachedDownload(ctx context.Context, pin *DownloadCachePin) (*OperationOutcome, error) {
+	if err := s.downloadCache.Pin(pin.Digest, pin.Pinned); err != nil {
+		return &OperationOutcome{Success: false, Text: err.Error()}, nil
+	}
+
+	return &OperationOutcome{Success: true, Text: fmt.Sprintf("set pinned to %t for cached download '%s'", pin.Pinned, pin.Digest)}, nil
+}
+
+func (s *jasperService) EvictCachedDownload(ctx context.Context, digest *DownloadCacheDigest) (*OperationOutcome, error) {
+	if err := s.downloadCache.Evict(digest.Value); err != nil {
+		return &OperationOutcome{Success: false, Text: err.Error()}, nil
+	}
+
+	return &OperationOutcome{Success: true, Text: fmt.Sprintf("evicted cached download '%s'", digest.Value)}, nil
+}
+
 func (s *jasperService) DownloadFile(ctx context.Context, info *DownloadInfo) (*OperationOutcome, error) {
 	jinfo := info.Export()
+	jinfo.Sources = jinfo.Sources.Merge(s.downloadSources())
 
 	if err := jinfo.Validate(); err != nil {
 		err = errors.Wrap(err, "problem validating download info")
 		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -2}, err
 	}
 
-	if err := jinfo.Download(); err != nil {
+	if err := s.downloads.Download(ctx, jinfo); err != nil {
 		err = errors.Wrapf(err, "problem occurred during file download for URL %s to path %s", jinfo.URL, jinfo.Path)
 		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -3}, err
 	}
@@ -374,6 +536,48 @@ func (s *jasperService) DownloadFile(ctx context.Context, info *DownloadInfo) (*
 	}, nil
 }
 
+func (s *jasperService) StartDownload(ctx context.Context, info *DownloadInfo) (*DownloadJobStatus, error) {
+	jinfo := info.Export()
+	jinfo.Sources = jinfo.Sources.Merge(s.downloadSources())
+
+	status, err := s.downloads.Start(jinfo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "problem starting download for URL %s", jinfo.URL)
+	}
+
+	return ConvertDownloadJobStatus(status), nil
+}
+
+func (s *jasperService) ListDownloads(ctx context.Context, _ *empty.Empty) (*DownloadJobStatuses, error) {
+	return ConvertDownloadJobStatuses(s.downloads.List()), nil
+}
+
+func (s *jasperService) GetDownload(ctx context.Context, id *DownloadJobID) (*DownloadJobStatus, error) {
+	status, err := s.downloads.Get(id.Value)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ConvertDownloadJobStatus(status), nil
+}
+
+func (s *jasperService) CancelDownload(ctx context.Context, id *DownloadJobID) (*OperationOutcome, error) {
+	if err := s.downloads.Cancel(id.Value); err != nil {
+		return &OperationOutcome{Success: false, Text: err.Error()}, nil
+	}
+
+	return &OperationOutcome{Success: true, Text: fmt.Sprintf("canceled download job '%s'", id.Value)}, nil
+}
+
+func (s *jasperService) WaitDownload(ctx context.Context, id *DownloadJobID) (*DownloadJobStatus, error) {
+	status, err := s.downloads.Wait(ctx, id.Value)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ConvertDownloadJobStatus(status), nil
+}
+
 func (s *jasperService) GetBuildloggerURLs(ctx context.Context, id *JasperProcessID) (*BuildloggerURLs, error) {
 	proc, err := s.manager.Get(ctx, id.Value)
 	if err != nil {
@@ -394,3 +598,148 @@ func (s *jasperService) GetBuildloggerURLs(ctx context.Context, id *JasperProces
 
 	return &BuildloggerURLs{Urls: urls}, nil
 }
+
+func (s *jasperService) GetLogs(ctx context.Context, id *JasperProcessID) (*LogLines, error) {
+	proc, err := s.manager.Get(ctx, id.Value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "problem finding process '%s'", id.Value)
+	}
+
+	logs, err := jasper.GetInMemoryLogs(ctx, proc)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ConvertLogLines(logs), nil
+}
+
+func (s *jasperService) QueryLogs(ctx context.Context, q *LogQuery) (*LogRecords, error) {
+	id, query := q.Export()
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "problem finding process '%s'", id)
+	}
+
+	records, err := jasper.QueryInMemoryLogs(ctx, proc, query)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	out, err := ConvertLogRecords(records)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return out, nil
+}
+
+// artifactChunkSize is the maximum size of the chunks in which artifacts
+// and files are streamed.
+const artifactChunkSize = 64 * 1024
+
+func (s *jasperService) GetArtifact(req *ArtifactRequest, stream JasperProcessManager_GetArtifactServer) error {
+	if s.artifacts == nil {
+		return errors.New("process artifacts are not enabled")
+	}
+
+	file, err := s.artifacts.Open(req.Id, req.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer file.Close()
+
+	buf := make([]byte, artifactChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&ArtifactChunk{Data: buf[:n]}); sendErr != nil {
+				return errors.Wrap(sendErr, "problem sending artifact")
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "problem reading artifact '%s'", req.Name)
+		}
+	}
+}
+
+// uploadReader reads the data of the chunks of a file upload.
+type uploadReader struct {
+	stream JasperProcessManager_UploadFileServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (s *jasperService) UploadFile(stream JasperProcessManager_UploadFileServer) error {
+	chunk, err := stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "problem receiving upload")
+	}
+	if chunk.Header == nil {
+		return errors.New("upload must start with a header")
+	}
+
+	r := &uploadReader{stream: stream, buf: chunk.Data}
+	if err = s.fileTransfers.Upload(r, chunk.Header.Path, chunk.Header.Archive); err != nil {
+		return errors.Wrap(err, "problem uploading file")
+	}
+
+	return stream.SendAndClose(&OperationOutcome{Success: true, Text: chunk.Header.Path})
+}
+
+func (s *jasperService) FetchFile(path *FilePath, stream JasperProcessManager_FetchFileServer) error {
+	content, archive, err := s.fileTransfers.Fetch(path.Value)
+	if err != nil {
+		return errors.Wrap(err, "problem fetching file")
+	}
+	defer content.Close()
+
+	if err = stream.Send(&FileTransferChunk{Header: &FileTransferHeader{Path: path.Value, Archive: archive}}); err != nil {
+		return errors.Wrap(err, "problem sending file")
+	}
+
+	buf := make([]byte, artifactChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&FileTransferChunk{Data: buf[:n]}); sendErr != nil {
+				return errors.Wrap(sendErr, "problem sending file")
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "problem reading '%s'", path.Value)
+		}
+	}
+}
+
+func (s *jasperService) GetProcessMetrics(ctx context.Context, id *JasperProcessID) (*ProcessMetrics, error) {
+	proc, err := s.manager.Get(ctx, id.Value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "problem finding process '%s'", id.Value)
+	}
+
+	metrics, err := ConvertProcessMetrics(message.CollectProcessInfoWithChildren(int32(getProcInfoNoHang(ctx, proc).Pid)))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return metrics, nil
+}
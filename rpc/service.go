@@ -1,6 +1,9 @@
 package rpc
 
 import (
+	"context"
+	"time"
+
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/rpc/internal"
 	"github.com/pkg/errors"
@@ -11,5 +14,58 @@ import (
 // this function successfully returns, calls to Manager functions will be sent
 // over GRPC to the Jasper GRPC server.
 func AttachService(manager jasper.Manager, s *grpc.Server) error {
-	return errors.WithStack(internal.AttachService(manager, s))
+	return errors.WithStack(internal.AttachService(context.Background(), manager, s, internal.ServiceOptions{}))
+}
+
+// ServiceOptions configures the optional features of the jasper GRPC
+// service.
+//
+// Metrics records metrics about the service, which can then be served over
+// HTTP using its Handler method; to record the latency of each RPC, the
+// GRPC server must also be constructed with the options returned by
+// MetricsServerOptions. If it is nil, the metrics are recorded in a new
+// jasper.ServiceMetrics.
+//
+// Artifacts serves the process artifacts in its directory, which should be
+// the one that the manager captures artifacts into with
+// jasper.ArtifactMiddleware.
+//
+// DownloadCache serves downloads from, and adds them to, the given download
+// cache instead of the jasper.DefaultDownloadCache.
+//
+// FileTransfers allows clients to upload files to, and fetch files from,
+// its paths.
+type ServiceOptions struct {
+	Metrics       *jasper.ServiceMetrics
+	Artifacts     *jasper.ProcessArtifacts
+	DownloadCache *jasper.DownloadCache
+	FileTransfers jasper.FileTransferOptions
+}
+
+// AttachServiceWithContext is the same as AttachService, but configures the
+// service with the given ServiceOptions and ties the service to the
+// lifetime of the context: once the context is done, its download jobs are
+// canceled and its background work stops. The services attached by
+// AttachService run until the process exits.
+func AttachServiceWithContext(ctx context.Context, manager jasper.Manager, s *grpc.Server, opts ServiceOptions) error {
+	return errors.WithStack(internal.AttachService(ctx, manager, s, internal.ServiceOptions(opts)))
+}
+
+// MetricsServerOptions returns GRPC server options that record the latency of
+// every RPC, by method, in the given ServiceMetrics.
+func MetricsServerOptions(metrics *jasper.ServiceMetrics) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			start := time.Now()
+			resp, err := handler(ctx, req)
+			metrics.ObserveRequest(info.FullMethod, time.Since(start))
+			return resp, err
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			start := time.Now()
+			err := handler(srv, ss)
+			metrics.ObserveRequest(info.FullMethod, time.Since(start))
+			return err
+		}),
+	}
 }
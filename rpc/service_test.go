@@ -1,8 +1,11 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
@@ -117,6 +120,41 @@ func TestRPCService(t *testing.T) {
 					assert.Error(t, err)
 					assert.Nil(t, urls)
 				},
+				"SignalByName": func(ctx context.Context, t *testing.T, opts jasper.CreateOptions, client internal.JasperProcessManagerClient, output string, buildDir string) {
+					info, err := client.Create(ctx, internal.ConvertCreateOptions(&jasper.CreateOptions{Args: []string{"sleep", "10"}}))
+					require.NoError(t, err)
+
+					outcome, err := client.Signal(ctx, &internal.SignalProcess{
+						ProcessID:  &internal.JasperProcessID{Value: info.Id},
+						SignalName: "quit",
+					})
+					require.NoError(t, err)
+					assert.True(t, outcome.Success)
+
+					outcome, err = client.Wait(ctx, &internal.JasperProcessID{Value: info.Id})
+					require.NoError(t, err)
+					assert.EqualValues(t, 3, outcome.ExitCode)
+				},
+				"SignalFailsForInvalidSignals": func(ctx context.Context, t *testing.T, opts jasper.CreateOptions, client internal.JasperProcessManagerClient, output string, buildDir string) {
+					info, err := client.Create(ctx, internal.ConvertCreateOptions(&jasper.CreateOptions{Args: []string{"sleep", "10"}}))
+					require.NoError(t, err)
+					id := &internal.JasperProcessID{Value: info.Id}
+
+					for _, sig := range []*internal.SignalProcess{
+						{ProcessID: id},
+						{ProcessID: id, SignalName: "SIGFOO"},
+						{ProcessID: id, SignalNumber: 1000},
+						{ProcessID: id, SignalNumber: -1},
+					} {
+						outcome, err := client.Signal(ctx, sig)
+						require.NoError(t, err)
+						assert.False(t, outcome.Success)
+					}
+
+					info, err = client.Get(ctx, id)
+					require.NoError(t, err)
+					assert.True(t, info.Running)
+				},
 				//"": func(ctx context.Context, t *testing.T, opts jasper.CreateOptions, client internal.JasperProcessManagerClient, output string) {},
 			} {
 				t.Run(testName, func(t *testing.T) {
@@ -150,3 +188,37 @@ func TestRPCService(t *testing.T) {
 		})
 	}
 }
+
+func TestRPCServiceMetrics(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+	defer cancel()
+
+	manager := jasper.NewLocalManager()
+	metrics := jasper.NewServiceMetrics()
+
+	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", getPortNumber()))
+	require.NoError(t, err)
+	rpcSrv := grpc.NewServer(MetricsServerOptions(metrics)...)
+	require.NoError(t, AttachServiceWithContext(ctx, manager, rpcSrv, ServiceOptions{Metrics: metrics}))
+	go rpcSrv.Serve(lis)
+	defer rpcSrv.Stop()
+
+	client, err := getClient(ctx, lis.Addr().String())
+	require.NoError(t, err)
+
+	proc, err := client.Create(ctx, trueCreateOpts())
+	require.NoError(t, err)
+	_, err = proc.Wait(ctx)
+	require.NoError(t, err)
+	_, err = client.List(ctx, jasper.All)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, metrics.Write(ctx, buf, manager))
+
+	out := buf.String()
+	assert.Contains(t, out, "jasper_processes_created_total 1\n")
+	assert.Contains(t, out, `jasper_processes{state="successful"} 1`)
+	assert.Contains(t, out, `jasper_request_duration_seconds_count{route="/jasper.JasperProcessManager/Create"} 1`)
+	assert.Contains(t, out, `jasper_request_duration_seconds_count{route="/jasper.JasperProcessManager/List"} 1`)
+}
@@ -3,7 +3,11 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"net/http"
+	"os"
+	"testing"
 	"time"
 
 	"github.com/mongodb/grip"
@@ -14,6 +18,25 @@ import (
 
 var intSource <-chan int
 
+// TestMain stores the default download cache in a temporary directory, so
+// that the services in the tests do not serve downloads that were cached
+// by earlier test runs.
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "jasper-download-cache")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err = jasper.SetDefaultDownloadCacheDirectory(dir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
 func init() {
 	intSource = func() <-chan int {
 		out := make(chan int, 25)
@@ -69,6 +92,28 @@ func createProcs(ctx context.Context, opts *jasper.CreateOptions, manager jasper
 }
 
 func startRPC(ctx context.Context, mngr jasper.Manager) (string, error) {
+	return startRPCWithArtifacts(ctx, mngr, nil)
+}
+
+func startRPCWithArtifacts(ctx context.Context, mngr jasper.Manager, artifacts *jasper.ProcessArtifacts) (string, error) {
+	return serveRPC(ctx, func(rpcSrv *grpc.Server) error {
+		return AttachServiceWithContext(ctx, mngr, rpcSrv, ServiceOptions{Artifacts: artifacts})
+	})
+}
+
+func startRPCWithDownloadCache(ctx context.Context, mngr jasper.Manager, cache *jasper.DownloadCache) (string, error) {
+	return serveRPC(ctx, func(rpcSrv *grpc.Server) error {
+		return AttachServiceWithContext(ctx, mngr, rpcSrv, ServiceOptions{DownloadCache: cache})
+	})
+}
+
+func startRPCWithFileTransfers(ctx context.Context, mngr jasper.Manager, opts jasper.FileTransferOptions) (string, error) {
+	return serveRPC(ctx, func(rpcSrv *grpc.Server) error {
+		return AttachServiceWithContext(ctx, mngr, rpcSrv, ServiceOptions{FileTransfers: opts})
+	})
+}
+
+func serveRPC(ctx context.Context, attach func(*grpc.Server) error) (string, error) {
 	addr := fmt.Sprintf("localhost:%d", getPortNumber())
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -77,7 +122,10 @@ func startRPC(ctx context.Context, mngr jasper.Manager) (string, error) {
 
 	rpcSrv := grpc.NewServer()
 
-	AttachService(mngr, rpcSrv)
+	if err = attach(rpcSrv); err != nil {
+		lis.Close()
+		return "", errors.WithStack(err)
+	}
 	go rpcSrv.Serve(lis)
 
 	go func() {
@@ -88,7 +136,68 @@ func startRPC(ctx context.Context, mngr jasper.Manager) (string, error) {
 	return addr, nil
 }
 
-func getClient(ctx context.Context, addr string) (jasper.Manager, error) {
+func startREST(ctx context.Context, mngr jasper.Manager) (string, error) {
+	return startRESTWithArtifacts(ctx, mngr, nil)
+}
+
+func startRESTWithArtifacts(ctx context.Context, mngr jasper.Manager, artifacts *jasper.ProcessArtifacts) (string, error) {
+	srv := jasper.NewManagerService(mngr)
+	srv.SetArtifacts(artifacts)
+	return serveREST(ctx, srv)
+}
+
+func startRESTWithDownloadCache(ctx context.Context, mngr jasper.Manager, cache *jasper.DownloadCache) (string, error) {
+	srv := jasper.NewManagerService(mngr)
+	srv.SetDownloadCache(cache)
+	return serveREST(ctx, srv)
+}
+
+func startRESTWithFileTransfers(ctx context.Context, mngr jasper.Manager, opts jasper.FileTransferOptions) (string, error) {
+	srv := jasper.NewManagerService(mngr)
+	if err := srv.SetFileTransfers(opts); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return serveREST(ctx, srv)
+}
+
+func serveREST(ctx context.Context, srv *jasper.Service) (string, error) {
+	port := getPortNumber()
+	app := srv.App()
+	app.SetPrefix("jasper")
+	if err := app.SetPort(port); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	go func() {
+		grip.Warning(app.Run(ctx))
+		srv.Close()
+	}()
+
+	url := fmt.Sprintf("http://localhost:%d/jasper/v1", port)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return "", errors.New("timed out waiting for REST service to start")
+		case <-timer.C:
+			req, err := http.NewRequest(http.MethodGet, url+"/", nil)
+			if err != nil {
+				return "", errors.WithStack(err)
+			}
+			resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+			if err == nil {
+				resp.Body.Close()
+				if resp.StatusCode == http.StatusOK {
+					return url, nil
+				}
+			}
+			timer.Reset(5 * time.Millisecond)
+		}
+	}
+}
+
+func getClient(ctx context.Context, addr string) (jasper.RemoteClient, error) {
 	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
 	if err != nil {
 		return nil, err
@@ -3,12 +3,129 @@ package jasper
 import (
 	"context"
 	"runtime"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 )
 
+// commonSignals are the signals that are defined on every platform.
+var commonSignals = map[string]syscall.Signal{
+	"SIGABRT": syscall.SIGABRT,
+	"SIGALRM": syscall.SIGALRM,
+	"SIGBUS":  syscall.SIGBUS,
+	"SIGFPE":  syscall.SIGFPE,
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGILL":  syscall.SIGILL,
+	"SIGINT":  syscall.SIGINT,
+	"SIGKILL": syscall.SIGKILL,
+	"SIGPIPE": syscall.SIGPIPE,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGSEGV": syscall.SIGSEGV,
+	"SIGTERM": syscall.SIGTERM,
+	"SIGTRAP": syscall.SIGTRAP,
+}
+
+// ParseSignal converts the name or number of a signal into a signal, and
+// validates it. Names are case-insensitive and may omit the "SIG" prefix
+// (e.g. "SIGTERM", "term" and "15" are all equivalent on Linux). On Linux,
+// real-time signals may be named relative to either end of their range
+// (e.g. "SIGRTMIN+1").
+func ParseSignal(name string) (syscall.Signal, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" {
+		return 0, errors.New("signal name cannot be empty")
+	}
+
+	if num, err := strconv.Atoi(name); err == nil {
+		sig := syscall.Signal(num)
+		return sig, errors.WithStack(ValidateSignal(sig))
+	}
+
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+
+	if sig, ok := commonSignals[name]; ok {
+		return sig, nil
+	}
+	if sig, ok := platformSignals[name]; ok {
+		return sig, nil
+	}
+
+	if sig, ok := parseRealTimeSignal(name); ok {
+		return sig, nil
+	}
+
+	return 0, errors.Errorf("unknown signal '%s'", name)
+}
+
+func parseRealTimeSignal(name string) (syscall.Signal, bool) {
+	var base syscall.Signal
+	var offset string
+	switch {
+	case strings.HasPrefix(name, "SIGRTMIN"):
+		base, offset = sigRTMin, strings.TrimPrefix(name, "SIGRTMIN")
+	case strings.HasPrefix(name, "SIGRTMAX"):
+		base, offset = sigRTMax, strings.TrimPrefix(name, "SIGRTMAX")
+	default:
+		return 0, false
+	}
+
+	var num int
+	if offset != "" {
+		var err error
+		num, err = strconv.Atoi(offset)
+		if err != nil || (offset[0] != '+' && offset[0] != '-') {
+			return 0, false
+		}
+	}
+
+	// The range is empty on platforms without real-time signals.
+	sig := base + syscall.Signal(num)
+	if sig < sigRTMin || sig > sigRTMax {
+		return 0, false
+	}
+
+	return sig, true
+}
+
+// ValidateSignal checks that the signal is one that can be sent to a process
+// on this platform.
+func ValidateSignal(sig syscall.Signal) error {
+	if SignalName(sig) == "" {
+		return errors.Errorf("invalid signal '%d'", int(sig))
+	}
+
+	return nil
+}
+
+// SignalName returns the canonical name of the signal (e.g. "SIGTERM"), or
+// an empty string if the signal is not valid on this platform.
+func SignalName(sig syscall.Signal) string {
+	for name, s := range commonSignals {
+		if s == sig {
+			return name
+		}
+	}
+	for name, s := range platformSignals {
+		if s == sig {
+			return name
+		}
+	}
+
+	if sig >= sigRTMin && sig <= sigRTMax {
+		if sig == sigRTMin {
+			return "SIGRTMIN"
+		}
+		return "SIGRTMIN+" + strconv.Itoa(int(sig-sigRTMin))
+	}
+
+	return ""
+}
+
 // Terminate sends a SIGTERM signal to the given process under the given
 // context. This does not guarantee that the process will actually die. This
 // function does not Wait() on the given process upon sending the signal. On
@@ -33,6 +150,26 @@ func Kill(ctx context.Context, p Process) error {
 	return errors.WithStack(p.Signal(ctx, syscall.SIGKILL))
 }
 
+// Suspend sends a SIGSTOP signal to the given process under the given
+// context, which pauses the process until it is resumed. On Windows, this
+// function returns an error.
+func Suspend(ctx context.Context, p Process) error {
+	if runtime.GOOS == "windows" {
+		return errors.New("cannot suspend processes on windows")
+	}
+	return errors.WithStack(p.Signal(ctx, suspendSignal))
+}
+
+// Resume sends a SIGCONT signal to the given process under the given context,
+// which continues a process that was previously suspended. On Windows, this
+// function returns an error.
+func Resume(ctx context.Context, p Process) error {
+	if runtime.GOOS == "windows" {
+		return errors.New("cannot resume processes on windows")
+	}
+	return errors.WithStack(p.Signal(ctx, resumeSignal))
+}
+
 // TerminateAll sends a SIGTERM signal to each of the given processes under the
 // given context. This does not guarantee that each process will actually die.
 // This function calls Wait() on each process after sending them SIGTERM
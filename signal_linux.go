@@ -0,0 +1,19 @@
+package jasper
+
+import "syscall"
+
+// The range of real-time signals on Linux. These signals have no fixed names
+// and are referred to relative to either end of the range, e.g. "SIGRTMIN+1"
+// or "SIGRTMAX-2".
+//
+// The kernel defines real-time signals 32 through 64, but the C library
+// reserves the lowest of them for its own use and reports the rest through
+// the SIGRTMIN and SIGRTMAX macros, which are not constants and are not
+// available to Go. These bounds are the values that glibc reports, which
+// the names used by tools such as kill(1) are relative to on most
+// distributions; on musl-based systems SIGRTMIN is 35 instead, so
+// "SIGRTMIN" refers to a signal that the C library reserves there.
+const (
+	sigRTMin = syscall.Signal(34)
+	sigRTMax = syscall.Signal(64)
+)
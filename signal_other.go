@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package jasper
+
+import "syscall"
+
+// Real-time signals are only supported on Linux, so the range is empty on
+// other platforms.
+const (
+	sigRTMin = syscall.Signal(1)
+	sigRTMax = syscall.Signal(0)
+)
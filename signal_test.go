@@ -0,0 +1,122 @@
+package jasper
+
+import (
+	"context"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSignal(t *testing.T) {
+	for name, expected := range map[string]syscall.Signal{
+		"SIGTERM": syscall.SIGTERM,
+		"TERM":    syscall.SIGTERM,
+		"term":    syscall.SIGTERM,
+		" SigInt": syscall.SIGINT,
+		"9":       syscall.SIGKILL,
+	} {
+		t.Run(name, func(t *testing.T) {
+			sig, err := ParseSignal(name)
+			require.NoError(t, err)
+			assert.Equal(t, expected, sig)
+		})
+	}
+
+	for _, name := range []string{"", "SIGFOO", "0", "-1", "1000", "SIGRTMIN+100", "SIGRTMAXfoo"} {
+		t.Run("Invalid"+name, func(t *testing.T) {
+			_, err := ParseSignal(name)
+			assert.Error(t, err)
+		})
+	}
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	t.Run("POSIXSignals", func(t *testing.T) {
+		for name, expected := range map[string]syscall.Signal{
+			"SIGSTOP": suspendSignal,
+			"cont":    resumeSignal,
+			"USR1":    platformSignals["SIGUSR1"],
+		} {
+			sig, err := ParseSignal(name)
+			require.NoError(t, err)
+			assert.Equal(t, expected, sig)
+		}
+	})
+
+	if runtime.GOOS != "linux" {
+		for _, name := range []string{"SIGRTMIN", "SIGRTMAX", "RTMIN+1"} {
+			_, err := ParseSignal(name)
+			assert.Error(t, err)
+		}
+		return
+	}
+
+	t.Run("RealTimeSignals", func(t *testing.T) {
+		for name, expected := range map[string]syscall.Signal{
+			"SIGRTMIN":   sigRTMin,
+			"SIGRTMIN+1": sigRTMin + 1,
+			"RTMAX-2":    sigRTMax - 2,
+			"SIGRTMAX":   sigRTMax,
+			"40":         syscall.Signal(40),
+		} {
+			sig, err := ParseSignal(name)
+			require.NoError(t, err)
+			assert.Equal(t, expected, sig)
+		}
+	})
+}
+
+func TestSignalName(t *testing.T) {
+	assert.Equal(t, "SIGTERM", SignalName(syscall.SIGTERM))
+	assert.Equal(t, "SIGKILL", SignalName(syscall.SIGKILL))
+	assert.Empty(t, SignalName(syscall.Signal(0)))
+	assert.Empty(t, SignalName(syscall.Signal(1000)))
+	assert.Error(t, ValidateSignal(syscall.Signal(1000)))
+	assert.NoError(t, ValidateSignal(syscall.SIGINT))
+
+	if runtime.GOOS == "linux" {
+		assert.Equal(t, "SIGRTMIN+3", SignalName(sigRTMin+3))
+
+		sig, err := ParseSignal(SignalName(sigRTMax))
+		require.NoError(t, err)
+		assert.Equal(t, sigRTMax, sig)
+	}
+}
+
+func TestSuspendAndResume(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("processes cannot be suspended on windows")
+	}
+
+	for cname, makeProc := range map[string]processConstructor{
+		"Basic":    newBasicProcess,
+		"Blocking": newBlockingProcess,
+	} {
+		t.Run(cname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+			defer cancel()
+
+			proc, err := makeProc(ctx, sleepCreateOpts(1))
+			require.NoError(t, err)
+			require.NoError(t, Suspend(ctx, proc))
+
+			// The process cannot finish while it is suspended.
+			wctx, wcancel := context.WithTimeout(ctx, 2*time.Second)
+			defer wcancel()
+			_, err = proc.Wait(wctx)
+			assert.Error(t, err)
+			assert.True(t, proc.Running(ctx))
+
+			require.NoError(t, Resume(ctx, proc))
+			_, err = proc.Wait(ctx)
+			assert.NoError(t, err)
+			assert.True(t, proc.Info(ctx).Successful)
+		})
+	}
+}
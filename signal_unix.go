@@ -0,0 +1,32 @@
+//go:build !windows
+// +build !windows
+
+package jasper
+
+import "syscall"
+
+const (
+	suspendSignal = syscall.SIGSTOP
+	resumeSignal  = syscall.SIGCONT
+)
+
+// platformSignals are the POSIX signals, in addition to commonSignals, that
+// are defined on all non-Windows platforms.
+var platformSignals = map[string]syscall.Signal{
+	"SIGCHLD":   syscall.SIGCHLD,
+	"SIGCONT":   syscall.SIGCONT,
+	"SIGIO":     syscall.SIGIO,
+	"SIGPROF":   syscall.SIGPROF,
+	"SIGSTOP":   syscall.SIGSTOP,
+	"SIGSYS":    syscall.SIGSYS,
+	"SIGTSTP":   syscall.SIGTSTP,
+	"SIGTTIN":   syscall.SIGTTIN,
+	"SIGTTOU":   syscall.SIGTTOU,
+	"SIGURG":    syscall.SIGURG,
+	"SIGUSR1":   syscall.SIGUSR1,
+	"SIGUSR2":   syscall.SIGUSR2,
+	"SIGVTALRM": syscall.SIGVTALRM,
+	"SIGWINCH":  syscall.SIGWINCH,
+	"SIGXCPU":   syscall.SIGXCPU,
+	"SIGXFSZ":   syscall.SIGXFSZ,
+}
@@ -0,0 +1,14 @@
+package jasper
+
+import "syscall"
+
+// Windows does not support suspending and resuming processes with signals,
+// so Suspend and Resume never send these.
+const (
+	suspendSignal = syscall.Signal(-1)
+	resumeSignal  = syscall.Signal(-1)
+)
+
+// platformSignals are the signals, in addition to commonSignals, that are
+// defined on Windows.
+var platformSignals = map[string]syscall.Signal{}
@@ -26,13 +26,17 @@ func (s ProcessTriggerSequence) Run(info ProcessInfo) {
 
 func makeOptionsCloseTrigger() ProcessTrigger {
 	return func(info ProcessInfo) {
-		info.Options.Close()
+		grip.Warning(message.WrapError(info.Options.Close(), message.Fields{
+			"message": "problem closing process options",
+			"process": info.ID,
+		}))
 	}
 }
 
 func makeDefaultTrigger(ctx context.Context, m Manager, opts *CreateOptions, parentID string) ProcessTrigger {
 	deadline, hasDeadline := ctx.Deadline()
 	timeout := time.Until(deadline)
+	metrics := serviceMetricsFromContext(ctx)
 
 	return func(info ProcessInfo) {
 		switch {
@@ -60,6 +64,7 @@ func makeDefaultTrigger(ctx context.Context, m Manager, opts *CreateOptions, par
 				}
 				p.Tag(parentID)
 				p.RegisterTrigger(ctx, func(_ ProcessInfo) { cancel() })
+				metrics.observeTrigger("on_timeout")
 			}
 		case info.Successful:
 			for _, opt := range opts.OnSuccess {
@@ -72,6 +77,7 @@ func makeDefaultTrigger(ctx context.Context, m Manager, opts *CreateOptions, par
 					continue
 				}
 				p.Tag(parentID)
+				metrics.observeTrigger("on_success")
 			}
 		case !info.Successful:
 			for _, opt := range opts.OnFailure {
@@ -85,6 +91,7 @@ func makeDefaultTrigger(ctx context.Context, m Manager, opts *CreateOptions, par
 					continue
 				}
 				p.Tag(parentID)
+				metrics.observeTrigger("on_failure")
 			}
 		}
 	}
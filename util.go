@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 )
 
@@ -41,8 +42,9 @@ func writeFile(reader io.Reader, path string) error {
 	}
 
 	if _, err := io.Copy(file, reader); err != nil {
+		grip.Warning(file.Close())
 		return err
 	}
 
-	return nil
+	return file.Close()
 }